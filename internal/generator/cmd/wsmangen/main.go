@@ -0,0 +1,120 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Command wsmangen generates a class package from a MOF or XSD class definition.
+//
+// It is intended to be run with go generate from the directory of the package being generated, for example:
+//
+//	//go:generate go run github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/generator/cmd/wsmangen -mof AMT_Example.mof -class AMT_Example -schema amt -package example -fixtures amt/example
+//
+// Go sources are written to -out (default ".") and fixtures to -responses/<fixtures>.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/generator"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "wsmangen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("wsmangen", flag.ContinueOnError)
+
+	mofPath := flags.String("mof", "", "MOF file containing the class and, optionally, its superclasses")
+	xsdPath := flags.String("xsd", "", "WS-CIM XSD file of the class")
+	className := flags.String("class", "", "class to generate when the MOF declares several classes")
+	out := flags.String("out", ".", "directory receiving the Go sources")
+	responses := flags.String("responses", "../../wsmantesting/responses", "wsmantesting responses directory, relative to -out")
+
+	config := generator.Config{}
+	flags.StringVar(&config.Package, "package", "", "Go package name")
+	flags.StringVar(&config.Schema, "schema", "amt", "schema of the class: amt, cim or ips")
+	flags.StringVar(&config.ServiceName, "service", "", "name of the service type (default: class name without prefix)")
+	flags.StringVar(&config.FileName, "file", "", "base name of the service source file (default: lower-cased service name)")
+	flags.StringVar(&config.FixturePath, "fixtures", "", "fixture directory under the responses directory, e.g. amt/example")
+	flags.BoolVar(&config.Put, "put", false, "generate Put even if no property is writable")
+	flags.BoolVar(&config.Delete, "delete", false, "generate Delete")
+	flags.IntVar(&config.Year, "year", 0, "copyright year (default: current year)")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	class, err := load(*mofPath, *xsdPath, *className)
+	if err != nil {
+		return err
+	}
+
+	output, err := generator.Generate(class, config)
+	if err != nil {
+		return err
+	}
+
+	if err := write(*out, output.Sources); err != nil {
+		return err
+	}
+
+	return write(filepath.Join(*out, *responses, config.FixturePath), output.Fixtures)
+}
+
+func load(mofPath, xsdPath, className string) (generator.Class, error) {
+	switch {
+	case mofPath != "":
+		file, err := os.Open(mofPath)
+		if err != nil {
+			return generator.Class{}, err
+		}
+		defer file.Close()
+
+		classes, err := generator.ParseMOF(file)
+		if err != nil {
+			return generator.Class{}, err
+		}
+
+		if className == "" && len(classes) > 0 {
+			className = classes[len(classes)-1].Name
+		}
+
+		class, ok := generator.Resolve(classes, className)
+		if !ok {
+			return class, fmt.Errorf("class %q not found in %s", className, mofPath)
+		}
+
+		return class, nil
+	case xsdPath != "":
+		file, err := os.Open(xsdPath)
+		if err != nil {
+			return generator.Class{}, err
+		}
+		defer file.Close()
+
+		return generator.ParseXSD(file)
+	default:
+		return generator.Class{}, fmt.Errorf("one of -mof or -xsd is required")
+	}
+}
+
+func write(directory string, files map[string][]byte) error {
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return err
+	}
+
+	for _, name := range generator.SortedNames(files) {
+		if err := os.WriteFile(filepath.Join(directory, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
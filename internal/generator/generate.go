@@ -0,0 +1,711 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package generator produces class packages in the layout used under pkg/wsman from DMTF or Intel® AMT class definitions.
+//
+// Definitions are read from MOF (ParseMOF) or WS-CIM XSD (ParseXSD) documents. Generate emits types.go, decoder.go,
+// marshal.go, the service file and its tests, plus the response fixtures the tests read through wsmantesting.MockClient.
+// The wsmangen command wraps this package for use with go generate.
+package generator
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+)
+
+var (
+	ErrUnknownSchema   = errors.New("unknown schema, expected amt, cim or ips")
+	ErrMissingPackage  = errors.New("package name is required")
+	ErrMissingFixtures = errors.New("fixture path is required")
+	ErrDeleteNeedsKey  = errors.New("delete requires a class with a single string key property")
+)
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+// Config controls the names used by the generated package.
+type Config struct {
+	Package     string // Go package name, e.g. general.
+	Schema      string // amt, cim or ips.
+	ServiceName string // Name of the service type. Defaults to the class name without its schema prefix.
+	FileName    string // Base name of the service source file. Defaults to the lower-cased service name.
+	FixturePath string // Directory under wsmantesting/responses holding the fixtures, e.g. amt/general.
+	Put         bool   // Generate Put even if the class definition has no writable properties.
+	Delete      bool   // Generate Delete.
+	Year        int    // Copyright year. Defaults to the current year.
+}
+
+// Output holds the generated files keyed by file name.
+type Output struct {
+	Sources  map[string][]byte // Go sources for the class package.
+	Fixtures map[string][]byte // XML responses for the generated tests.
+}
+
+type schema struct {
+	constant        string // message constant holding the resource URI base
+	resourceURIBase string
+	testingBase     string // wsmantesting constant holding the resource URI base
+}
+
+var schemas = map[string]schema{
+	"amt": {"message.AMTSchema", "http://intel.com/wbem/wscim/1/amt-schema/1/", "wsmantesting.AMTResourceURIBase"},
+	"cim": {"message.CIMSchema", "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/", "wsmantesting.CIMResourceURIBase"},
+	"ips": {"message.IPSSchema", "http://intel.com/wbem/wscim/1/ips-schema/1/", "wsmantesting.IPSResourceURIBase"},
+}
+
+type (
+	templateData struct {
+		Config
+		Class           Class
+		Base            string // class name without the schema prefix
+		ClassConstant   string
+		SchemaConstant  string
+		TestingBase     string
+		Namespace       string
+		Receiver        string
+		ResponseType    string
+		RequestType     string
+		ItemsField      string
+		Fields          []field
+		RequestFields   []field
+		Methods         []methodData
+		Enums           []enum
+		Key             *field
+		NeedsModels     bool
+		NeedsTime       bool
+		NeedsReference  bool
+		JSON            string
+		YAML            string
+		ExpectedGet     string
+		FixtureInstance string
+	}
+
+	field struct {
+		Name     string
+		GoType   string
+		Tag      string
+		Comment  string
+		Sample   string // Go literal of the value placed in the fixtures, empty when the fixture omits the field
+		Fixture  string // XML of the value placed in the fixtures
+		property Property
+	}
+
+	methodData struct {
+		Name           string
+		Comment        string
+		Inputs         []field
+		Outputs        []field
+		Expected       string
+		FixtureOutputs string
+	}
+
+	enum struct {
+		Name      string
+		Comment   string
+		MapName   string
+		Receiver  string
+		Constants []constant
+		valueMap  []string
+	}
+
+	constant struct {
+		Name  string
+		Value string
+		Text  string
+	}
+)
+
+// Generate renders the package for class.
+func Generate(class Class, config Config) (Output, error) {
+	data, err := newTemplateData(class, config)
+	if err != nil {
+		return Output{}, err
+	}
+
+	templates, err := template.New("").Funcs(template.FuncMap{
+		"quote": strconv.Quote,
+		"lower": strings.ToLower,
+		"param": lowerFirst,
+	}).ParseFS(templateFiles, "templates/*.tmpl")
+	if err != nil {
+		return Output{}, err
+	}
+
+	output := Output{Sources: map[string][]byte{}, Fixtures: map[string][]byte{}}
+
+	sources := map[string]string{
+		"types.go":                 "types.go.tmpl",
+		"decoder.go":               "decoder.go.tmpl",
+		"marshal.go":               "marshal.go.tmpl",
+		data.FileName + ".go":      "service.go.tmpl",
+		data.FileName + "_test.go": "service_test.go.tmpl",
+		"decoder_test.go":          "decoder_test.go.tmpl",
+	}
+
+	if len(data.Enums) == 0 {
+		delete(sources, "decoder_test.go")
+	}
+
+	for name, templateName := range sources {
+		source, err := render(templates, templateName, data)
+		if err != nil {
+			return Output{}, err
+		}
+
+		formatted, err := format.Source(source)
+		if err != nil {
+			return Output{}, fmt.Errorf("%s: %w", name, err)
+		}
+
+		output.Sources[name] = formatted
+	}
+
+	fixtures := map[string]fixture{
+		"get.xml":       {Action: "http://schemas.xmlsoap.org/ws/2004/09/transfer/GetResponse", Namespace: data.Namespace, Body: data.FixtureInstance},
+		"enumerate.xml": {Action: "http://schemas.xmlsoap.org/ws/2004/09/enumeration/EnumerateResponse", Namespace: enumerationNamespace, Body: enumerateFixtureBody},
+		"pull.xml":      {Action: "http://schemas.xmlsoap.org/ws/2004/09/enumeration/PullResponse", Namespace: enumerationNamespace, ItemNamespace: data.Namespace, Body: pullFixtureBody(data.FixtureInstance)},
+	}
+
+	if data.RequestType != "" {
+		fixtures["put.xml"] = fixture{Action: "http://schemas.xmlsoap.org/ws/2004/09/transfer/PutResponse", Namespace: data.Namespace, Body: data.FixtureInstance}
+	}
+
+	if data.Delete {
+		fixtures["delete.xml"] = fixture{Action: "http://schemas.xmlsoap.org/ws/2004/09/transfer/DeleteResponse", Namespace: data.Namespace}
+	}
+
+	for _, method := range data.Methods {
+		fixtures[strings.ToLower(method.Name)+".xml"] = fixture{
+			Action:    data.Namespace + "/" + method.Name + "Response",
+			Namespace: data.Namespace,
+			Body:      fmt.Sprintf("\n        <g:%s_OUTPUT>%s\n            <g:ReturnValue>0</g:ReturnValue>\n        </g:%s_OUTPUT>", method.Name, method.FixtureOutputs, method.Name),
+		}
+	}
+
+	for name, value := range fixtures {
+		value.ResourceURI = data.Namespace
+
+		rendered, err := render(templates, "fixture.xml.tmpl", value)
+		if err != nil {
+			return Output{}, err
+		}
+
+		output.Fixtures[name] = rendered
+	}
+
+	return output, nil
+}
+
+const (
+	enumerationNamespace = "http://schemas.xmlsoap.org/ws/2004/09/enumeration"
+	enumerateFixtureBody = "\n        <g:EnumerateResponse>\n            <g:EnumerationContext>14000000-0000-0000-0000-000000000000</g:EnumerationContext>\n        </g:EnumerateResponse>"
+	fixtureDateTime      = "2024-01-01T00:00:00Z"
+)
+
+type fixture struct {
+	Action        string
+	Namespace     string
+	ItemNamespace string
+	ResourceURI   string
+	Body          string
+}
+
+func pullFixtureBody(instance string) string {
+	items := strings.ReplaceAll(instance, "<g:", "<h:")
+	items = strings.ReplaceAll(items, "</g:", "</h:")
+	items = strings.ReplaceAll(items, "\n", "\n        ")
+
+	return "\n        <g:PullResponse>\n            <g:Items>" + items + "\n            </g:Items>\n            <g:EndOfSequence></g:EndOfSequence>\n        </g:PullResponse>"
+}
+
+func render(templates *template.Template, name string, data interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	if err := templates.ExecuteTemplate(&buffer, name, data); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func newTemplateData(class Class, config Config) (templateData, error) {
+	selected, ok := schemas[strings.ToLower(config.Schema)]
+	if !ok {
+		return templateData{}, ErrUnknownSchema
+	}
+
+	if config.Package == "" {
+		return templateData{}, ErrMissingPackage
+	}
+
+	if config.FixturePath == "" {
+		return templateData{}, ErrMissingFixtures
+	}
+
+	base := class.Name
+	if index := strings.Index(base, "_"); index >= 0 {
+		base = base[index+1:]
+	}
+
+	if config.ServiceName == "" {
+		config.ServiceName = base
+	}
+
+	if config.FileName == "" {
+		config.FileName = strings.ToLower(config.ServiceName)
+	}
+
+	if config.Year == 0 {
+		config.Year = time.Now().Year()
+	}
+
+	config.Put = config.Put || class.Writable()
+
+	data := templateData{
+		Config:         config,
+		Class:          class,
+		Base:           base,
+		ClassConstant:  strings.ReplaceAll(class.Name, "_", ""),
+		SchemaConstant: selected.constant,
+		TestingBase:    selected.testingBase,
+		Namespace:      selected.resourceURIBase + class.Name,
+		Receiver:       strings.ToLower(config.ServiceName[:1]),
+		ResponseType:   base + "Response",
+		ItemsField:     base + "Items",
+	}
+
+	enums := enumSet{byName: map[string]int{}}
+
+	for _, property := range class.Properties {
+		data.Fields = append(data.Fields, data.newField(property, enums.add("", property), false))
+	}
+
+	if config.Put {
+		data.RequestType = base + "Request"
+
+		for _, property := range class.Properties {
+			if property.Key || property.Write || !class.Writable() {
+				data.RequestFields = append(data.RequestFields, data.newField(property, enums.add("", property), true))
+			}
+		}
+	}
+
+	keys := []field{}
+
+	for _, current := range data.Fields {
+		if current.property.Key {
+			keys = append(keys, current)
+		}
+	}
+
+	if len(keys) == 1 && keys[0].GoType == "string" {
+		data.Key = &keys[0]
+	}
+
+	if config.Delete && data.Key == nil {
+		return data, ErrDeleteNeedsKey
+	}
+
+	for _, method := range class.Methods {
+		data.Methods = append(data.Methods, data.newMethod(method, &enums))
+	}
+
+	data.Enums = enums.enums
+	data.JSON, data.YAML = data.zeroBody()
+	data.ExpectedGet = data.expectedInstance()
+	data.FixtureInstance = data.fixtureInstance()
+
+	return data, nil
+}
+
+func (data *templateData) newMethod(method Method, enums *enumSet) methodData {
+	result := methodData{Name: method.Name, Comment: firstSentence(method.Description)}
+
+	if result.Comment == "" {
+		result.Comment = "invokes the " + method.Name + " method of " + data.Class.Name + "."
+	}
+
+	for _, parameter := range method.Inputs() {
+		result.Inputs = append(result.Inputs, data.newField(parameter.Property, enums.add(method.Name, parameter.Property), true))
+	}
+
+	expected := []string{}
+	fixtures := []string{}
+
+	for _, parameter := range method.Outputs() {
+		output := data.newField(parameter.Property, enums.add(method.Name, parameter.Property), false)
+		result.Outputs = append(result.Outputs, output)
+
+		if output.Sample != "" {
+			expected = append(expected, fmt.Sprintf("%s: %s,", output.Name, output.Sample))
+			fixtures = append(fixtures, output.Fixture)
+		}
+	}
+
+	result.Expected = strings.Join(expected, "\n")
+	result.FixtureOutputs = strings.Join(fixtures, "")
+
+	return result
+}
+
+func (data *templateData) newField(property Property, enumName string, input bool) field {
+	result := field{Name: property.Name, Comment: oneLine(property.Description), property: property}
+
+	elementType, sample, fixtureValue := data.goType(property, enumName, input)
+
+	result.GoType = elementType
+	if property.Array {
+		result.GoType = "[]" + elementType
+	}
+
+	tag := property.Name
+	if input {
+		tag = "h:" + tag
+	}
+
+	if omitEmpty(property, input) {
+		tag += ",omitempty"
+	}
+
+	result.Tag = fmt.Sprintf("`xml:\"%s\"`", tag)
+
+	if sample == "" {
+		return result
+	}
+
+	result.Sample = sample
+	if property.Array {
+		result.Sample = fmt.Sprintf("%s{%s}", result.GoType, sample)
+	}
+
+	result.Fixture = fmt.Sprintf("\n            <g:%s>%s</g:%s>", property.Name, fixtureValue, property.Name)
+
+	return result
+}
+
+// omitEmpty reports whether the element of property is left out when it holds the zero value.
+// Keys and required properties are always sent, as are writable properties in a request, so that false or 0 can be Put.
+func omitEmpty(property Property, input bool) bool {
+	return !property.Key && !property.Required && !(input && property.Write)
+}
+
+// goType returns the Go type of a single value of property, together with the Go literal and XML of its fixture value.
+func (data *templateData) goType(property Property, enumName string, input bool) (goType, sample, fixtureValue string) {
+	switch {
+	case property.Reference && input:
+		data.NeedsReference = true
+
+		return "*EndpointReferenceInput", "", ""
+	case property.Reference:
+		data.NeedsModels = true
+
+		return "models.AssociationReference", "", ""
+	case enumName != "":
+		return enumName, enumName + "(" + property.ValueMap[0] + ")", property.ValueMap[0]
+	}
+
+	switch strings.ToLower(property.Type) {
+	case "boolean":
+		return "bool", "true", "true"
+	case "uint8", "uint16", "uint32", "uint64", "sint8", "sint16", "sint32", "sint64":
+		return "int", "1", "1"
+	case "real32", "real64":
+		return "float64", "1.5", "1.5"
+	case "datetime":
+		if input {
+			return "string", "", ""
+		}
+
+		data.NeedsTime = true
+
+		return "Time", fmt.Sprintf("Time{DateTime: %q}", fixtureDateTime), "<g:Datetime>" + fixtureDateTime + "</g:Datetime>"
+	default:
+		return "string", strconv.Quote(property.Name), property.Name
+	}
+}
+
+// expectedInstance is the Go literal of the instance described by the fixtures.
+func (data *templateData) expectedInstance() string {
+	lines := []string{fmt.Sprintf("XMLName: xml.Name{Space: %q, Local: %s},", data.Namespace, data.ClassConstant)}
+
+	for _, current := range data.Fields {
+		if current.Sample != "" {
+			lines = append(lines, fmt.Sprintf("%s: %s,", current.Name, current.Sample))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (data *templateData) fixtureInstance() string {
+	var str strings.Builder
+
+	str.WriteString(fmt.Sprintf("\n        <g:%s>", data.Class.Name))
+
+	for _, current := range data.Fields {
+		str.WriteString(current.Fixture)
+	}
+
+	str.WriteString(fmt.Sprintf("\n        </g:%s>", data.Class.Name))
+
+	return str.String()
+}
+
+type enumSet struct {
+	enums  []enum
+	byName map[string]int
+}
+
+// add registers an enumeration for property and returns its type name, or an empty string if the property is not an enumeration.
+func (e *enumSet) add(scope string, property Property) string {
+	if property.Reference || len(property.ValueMap) == 0 || len(property.ValueMap) != len(property.Values) {
+		return ""
+	}
+
+	constants := []constant{}
+	used := map[string]bool{}
+	valueMap := []string{}
+
+	name := property.Name
+
+	for index, value := range property.ValueMap {
+		if _, err := strconv.Atoi(value); err != nil {
+			// ranges such as "2..32767" and vendor specific values are not enumerated
+			continue
+		}
+
+		constantName := name + identifier(property.Values[index])
+		if used[constantName] || constantName == name {
+			constantName += value
+		}
+
+		used[constantName] = true
+
+		constants = append(constants, constant{Name: constantName, Value: value, Text: property.Values[index]})
+		valueMap = append(valueMap, value)
+	}
+
+	if len(constants) == 0 {
+		return ""
+	}
+
+	if index, exists := e.byName[name]; exists {
+		if strings.Join(e.enums[index].valueMap, ",") == strings.Join(valueMap, ",") {
+			return name
+		}
+
+		if scope == "" {
+			return ""
+		}
+
+		// the same parameter name with a different value map in a method gets its own type
+		name = scope + name
+		if _, taken := e.byName[name]; taken {
+			return name
+		}
+
+		for index := range constants {
+			constants[index].Name = scope + constants[index].Name
+		}
+	}
+
+	e.byName[name] = len(e.enums)
+	e.enums = append(e.enums, enum{
+		Name:      name,
+		Comment:   enumComment(property),
+		MapName:   lowerFirst(name) + "ToString",
+		Receiver:  strings.ToLower(name[:1]),
+		Constants: constants,
+		valueMap:  valueMap,
+	})
+
+	return name
+}
+
+func enumComment(property Property) string {
+	description := oneLine(property.Description)
+	if description == "" {
+		description = property.Name + " is an enumeration."
+	}
+
+	return fmt.Sprintf("%s\n//\n// ValueMap={%s}\n//\n// Values={%s}.", description, strings.Join(property.ValueMap, ", "), strings.Join(property.Values, ", "))
+}
+
+// identifier converts a Values entry such as "Not Applicable" into an exported Go identifier fragment.
+func identifier(text string) string {
+	var str strings.Builder
+
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+
+		for _, r := range runes {
+			if r < unicode.MaxASCII {
+				str.WriteRune(r)
+			}
+		}
+	}
+
+	return str.String()
+}
+
+// lowerFirst returns name with its leading capitals lowered, e.g. InstanceID becomes instanceID.
+func lowerFirst(name string) string {
+	runes := []rune(name)
+
+	for index := range runes {
+		if !unicode.IsUpper(runes[index]) || (index > 0 && index+1 < len(runes) && unicode.IsLower(runes[index+1])) {
+			break
+		}
+
+		runes[index] = unicode.ToLower(runes[index])
+	}
+
+	return string(runes)
+}
+
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func firstSentence(text string) string {
+	text = oneLine(text)
+	if index := strings.Index(text, ". "); index >= 0 {
+		text = text[:index+1]
+	}
+
+	if text == "" {
+		return ""
+	}
+
+	runes := []rune(text)
+	runes[0] = unicode.ToLower(runes[0])
+
+	text = string(runes)
+	if !strings.HasSuffix(text, ".") {
+		text += "."
+	}
+
+	return text
+}
+
+// zeroBody returns the JSON and YAML encoding of an empty Body, as asserted by the generated TestJson and TestYaml.
+func (data *templateData) zeroBody() (jsonText, yamlText string) {
+	xmlName := node{name: "XMLName", children: []node{{name: "Space", json: `""`}, {name: "Local", json: `""`}}}
+	body := node{children: []node{xmlName}}
+
+	instance := node{name: "GetResponse", children: []node{xmlName}}
+	for _, current := range data.Fields {
+		instance.children = append(instance.children, zeroNode(current))
+	}
+
+	body.children = append(body.children,
+		instance,
+		node{name: "EnumerateResponse", children: []node{{name: "EnumerationContext", json: `""`}}},
+		node{name: "PullResponse", children: []node{xmlName, {name: data.ItemsField, json: "null", yaml: "[]"}}},
+	)
+
+	for _, method := range data.Methods {
+		output := node{name: method.Name + "_OUTPUT", children: []node{xmlName}}
+		for _, current := range method.Outputs {
+			output.children = append(output.children, zeroNode(current))
+		}
+
+		output.children = append(output.children, node{name: "ReturnValue", json: "0"})
+		body.children = append(body.children, output)
+	}
+
+	return body.toJSON(), body.toYAML(0)
+}
+
+type node struct {
+	name     string
+	json     string // literal value, empty for structures
+	yaml     string // literal value when it differs from json
+	children []node
+}
+
+func zeroNode(current field) node {
+	switch {
+	case strings.HasPrefix(current.GoType, "[]"):
+		return node{name: current.Name, json: "null", yaml: "[]"}
+	case current.GoType == "string":
+		return node{name: current.Name, json: `""`}
+	case current.GoType == "bool":
+		return node{name: current.Name, json: "false"}
+	case current.GoType == "Time":
+		return node{name: current.Name, children: []node{{name: "DateTime", json: `""`}}}
+	case current.GoType == "models.AssociationReference":
+		xmlName := node{name: "XMLName", children: []node{{name: "Space", json: `""`}, {name: "Local", json: `""`}}}
+
+		return node{name: current.Name, children: []node{
+			{name: "Address", json: `""`},
+			{name: "ReferenceParameters", children: []node{
+				xmlName,
+				{name: "ResourceURI", json: `""`},
+				{name: "SelectorSet", children: []node{xmlName, {name: "Selectors", json: "null", yaml: "[]"}}},
+			}},
+		}}
+	default:
+		return node{name: current.Name, json: "0"}
+	}
+}
+
+func (n node) toJSON() string {
+	if n.json != "" {
+		return n.json
+	}
+
+	members := []string{}
+	for _, child := range n.children {
+		members = append(members, strconv.Quote(child.name)+":"+child.toJSON())
+	}
+
+	return "{" + strings.Join(members, ",") + "}"
+}
+
+func (n node) toYAML(depth int) string {
+	var str strings.Builder
+
+	indent := strings.Repeat("    ", depth)
+
+	for _, child := range n.children {
+		name := strings.ToLower(child.name)
+
+		switch {
+		case child.yaml != "":
+			str.WriteString(fmt.Sprintf("%s%s: %s\n", indent, name, child.yaml))
+		case child.json != "":
+			str.WriteString(fmt.Sprintf("%s%s: %s\n", indent, name, child.json))
+		case len(child.children) == 0:
+			str.WriteString(fmt.Sprintf("%s%s: {}\n", indent, name))
+		default:
+			str.WriteString(fmt.Sprintf("%s%s:\n%s", indent, name, child.toYAML(depth+1)))
+		}
+	}
+
+	return str.String()
+}
+
+// SortedNames returns the keys of files in lexical order so that output is written deterministically.
+func SortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
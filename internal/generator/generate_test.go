@@ -0,0 +1,135 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package generator
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadExample(t *testing.T) Class {
+	t.Helper()
+
+	file, err := os.Open("testdata/AMT_Example.mof")
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	classes, err := ParseMOF(file)
+	require.NoError(t, err)
+
+	class, ok := Resolve(classes, "AMT_Example")
+	require.True(t, ok)
+
+	return class
+}
+
+func TestGenerate(t *testing.T) {
+	output, err := Generate(loadExample(t), Config{Package: "example", Schema: "amt", FixturePath: "amt/example", Delete: true, Year: 2024})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"decoder.go", "decoder_test.go", "example.go", "example_test.go", "marshal.go", "types.go"}, SortedNames(output.Sources))
+	assert.Equal(t, []string{"delete.xml", "enumerate.xml", "get.xml", "pull.xml", "put.xml", "reset.xml", "setstate.xml"}, SortedNames(output.Fixtures))
+
+	types := string(output.Sources["types.go"])
+	assert.Contains(t, types, "Copyright (c) Intel Corporation 2024")
+	assert.Contains(t, types, "\n// Code generated by wsmangen; DO NOT EDIT.\n\npackage example\n")
+	assert.Contains(t, types, "type Example struct {")
+	assert.Contains(t, types, "ExampleItems []ExampleResponse `xml:\"Items>AMT_Example\"`")
+	assert.Contains(t, types, "Mode           Mode                        `xml:\"Mode,omitempty\"`           // The mode of the feature.")
+	assert.Contains(t, types, "SupportedModes []SupportedModes")
+	assert.Contains(t, types, "LastChanged    Time")
+	assert.Contains(t, types, "Certificate    models.AssociationReference")
+	assert.Contains(t, types, "Certificate    *EndpointReferenceInput `xml:\"h:Certificate,omitempty\"`")
+	assert.Contains(t, types, "RequestedState RequestedState          `xml:\"h:RequestedState\"`")
+	assert.Contains(t, types, "InstanceID string   `xml:\"h:InstanceID\"`")
+	assert.Contains(t, types, "Enabled    bool     `xml:\"h:Enabled\"`")
+	assert.Contains(t, types, "XMLName    xml.Name `xml:\"h:AMT_Example\"`")
+	assert.Contains(t, types, "// ValueMap={0, 1, 2, 3..32767, 32768..}")
+	assert.NotContains(t, types, "SupportedModes []SupportedModes `xml:\"h:")
+
+	decoder := string(output.Sources["decoder.go"])
+	assert.Contains(t, decoder, "AMTExample    string = \"AMT_Example\"")
+	assert.Contains(t, decoder, "ModeEnabledDefault Mode = 1")
+	assert.NotContains(t, decoder, "DMTFReserved")
+	assert.Contains(t, decoder, "func (r RequestedState) String() string {")
+
+	service := string(output.Sources["example.go"])
+	assert.Contains(t, service, "func NewExampleWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Example {")
+	assert.Contains(t, service, "func (e Example) Get(instanceID string) (response Response, err error) {")
+	assert.Contains(t, service, "func (e Example) Put(request ExampleRequest) (response Response, err error) {")
+	assert.Contains(t, service, "func (e Example) Delete(instanceID string) (response Response, err error) {")
+	assert.Contains(t, service, "// SetState sets the state of the feature.")
+	assert.Contains(t, service, "func (e Example) SetState(input SetState_INPUT) (response Response, err error) {")
+	assert.Contains(t, service, "func (e Example) Reset() (response Response, err error) {")
+	assert.Contains(t, service, "pkg/wsman/amt/methods")
+
+	tests := string(output.Sources["example_test.go"])
+	assert.Contains(t, tests, "PackageUnderTest: \"amt/example\"")
+	assert.Contains(t, tests, "Mode:           Mode(0),")
+
+	for name, source := range output.Sources {
+		assert.True(t, strings.Contains(string(source), "// Code generated by wsmangen; DO NOT EDIT."), name)
+	}
+
+	assert.Contains(t, string(output.Fixtures["get.xml"]), "<g:LastChanged><g:Datetime>2024-01-01T00:00:00Z</g:Datetime></g:LastChanged>")
+	assert.Contains(t, string(output.Fixtures["pull.xml"]), "<h:InstanceID>InstanceID</h:InstanceID>")
+	assert.Contains(t, string(output.Fixtures["setstate.xml"]), "<g:PreviousState>1</g:PreviousState>")
+}
+
+func TestGenerateXSD(t *testing.T) {
+	file, err := os.Open("testdata/AMT_Example.xsd")
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	class, err := ParseXSD(file)
+	require.NoError(t, err)
+
+	output, err := Generate(class, Config{Package: "example", Schema: "cim", FixturePath: "cim/example", ServiceName: "Settings", FileName: "settings"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"decoder.go", "marshal.go", "settings.go", "settings_test.go", "types.go"}, SortedNames(output.Sources))
+	assert.Contains(t, string(output.Sources["settings.go"]), "func (s Settings) Get() (response Response, err error) {")
+	assert.Contains(t, string(output.Sources["settings.go"]), "pkg/wsman/cim/methods")
+	assert.NotContains(t, string(output.Sources["settings.go"]), "Put(")
+}
+
+func TestNegativeGenerate(t *testing.T) {
+	class := loadExample(t)
+
+	tests := []struct {
+		name     string
+		class    Class
+		config   Config
+		expected error
+	}{
+		{"unknown schema", class, Config{Package: "example", Schema: "xyz", FixturePath: "amt/example"}, ErrUnknownSchema},
+		{"missing package", class, Config{Schema: "amt", FixturePath: "amt/example"}, ErrMissingPackage},
+		{"missing fixtures", class, Config{Package: "example", Schema: "amt"}, ErrMissingFixtures},
+		{"delete without key", Class{Name: "AMT_Keyless"}, Config{Package: "example", Schema: "amt", FixturePath: "amt/example", Delete: true}, ErrDeleteNeedsKey},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Generate(test.class, test.config)
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
+
+func TestIdentifiers(t *testing.T) {
+	assert.Equal(t, "NotApplicable", identifier("Not Applicable"))
+	assert.Equal(t, "8021x", identifier("802.1x"))
+	assert.Equal(t, "EnabledDefault", identifier("Enabled \\ Default"))
+	assert.Equal(t, "instanceID", lowerFirst("InstanceID"))
+	assert.Equal(t, "amtNetwork", lowerFirst("AMTNetwork"))
+	assert.Equal(t, "sets the state.", firstSentence("Sets the state. More text."))
+}
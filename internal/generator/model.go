@@ -0,0 +1,206 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package generator
+
+import "strings"
+
+// Class is the schema-neutral description of a CIM class produced by the MOF and XSD parsers.
+type Class struct {
+	Name        string
+	Superclass  string
+	Description string
+	Properties  []Property
+	Methods     []Method
+}
+
+// Property describes a class property or method parameter.
+type Property struct {
+	Name        string
+	Type        string // CIM data type (string, boolean, uint16, datetime, ...) or the referenced class name when Reference is set.
+	Description string
+	Array       bool
+	Reference   bool
+	Key         bool
+	Write       bool
+	Required    bool
+	MaxLen      int
+	ValueMap    []string
+	Values      []string
+}
+
+// Method describes an extrinsic method of a class.
+type Method struct {
+	Name        string
+	Description string
+	Parameters  []Parameter
+}
+
+// Parameter describes a method parameter and its direction.
+type Parameter struct {
+	Property
+	In  bool
+	Out bool
+}
+
+// Inputs returns the parameters sent in the <Method>_INPUT element.
+func (m Method) Inputs() []Parameter {
+	parameters := []Parameter{}
+
+	for _, parameter := range m.Parameters {
+		if parameter.In || !parameter.Out {
+			parameters = append(parameters, parameter)
+		}
+	}
+
+	return parameters
+}
+
+// Outputs returns the parameters returned in the <Method>_OUTPUT element, excluding ReturnValue.
+func (m Method) Outputs() []Parameter {
+	parameters := []Parameter{}
+
+	for _, parameter := range m.Parameters {
+		if parameter.Out {
+			parameters = append(parameters, parameter)
+		}
+	}
+
+	return parameters
+}
+
+// Property returns the named property and whether it exists.
+func (c Class) Property(name string) (Property, bool) {
+	for _, property := range c.Properties {
+		if strings.EqualFold(property.Name, name) {
+			return property, true
+		}
+	}
+
+	return Property{}, false
+}
+
+// Writable reports whether the class has any property that can be changed with Put.
+func (c Class) Writable() bool {
+	for _, property := range c.Properties {
+		if property.Write {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Merge overlays the properties and methods of class on top of c, as a subclass overrides its superclass.
+func (c Class) Merge(class Class) Class {
+	merged := Class{
+		Name:        class.Name,
+		Superclass:  class.Superclass,
+		Description: class.Description,
+	}
+
+	merged.Properties = append(merged.Properties, c.Properties...)
+
+	for _, property := range class.Properties {
+		if index := indexOfProperty(merged.Properties, property.Name); index >= 0 {
+			merged.Properties[index] = overrideProperty(merged.Properties[index], property)
+
+			continue
+		}
+
+		merged.Properties = append(merged.Properties, property)
+	}
+
+	merged.Methods = append(merged.Methods, c.Methods...)
+
+	for _, method := range class.Methods {
+		if index := indexOfMethod(merged.Methods, method.Name); index >= 0 {
+			merged.Methods[index] = method
+
+			continue
+		}
+
+		merged.Methods = append(merged.Methods, method)
+	}
+
+	return merged
+}
+
+// Resolve flattens the inheritance hierarchy of the named class using the other classes in classes.
+// Classes whose superclass is not part of classes are returned with only their own members.
+func Resolve(classes []Class, name string) (Class, bool) {
+	byName := map[string]Class{}
+	for _, class := range classes {
+		byName[strings.ToLower(class.Name)] = class
+	}
+
+	class, ok := byName[strings.ToLower(name)]
+	if !ok {
+		return Class{}, false
+	}
+
+	chain := []Class{class}
+	visited := map[string]bool{strings.ToLower(class.Name): true}
+
+	for current := class; current.Superclass != ""; {
+		parent, exists := byName[strings.ToLower(current.Superclass)]
+		if !exists || visited[strings.ToLower(parent.Name)] {
+			break
+		}
+
+		visited[strings.ToLower(parent.Name)] = true
+		chain = append(chain, parent)
+		current = parent
+	}
+
+	resolved := Class{}
+	for index := len(chain) - 1; index >= 0; index-- {
+		resolved = resolved.Merge(chain[index])
+	}
+
+	return resolved, true
+}
+
+// overrideProperty applies a subclass redeclaration of a property, keeping the qualifiers it does not restate.
+func overrideProperty(parent, child Property) Property {
+	if child.Description == "" {
+		child.Description = parent.Description
+	}
+
+	if len(child.ValueMap) == 0 {
+		child.ValueMap = parent.ValueMap
+		child.Values = parent.Values
+	}
+
+	if child.MaxLen == 0 {
+		child.MaxLen = parent.MaxLen
+	}
+
+	child.Key = child.Key || parent.Key
+	child.Write = child.Write || parent.Write
+	child.Required = child.Required || parent.Required
+
+	return child
+}
+
+func indexOfProperty(properties []Property, name string) int {
+	for index := range properties {
+		if strings.EqualFold(properties[index].Name, name) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func indexOfMethod(methods []Method, name string) int {
+	for index := range methods {
+		if strings.EqualFold(methods[index].Name, name) {
+			return index
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,548 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrMOFSyntax = errors.New("mof syntax error")
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenNumber
+	tokenPunctuation
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	line  int
+	value string // decoded value of string tokens
+}
+
+// qualifiers maps lower-cased qualifier names to their values. Flag qualifiers such as Key have no values.
+type qualifiers map[string][]string
+
+func (q qualifiers) has(name string) bool {
+	_, ok := q[strings.ToLower(name)]
+
+	return ok
+}
+
+func (q qualifiers) text(name string) string {
+	return strings.Join(q[strings.ToLower(name)], "")
+}
+
+func (q qualifiers) list(name string) []string {
+	return q[strings.ToLower(name)]
+}
+
+// ParseMOF parses the class declarations of a DMTF Managed Object Format document.
+// Pragmas, qualifier declarations and instance declarations are skipped.
+func ParseMOF(r io.Reader) ([]Class, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := tokenize(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	p := mofParser{tokens: tokens}
+
+	return p.parse()
+}
+
+func tokenize(input string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(input)
+	line := 1
+
+	for index := 0; index < len(runes); {
+		r := runes[index]
+
+		switch {
+		case r == '\n':
+			line++
+			index++
+		case unicode.IsSpace(r):
+			index++
+		case r == '#':
+			// pragmas are not needed to describe classes
+			for index < len(runes) && runes[index] != '\n' {
+				index++
+			}
+		case r == '/' && index+1 < len(runes) && runes[index+1] == '/':
+			for index < len(runes) && runes[index] != '\n' {
+				index++
+			}
+		case r == '/' && index+1 < len(runes) && runes[index+1] == '*':
+			start := line
+			index += 2
+
+			for index+1 < len(runes) && (runes[index] != '*' || runes[index+1] != '/') {
+				if runes[index] == '\n' {
+					line++
+				}
+
+				index++
+			}
+
+			if index+1 >= len(runes) {
+				return nil, fmt.Errorf("%w: line %d: unterminated comment", ErrMOFSyntax, start)
+			}
+
+			index += 2
+		case r == '"':
+			value, length, err := readString(runes[index:])
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s", ErrMOFSyntax, line, err.Error())
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: string(runes[index : index+length]), value: value, line: line})
+			index += length
+		case r == '_' || unicode.IsLetter(r):
+			start := index
+			for index < len(runes) && (runes[index] == '_' || unicode.IsLetter(runes[index]) || unicode.IsDigit(runes[index])) {
+				index++
+			}
+
+			tokens = append(tokens, token{kind: tokenIdentifier, text: string(runes[start:index]), line: line})
+		case unicode.IsDigit(r) || ((r == '-' || r == '+') && index+1 < len(runes) && unicode.IsDigit(runes[index+1])):
+			start := index
+			index++
+
+			for index < len(runes) && (unicode.IsDigit(runes[index]) || unicode.IsLetter(runes[index]) || runes[index] == '.') {
+				index++
+			}
+
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[start:index]), line: line})
+		default:
+			tokens = append(tokens, token{kind: tokenPunctuation, text: string(r), line: line})
+			index++
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line}), nil
+}
+
+// readString decodes a double quoted MOF string literal, returning its value and its length in runes.
+func readString(runes []rune) (value string, length int, err error) {
+	var str strings.Builder
+
+	for index := 1; index < len(runes); index++ {
+		switch runes[index] {
+		case '"':
+			return str.String(), index + 1, nil
+		case '\\':
+			index++
+			if index >= len(runes) {
+				return "", 0, errors.New("unterminated string")
+			}
+
+			switch runes[index] {
+			case 'n':
+				str.WriteRune('\n')
+			case 't':
+				str.WriteRune('\t')
+			case 'r':
+				str.WriteRune('\r')
+			default:
+				str.WriteRune(runes[index])
+			}
+		default:
+			str.WriteRune(runes[index])
+		}
+	}
+
+	return "", 0, errors.New("unterminated string")
+}
+
+type mofParser struct {
+	tokens   []token
+	position int
+}
+
+func (p *mofParser) peek() token {
+	return p.tokens[p.position]
+}
+
+func (p *mofParser) next() token {
+	current := p.tokens[p.position]
+	if current.kind != tokenEOF {
+		p.position++
+	}
+
+	return current
+}
+
+func (p *mofParser) is(text string) bool {
+	return strings.EqualFold(p.peek().text, text) && p.peek().kind != tokenString
+}
+
+func (p *mofParser) expect(text string) error {
+	current := p.next()
+	if !strings.EqualFold(current.text, text) || current.kind == tokenString {
+		return fmt.Errorf("%w: line %d: expected %q, found %q", ErrMOFSyntax, current.line, text, current.text)
+	}
+
+	return nil
+}
+
+func (p *mofParser) identifier() (string, error) {
+	current := p.next()
+	if current.kind != tokenIdentifier {
+		return "", fmt.Errorf("%w: line %d: expected identifier, found %q", ErrMOFSyntax, current.line, current.text)
+	}
+
+	return current.text, nil
+}
+
+func (p *mofParser) parse() ([]Class, error) {
+	classes := []Class{}
+
+	for p.peek().kind != tokenEOF {
+		classQualifiers := qualifiers{}
+
+		if p.is("[") {
+			var err error
+
+			classQualifiers, err = p.qualifierList()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch {
+		case p.is("class"):
+			class, err := p.class(classQualifiers)
+			if err != nil {
+				return nil, err
+			}
+
+			classes = append(classes, class)
+		case p.is("instance"), p.is("qualifier"):
+			if err := p.skipDeclaration(); err != nil {
+				return nil, err
+			}
+		default:
+			current := p.peek()
+
+			return nil, fmt.Errorf("%w: line %d: unexpected %q", ErrMOFSyntax, current.line, current.text)
+		}
+	}
+
+	return classes, nil
+}
+
+// skipDeclaration skips an instance or qualifier declaration up to its terminating semicolon.
+func (p *mofParser) skipDeclaration() error {
+	depth := 0
+
+	for {
+		current := p.next()
+
+		switch {
+		case current.kind == tokenEOF:
+			return fmt.Errorf("%w: line %d: unterminated declaration", ErrMOFSyntax, current.line)
+		case current.kind != tokenPunctuation:
+		case current.text == "{" || current.text == "(" || current.text == "[":
+			depth++
+		case current.text == "}" || current.text == ")" || current.text == "]":
+			depth--
+		case current.text == ";" && depth == 0:
+			return nil
+		}
+	}
+}
+
+func (p *mofParser) class(classQualifiers qualifiers) (Class, error) {
+	class := Class{Description: classQualifiers.text("Description")}
+
+	if err := p.expect("class"); err != nil {
+		return class, err
+	}
+
+	name, err := p.identifier()
+	if err != nil {
+		return class, err
+	}
+
+	class.Name = name
+
+	if p.is(":") {
+		p.next()
+
+		if class.Superclass, err = p.identifier(); err != nil {
+			return class, err
+		}
+	}
+
+	if err := p.expect("{"); err != nil {
+		return class, err
+	}
+
+	for !p.is("}") {
+		if p.peek().kind == tokenEOF {
+			return class, fmt.Errorf("%w: class %s is not terminated", ErrMOFSyntax, class.Name)
+		}
+
+		if err := p.feature(&class); err != nil {
+			return class, err
+		}
+	}
+
+	p.next()
+
+	if err := p.expect(";"); err != nil {
+		return class, err
+	}
+
+	return class, nil
+}
+
+// feature parses a property, reference or method declaration.
+func (p *mofParser) feature(class *Class) error {
+	featureQualifiers := qualifiers{}
+
+	if p.is("[") {
+		var err error
+
+		if featureQualifiers, err = p.qualifierList(); err != nil {
+			return err
+		}
+	}
+
+	property, err := p.typedName(featureQualifiers)
+	if err != nil {
+		return err
+	}
+
+	if p.is("(") {
+		method, err := p.method(property.Name, featureQualifiers)
+		if err != nil {
+			return err
+		}
+
+		class.Methods = append(class.Methods, method)
+
+		return p.expect(";")
+	}
+
+	if p.is("=") {
+		// default values are not part of the generated types
+		for !p.is(";") && p.peek().kind != tokenEOF {
+			p.next()
+		}
+	}
+
+	class.Properties = append(class.Properties, property)
+
+	return p.expect(";")
+}
+
+// typedName parses "type [REF] name [array]" and applies the qualifiers describing it.
+func (p *mofParser) typedName(featureQualifiers qualifiers) (Property, error) {
+	dataType, err := p.identifier()
+	if err != nil {
+		return Property{}, err
+	}
+
+	property := Property{Type: dataType}
+
+	if p.is("ref") && p.tokens[p.position+1].kind == tokenIdentifier {
+		p.next()
+
+		property.Reference = true
+	}
+
+	if property.Name, err = p.identifier(); err != nil {
+		return property, err
+	}
+
+	if p.is("[") {
+		p.next()
+
+		for !p.is("]") {
+			if p.peek().kind == tokenEOF {
+				return property, fmt.Errorf("%w: unterminated array of %s", ErrMOFSyntax, property.Name)
+			}
+
+			p.next()
+		}
+
+		p.next()
+
+		property.Array = true
+	}
+
+	property.Description = featureQualifiers.text("Description")
+	property.Key = featureQualifiers.has("Key")
+	property.Write = featureQualifiers.has("Write")
+	property.Required = featureQualifiers.has("Required")
+	property.ValueMap = featureQualifiers.list("ValueMap")
+	property.Values = featureQualifiers.list("Values")
+
+	if maxLen := featureQualifiers.text("MaxLen"); maxLen != "" {
+		if property.MaxLen, err = strconv.Atoi(maxLen); err != nil {
+			return property, fmt.Errorf("%w: invalid MaxLen %q for %s", ErrMOFSyntax, maxLen, property.Name)
+		}
+	}
+
+	return property, nil
+}
+
+func (p *mofParser) method(name string, methodQualifiers qualifiers) (Method, error) {
+	method := Method{Name: name, Description: methodQualifiers.text("Description")}
+
+	if err := p.expect("("); err != nil {
+		return method, err
+	}
+
+	for !p.is(")") {
+		parameterQualifiers := qualifiers{}
+
+		if p.is("[") {
+			var err error
+
+			if parameterQualifiers, err = p.qualifierList(); err != nil {
+				return method, err
+			}
+		}
+
+		property, err := p.typedName(parameterQualifiers)
+		if err != nil {
+			return method, err
+		}
+
+		method.Parameters = append(method.Parameters, Parameter{
+			Property: property,
+			In:       parameterQualifiers.has("IN"),
+			Out:      parameterQualifiers.has("OUT"),
+		})
+
+		if p.is(",") {
+			p.next()
+		}
+	}
+
+	p.next()
+
+	return method, nil
+}
+
+// qualifierList parses "[Name, Name(value), Name{value, value}, ...]".
+func (p *mofParser) qualifierList() (qualifiers, error) {
+	list := qualifiers{}
+
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+
+	for !p.is("]") {
+		name, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+
+		values := []string{}
+
+		switch {
+		case p.is("("):
+			p.next()
+
+			value, err := p.literal()
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+		case p.is("{"):
+			if values, err = p.literalArray(); err != nil {
+				return nil, err
+			}
+		}
+
+		list[strings.ToLower(name)] = values
+
+		// flavors such as ": ToSubclass" do not affect the generated types
+		if p.is(":") {
+			p.next()
+
+			for p.peek().kind == tokenIdentifier {
+				p.next()
+			}
+		}
+
+		if p.is(",") {
+			p.next()
+		}
+	}
+
+	p.next()
+
+	return list, nil
+}
+
+func (p *mofParser) literalArray() ([]string, error) {
+	values := []string{}
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for !p.is("}") {
+		value, err := p.literal()
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+
+		if p.is(",") {
+			p.next()
+		}
+	}
+
+	p.next()
+
+	return values, nil
+}
+
+// literal returns the value of a constant, joining adjacent string literals as MOF does.
+func (p *mofParser) literal() (string, error) {
+	current := p.next()
+
+	switch current.kind {
+	case tokenString:
+		value := current.value
+		for p.peek().kind == tokenString {
+			value += p.next().value
+		}
+
+		return value, nil
+	case tokenNumber, tokenIdentifier:
+		return current.text, nil
+	default:
+		return "", fmt.Errorf("%w: line %d: expected a value, found %q", ErrMOFSyntax, current.line, current.text)
+	}
+}
@@ -0,0 +1,124 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package generator
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMOF(t *testing.T) {
+	file, err := os.Open("testdata/AMT_Example.mof")
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	classes, err := ParseMOF(file)
+	require.NoError(t, err)
+	require.Len(t, classes, 2)
+
+	managedElement := classes[0]
+	assert.Equal(t, "CIM_ManagedElement", managedElement.Name)
+	assert.Equal(t, "ManagedElement is an abstract class that provides a common superclass for the non-association classes in the CIM Schema.", managedElement.Description)
+	assert.True(t, managedElement.Properties[0].Key)
+	assert.Equal(t, 256, managedElement.Properties[1].MaxLen)
+
+	example := classes[1]
+	assert.Equal(t, "AMT_Example", example.Name)
+	assert.Equal(t, "CIM_ManagedElement", example.Superclass)
+	assert.Len(t, example.Properties, 6)
+
+	mode, ok := example.Property("Mode")
+	require.True(t, ok)
+	assert.True(t, mode.Write)
+	assert.Equal(t, "uint16", mode.Type)
+	assert.Equal(t, []string{"0", "1", "2", "3..32767", "32768.."}, mode.ValueMap)
+	assert.Equal(t, "Enabled \\ Default", mode.Values[1])
+
+	supportedModes, _ := example.Property("SupportedModes")
+	assert.True(t, supportedModes.Array)
+
+	timeout, _ := example.Property("Timeout")
+	assert.Equal(t, "uint32", timeout.Type)
+
+	certificate, _ := example.Property("Certificate")
+	assert.True(t, certificate.Reference)
+	assert.Equal(t, "AMT_PublicKeyCertificate", certificate.Type)
+
+	require.Len(t, example.Methods, 2)
+
+	setState := example.Methods[0]
+	assert.Equal(t, "SetState", setState.Name)
+	assert.Equal(t, "Sets the state of the feature. Additional details follow.", setState.Description)
+	assert.Len(t, setState.Inputs(), 2)
+	assert.Len(t, setState.Outputs(), 2)
+	assert.True(t, setState.Parameters[0].Required)
+	assert.Equal(t, []string{"Off", "On"}, setState.Parameters[0].Values)
+	assert.True(t, setState.Parameters[2].Reference)
+
+	assert.Equal(t, "Reset", example.Methods[1].Name)
+	assert.Empty(t, example.Methods[1].Parameters)
+}
+
+func TestResolve(t *testing.T) {
+	file, err := os.Open("testdata/AMT_Example.mof")
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	classes, err := ParseMOF(file)
+	require.NoError(t, err)
+
+	class, ok := Resolve(classes, "amt_example")
+	require.True(t, ok)
+	assert.Equal(t, "AMT_Example", class.Name)
+	assert.Len(t, class.Properties, 8)
+	assert.Equal(t, "InstanceID", class.Properties[0].Name)
+	assert.Equal(t, "Example settings used to test the generator.", class.Description)
+
+	_, ok = Resolve(classes, "AMT_Missing")
+	assert.False(t, ok)
+}
+
+func TestResolveOverride(t *testing.T) {
+	classes := []Class{
+		{Name: "CIM_Parent", Properties: []Property{{Name: "State", Type: "uint16", Description: "Parent description.", ValueMap: []string{"0"}, Values: []string{"Off"}}}},
+		{Name: "AMT_Child", Superclass: "CIM_Parent", Properties: []Property{{Name: "State", Type: "uint16", Write: true}}},
+	}
+
+	class, ok := Resolve(classes, "AMT_Child")
+	require.True(t, ok)
+	require.Len(t, class.Properties, 1)
+	assert.True(t, class.Properties[0].Write)
+	assert.Equal(t, "Parent description.", class.Properties[0].Description)
+	assert.Equal(t, []string{"Off"}, class.Properties[0].Values)
+}
+
+func TestNegativeParseMOF(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unterminated comment", "/* class"},
+		{"unterminated string", `[Description("missing)] class A {};`},
+		{"unterminated class", "class A { string B;"},
+		{"missing semicolon", "class A { string B }; "},
+		{"unexpected token", "string B;"},
+		{"invalid MaxLen", "class A { [MaxLen(\"x\")] string B; };"},
+		{"unterminated instance", "instance of A { B = 1;"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseMOF(strings.NewReader(test.input))
+			assert.ErrorIs(t, err, ErrMOFSyntax)
+		})
+	}
+}
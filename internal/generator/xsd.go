@@ -0,0 +1,261 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package generator
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var ErrXSDClassNotFound = errors.New("xsd does not define a class type")
+
+const (
+	inputSuffix  = "_INPUT"
+	outputSuffix = "_OUTPUT"
+	typeSuffix   = "_Type"
+	returnValue  = "ReturnValue"
+)
+
+// xsdTypes maps the WS-CIM and XML schema data types to CIM data types.
+var xsdTypes = map[string]string{
+	"cimString":        "string",
+	"cimBoolean":       "boolean",
+	"cimByte":          "sint8",
+	"cimUnsignedByte":  "uint8",
+	"cimShort":         "sint16",
+	"cimUnsignedShort": "uint16",
+	"cimInt":           "sint32",
+	"cimUnsignedInt":   "uint32",
+	"cimLong":          "sint64",
+	"cimUnsignedLong":  "uint64",
+	"cimFloat":         "real32",
+	"cimDouble":        "real64",
+	"cimChar16":        "char16",
+	"cimDateTime":      "datetime",
+	"cimReference":     "ref",
+	"cimAnySimpleType": "string",
+	"string":           "string",
+	"boolean":          "boolean",
+	"byte":             "sint8",
+	"unsignedByte":     "uint8",
+	"short":            "sint16",
+	"unsignedShort":    "uint16",
+	"int":              "sint32",
+	"unsignedInt":      "uint32",
+	"long":             "sint64",
+	"unsignedLong":     "uint64",
+	"base64Binary":     "string",
+	"hexBinary":        "string",
+	"dateTime":         "datetime",
+}
+
+// xsdNode captures an arbitrary schema element.
+type xsdNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Text     string     `xml:",chardata"`
+	Children []xsdNode  `xml:",any"`
+}
+
+func (n *xsdNode) attr(name string) string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+// find returns the descendants with the given local name, without descending into matches.
+func (n *xsdNode) find(local string) []*xsdNode {
+	found := []*xsdNode{}
+
+	for index := range n.Children {
+		child := &n.Children[index]
+		if child.XMLName.Local == local {
+			found = append(found, child)
+
+			continue
+		}
+
+		found = append(found, child.find(local)...)
+	}
+
+	return found
+}
+
+// documentation returns the text of the annotation directly attached to the node.
+func (n *xsdNode) documentation() string {
+	for index := range n.Children {
+		if n.Children[index].XMLName.Local != "annotation" {
+			continue
+		}
+
+		for _, documentation := range n.Children[index].find("documentation") {
+			return strings.Join(strings.Fields(documentation.Text), " ")
+		}
+	}
+
+	return ""
+}
+
+// ParseXSD parses a WS-CIM class schema as published with the DMTF and Intel® AMT SDK schemas.
+// The class is the complexType named <Class>_Type, and methods are the <Method>_INPUT and <Method>_OUTPUT elements.
+func ParseXSD(r io.Reader) (Class, error) {
+	schema := xsdNode{}
+	if err := xml.NewDecoder(r).Decode(&schema); err != nil {
+		return Class{}, err
+	}
+
+	elements := map[string]*xsdNode{}
+	complexTypes := []*xsdNode{}
+
+	for index := range schema.Children {
+		child := &schema.Children[index]
+
+		switch child.XMLName.Local {
+		case "element":
+			elements[child.attr("name")] = child
+		case "complexType":
+			complexTypes = append(complexTypes, child)
+		}
+	}
+
+	class := Class{}
+
+	for _, complexType := range complexTypes {
+		name := complexType.attr("name")
+		if class.Name != "" || !strings.HasSuffix(name, typeSuffix) {
+			continue
+		}
+
+		class.Name = strings.TrimSuffix(name, typeSuffix)
+		class.Description = complexType.documentation()
+
+		for _, extension := range complexType.find("extension") {
+			class.Superclass = strings.TrimSuffix(localName(extension.attr("base")), typeSuffix)
+		}
+
+		for _, element := range complexType.find("element") {
+			class.Properties = append(class.Properties, xsdProperty(element, elements))
+		}
+	}
+
+	if class.Name == "" {
+		return class, ErrXSDClassNotFound
+	}
+
+	class.Methods = xsdMethods(schema.Children, elements)
+
+	return class, nil
+}
+
+func xsdMethods(children []xsdNode, elements map[string]*xsdNode) []Method {
+	methods := []Method{}
+
+	for index := range children {
+		child := &children[index]
+		name := child.attr("name")
+
+		if child.XMLName.Local != "element" || !strings.HasSuffix(name, inputSuffix) {
+			continue
+		}
+
+		method := Method{Name: strings.TrimSuffix(name, inputSuffix), Description: child.documentation()}
+
+		for _, element := range child.find("element") {
+			method.Parameters = append(method.Parameters, Parameter{Property: xsdProperty(element, elements), In: true})
+		}
+
+		if output, ok := elements[method.Name+outputSuffix]; ok {
+			for _, element := range output.find("element") {
+				parameter := Parameter{Property: xsdProperty(element, elements), Out: true}
+				if parameter.Name == returnValue || localName(element.attr("ref")) == returnValue {
+					continue
+				}
+
+				method.Parameters = append(method.Parameters, parameter)
+			}
+		}
+
+		methods = append(methods, method)
+	}
+
+	return methods
+}
+
+// xsdProperty describes an element of a sequence, following references to top-level element declarations.
+func xsdProperty(element *xsdNode, elements map[string]*xsdNode) Property {
+	property := Property{
+		Name:     element.attr("name"),
+		Array:    element.attr("maxOccurs") == "unbounded",
+		Required: element.attr("minOccurs") != "0",
+	}
+
+	if maxOccurs, err := strconv.Atoi(element.attr("maxOccurs")); err == nil && maxOccurs > 1 {
+		property.Array = true
+	}
+
+	declaration := element
+
+	if ref := element.attr("ref"); ref != "" {
+		property.Name = localName(ref)
+
+		if referenced, ok := elements[property.Name]; ok {
+			declaration = referenced
+		}
+	}
+
+	property.Description = declaration.documentation()
+	property.Type = xsdType(declaration)
+
+	if property.Type == "ref" {
+		property.Type = "CIM_ManagedElement"
+		property.Reference = true
+	}
+
+	for _, enumeration := range declaration.find("enumeration") {
+		property.ValueMap = append(property.ValueMap, enumeration.attr("value"))
+	}
+
+	for _, maxLength := range declaration.find("maxLength") {
+		property.MaxLen, _ = strconv.Atoi(maxLength.attr("value"))
+	}
+
+	return property
+}
+
+func xsdType(declaration *xsdNode) string {
+	candidates := []string{declaration.attr("type")}
+
+	for _, restriction := range declaration.find("restriction") {
+		candidates = append(candidates, restriction.attr("base"))
+	}
+
+	for _, extension := range declaration.find("extension") {
+		candidates = append(candidates, extension.attr("base"))
+	}
+
+	for _, candidate := range candidates {
+		if dataType, ok := xsdTypes[localName(candidate)]; ok {
+			return dataType
+		}
+	}
+
+	return "string"
+}
+
+func localName(qualifiedName string) string {
+	if index := strings.LastIndex(qualifiedName, ":"); index >= 0 {
+		return qualifiedName[index+1:]
+	}
+
+	return qualifiedName
+}
@@ -0,0 +1,67 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package generator
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXSD(t *testing.T) {
+	file, err := os.Open("testdata/AMT_Example.xsd")
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	class, err := ParseXSD(file)
+	require.NoError(t, err)
+
+	assert.Equal(t, "AMT_Example", class.Name)
+	assert.Equal(t, "CIM_SettingData", class.Superclass)
+	assert.Equal(t, "Example settings.", class.Description)
+	require.Len(t, class.Properties, 6)
+
+	hostName, _ := class.Property("HostName")
+	assert.Equal(t, "string", hostName.Type)
+	assert.Equal(t, 63, hostName.MaxLen)
+	assert.Equal(t, "Host name of the device.", hostName.Description)
+
+	family, _ := class.Property("PreferredAddressFamily")
+	assert.Equal(t, "uint32", family.Type)
+	assert.Equal(t, []string{"0", "1"}, family.ValueMap)
+
+	enabled, _ := class.Property("Enabled")
+	assert.Equal(t, "boolean", enabled.Type)
+
+	addresses, _ := class.Property("Addresses")
+	assert.True(t, addresses.Array)
+
+	owner, _ := class.Property("Owner")
+	assert.True(t, owner.Reference)
+	assert.False(t, owner.Required)
+
+	require.Len(t, class.Methods, 1)
+	assert.Equal(t, "Commit", class.Methods[0].Name)
+	require.Len(t, class.Methods[0].Inputs(), 2)
+	assert.Equal(t, "Force", class.Methods[0].Inputs()[0].Name)
+	assert.False(t, class.Methods[0].Inputs()[0].Required)
+	assert.Equal(t, "Reason", class.Methods[0].Inputs()[1].Name)
+	assert.True(t, class.Methods[0].Inputs()[1].Required)
+	require.Len(t, class.Methods[0].Outputs(), 1)
+	assert.Equal(t, "Handle", class.Methods[0].Outputs()[0].Name)
+}
+
+func TestNegativeParseXSD(t *testing.T) {
+	_, err := ParseXSD(strings.NewReader(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"></xs:schema>`))
+	assert.ErrorIs(t, err, ErrXSDClassNotFound)
+
+	_, err = ParseXSD(strings.NewReader(`<xs:schema`))
+	assert.Error(t, err)
+}
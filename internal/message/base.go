@@ -79,6 +79,22 @@ func (b *Base) Put(data interface{}, useHeaderSelector bool, selectorSet []Selec
 	return b.WSManMessageCreator.CreateXML(header, body)
 }
 
+// GetFragment retrieves only the fragment of the selected instance identified by fragment, typically the name of a single property.
+func (b *Base) GetFragment(fragment string, selectorSet []Selector) string {
+	header := b.WSManMessageCreator.CreateFragmentHeader(BaseActionsGet, b.className, selectorSet, fragment)
+
+	return b.WSManMessageCreator.CreateXML(header, GetBody)
+}
+
+// PutFragment changes only the fragment of the selected instance identified by fragment, leaving the other properties untouched.
+// A slice value replaces every element of an array property.
+func (b *Base) PutFragment(fragment string, value interface{}, selectorSet []Selector) string {
+	header := b.WSManMessageCreator.CreateFragmentHeader(BaseActionsPut, b.className, selectorSet, fragment)
+	body := b.WSManMessageCreator.createFragmentBody(b.className, fragment, value)
+
+	return b.WSManMessageCreator.CreateXML(header, body)
+}
+
 // Creates a new instance of this class.
 func (b *Base) Create(data interface{}, selectorSet []Selector) string {
 	header := b.WSManMessageCreator.CreateHeader(BaseActionsCreate, b.className, selectorSet, "", "")
@@ -95,16 +111,28 @@ func (b *Base) RequestStateChange(actionName string, requestedState int) string
 	return b.WSManMessageCreator.CreateXML(header, body)
 }
 
-func (b *Base) Execute(message *client.Message) error {
-	if b.client != nil {
-		xmlResponse, err := b.client.Post(message.XMLInput)
-		message.XMLOutput = string(xmlResponse)
+// Validate runs the Validate method of request when WSManMessageCreator.ValidateRequests is set.
+func (b *Base) Validate(request interface{}) error {
+	if !b.WSManMessageCreator.ValidateRequests {
+		return nil
+	}
 
-		if err != nil {
-			return err
-		}
+	if validator, ok := request.(interface{ Validate() error }); ok {
+		return validator.Validate()
 	}
 
-	// potentially could return an error that says that client doesn't exist
 	return nil
 }
+
+// Execute sends message with the client of the service and stores the response in message.XMLOutput.
+// It fails with client.ErrNoClient when the service was created without a client.
+func (b *Base) Execute(message *client.Message) error {
+	if b.client == nil {
+		return client.ErrNoClient
+	}
+
+	xmlResponse, err := b.client.Post(message.XMLInput)
+	message.XMLOutput = string(xmlResponse)
+
+	return err
+}
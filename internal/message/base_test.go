@@ -19,6 +19,12 @@ type MockClient struct {
 	Err error
 }
 
+type validatedRequest struct {
+	Err error
+}
+
+func (r validatedRequest) Validate() error { return r.Err }
+
 const (
 	TestData    = "test-data"
 	TestAction  = "test-action"
@@ -50,6 +56,19 @@ func TestBaseWithClient(t *testing.T) {
 		err := base.Execute(&message)
 		assert.NoError(t, err)
 	})
+	t.Run("Validate skips validation unless the message creator requests it", func(t *testing.T) {
+		err := base.Validate(validatedRequest{Err: errors.New("invalid")})
+		assert.NoError(t, err)
+	})
+	t.Run("Validate runs the request validation", func(t *testing.T) {
+		mockWsmanMessageCreator.ValidateRequests = true
+		defer func() { mockWsmanMessageCreator.ValidateRequests = false }()
+
+		err := base.Validate(validatedRequest{Err: errors.New("invalid")})
+		assert.EqualError(t, err, "invalid")
+		assert.NoError(t, base.Validate(validatedRequest{}))
+		assert.NoError(t, base.Validate(TestData))
+	})
 	t.Run("Execute returns error", func(t *testing.T) {
 		mockClient.Err = errors.New("test error")
 		message := client.Message{
@@ -131,6 +150,14 @@ func TestBase(t *testing.T) {
 	base := NewBase(mockWsmanMessageCreator, "TestClass")
 	MessageID := 0
 
+	t.Run("Execute without a client", func(t *testing.T) {
+		message := client.Message{
+			XMLInput: "TestMessage",
+		}
+		err := base.Execute(&message)
+		assert.ErrorIs(t, err, client.ErrNoClient)
+	})
+
 	t.Run("Enumerate", func(t *testing.T) {
 		expected := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Envelope xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:a=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:w=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\" xmlns=\"http://www.w3.org/2003/05/soap-envelope\"><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/enumeration/Enumerate</a:Action><a:To>/wsman</a:To><w:ResourceURI>test-uriTestClass</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout></Header><Body><Enumerate xmlns=\"http://schemas.xmlsoap.org/ws/2004/09/enumeration\" /></Body></Envelope>", MessageID)
 		MessageID++
@@ -180,6 +207,25 @@ func TestBase(t *testing.T) {
 		assert.Equal(t, expectedNoSelector, actualNoSelector)
 	})
 
+	t.Run("GetFragment", func(t *testing.T) {
+		expected := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Envelope xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:a=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:w=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\" xmlns=\"http://www.w3.org/2003/05/soap-envelope\"><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Get</a:Action><a:To>/wsman</a:To><w:ResourceURI>test-uriTestClass</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout><w:SelectorSet><w:Selector Name=\"Key\">Value</w:Selector></w:SelectorSet><w:FragmentTransfer xmlns:s=\"http://www.w3.org/2003/05/soap-envelope\" s:mustUnderstand=\"true\">Property</w:FragmentTransfer></Header><Body></Body></Envelope>", MessageID)
+		MessageID++
+		actual := base.GetFragment("Property", []Selector{{Name: "Key", Value: "Value"}})
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("PutFragment", func(t *testing.T) {
+		expected := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Envelope xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:a=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:w=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\" xmlns=\"http://www.w3.org/2003/05/soap-envelope\"><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Put</a:Action><a:To>/wsman</a:To><w:ResourceURI>test-uriTestClass</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout><w:FragmentTransfer xmlns:s=\"http://www.w3.org/2003/05/soap-envelope\" s:mustUnderstand=\"true\">Property</w:FragmentTransfer></Header><Body><w:XmlFragment><h:Property xmlns:h=\"test-uriTestClass\">true</h:Property></w:XmlFragment></Body></Envelope>", MessageID)
+		MessageID++
+		actual := base.PutFragment("Property", true, nil)
+		assert.Equal(t, expected, actual)
+
+		expectedArray := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Envelope xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:a=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:w=\"http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd\" xmlns=\"http://www.w3.org/2003/05/soap-envelope\"><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Put</a:Action><a:To>/wsman</a:To><w:ResourceURI>test-uriTestClass</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout><w:FragmentTransfer xmlns:s=\"http://www.w3.org/2003/05/soap-envelope\" s:mustUnderstand=\"true\">Names[1]</w:FragmentTransfer></Header><Body><w:XmlFragment><h:Names xmlns:h=\"test-uriTestClass\">a&amp;b</h:Names><h:Names xmlns:h=\"test-uriTestClass\">c</h:Names></w:XmlFragment></Body></Envelope>", MessageID)
+		MessageID++
+		actualArray := base.PutFragment("Names[1]", []string{"a&b", "c"}, nil)
+		assert.Equal(t, expectedArray, actualArray)
+	})
+
 	t.Run("Create", func(t *testing.T) {
 		data := TestData
 		selector := []Selector{{Name: "Key", Value: "Value"}}
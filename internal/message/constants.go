@@ -51,18 +51,22 @@ const (
 )
 
 const (
-	BaseActionsEnumerate = "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Enumerate"
-	BaseActionsPull      = "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Pull"
-	BaseActionsGet       = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Get"
-	BaseActionsPut       = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Put"
-	BaseActionsCreate    = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Create"
-	BaseActionsDelete    = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete"
-	DeleteBody           = "<Body></Body>"
-	EnumerateBody        = "<Body><Enumerate xmlns=\"http://schemas.xmlsoap.org/ws/2004/09/enumeration\" /></Body>"
-	GetBody              = "<Body></Body>"
-	AMTSchema            = "http://intel.com/wbem/wscim/1/amt-schema/1/"
-	CIMSchema            = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/"
-	IPSSchema            = "http://intel.com/wbem/wscim/1/ips-schema/1/"
-	XMLBodySpace         = "http://www.w3.org/2003/05/soap-envelope"
-	XMLPullResponseSpace = "http://schemas.xmlsoap.org/ws/2004/09/enumeration"
+	BaseActionsEnumerate   = "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Enumerate"
+	BaseActionsPull        = "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Pull"
+	BaseActionsGet         = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Get"
+	BaseActionsPut         = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Put"
+	BaseActionsCreate      = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Create"
+	BaseActionsDelete      = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete"
+	BaseActionsSubscribe   = "http://schemas.xmlsoap.org/ws/2004/08/eventing/Subscribe"
+	BaseActionsUnsubscribe = "http://schemas.xmlsoap.org/ws/2004/08/eventing/Unsubscribe"
+	DeleteBody             = "<Body></Body>"
+	EnumerateBody          = "<Body><Enumerate xmlns=\"http://schemas.xmlsoap.org/ws/2004/09/enumeration\" /></Body>"
+	GetBody                = "<Body></Body>"
+	AMTSchema              = "http://intel.com/wbem/wscim/1/amt-schema/1/"
+	CIMSchema              = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/"
+	IPSSchema              = "http://intel.com/wbem/wscim/1/ips-schema/1/"
+	XMLBodySpace           = "http://www.w3.org/2003/05/soap-envelope"
+	XMLPullResponseSpace   = "http://schemas.xmlsoap.org/ws/2004/09/enumeration"
+	XMLEventingSpace       = "http://schemas.xmlsoap.org/ws/2004/08/eventing"
+	EventingResourceURI    = "http://schemas.dmtf.org/wbem/wscim/1/*"
 )
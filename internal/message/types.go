@@ -59,4 +59,5 @@ type WSManMessageCreator struct {
 	AnonymousAddress string
 	DefaultTimeout   string
 	ResourceURIBase  string
+	ValidateRequests bool // When true, services validate request types against the constraints of their class before creating the message.
 }
@@ -106,6 +106,63 @@ func (w WSManMessageCreator) CreateBody(method, wsmanClass string, data interfac
 	return str.String()
 }
 
+// CreateFragmentHeader creates a header that limits a Get or Put to the fragment of the instance selected by fragment.
+// fragment is an XPath level 1 expression relative to the instance, typically the name of a single property.
+func (w *WSManMessageCreator) CreateFragmentHeader(action, wsmanClass string, selectorSet []Selector, fragment string) string {
+	header := strings.TrimSuffix(w.CreateHeader(action, wsmanClass, selectorSet, "", ""), "</Header>")
+
+	return fmt.Sprintf(`%s<w:FragmentTransfer xmlns:s=%q s:mustUnderstand="true">%s</w:FragmentTransfer></Header>`, header, XMLBodySpace, EscapeXML(fragment))
+}
+
+// createFragmentBody creates a body holding value as the fragment selected by fragment. Each element of a slice value becomes an element of the fragment.
+func (w WSManMessageCreator) createFragmentBody(wsmanClass, fragment string, value interface{}) string {
+	property := fragment
+	if index := strings.LastIndex(property, "/"); index >= 0 {
+		property = property[index+1:]
+	}
+
+	property, _, _ = strings.Cut(property, "[")
+
+	var body strings.Builder
+
+	body.WriteString("<Body><w:XmlFragment>")
+
+	values := reflect.ValueOf(value)
+	if values.Kind() != reflect.Slice {
+		values = reflect.ValueOf([]interface{}{value})
+	}
+
+	for index := 0; index < values.Len(); index++ {
+		body.WriteString(fmt.Sprintf(`<h:%s xmlns:h="%s%s">%s</h:%s>`, property, w.ResourceURIBase, wsmanClass, EscapeXML(fmt.Sprintf("%v", values.Index(index).Interface())), property))
+	}
+
+	body.WriteString("</w:XmlFragment></Body>")
+
+	return body.String()
+}
+
+// CreateEventingHeader creates a header for a WS-Eventing action. Events are not subscribed to through a single class, so the resource URI is EventingResourceURI.
+// A Subscribe selects the filter collection with selectorSet, and an Unsubscribe selects the subscription with the identifier returned by the Subscribe.
+func (w *WSManMessageCreator) CreateEventingHeader(action string, selectorSet []Selector, identifier string) string {
+	header := strings.TrimSuffix(w.CreateHeader(action, "", selectorSet, "", ""), "</Header>")
+	header = strings.Replace(header, fmt.Sprintf("<w:ResourceURI>%s</w:ResourceURI>", w.ResourceURIBase), fmt.Sprintf("<w:ResourceURI>%s</w:ResourceURI>", EventingResourceURI), 1)
+
+	if identifier != "" {
+		header += fmt.Sprintf(`<e:Identifier xmlns:e=%q>%s</e:Identifier>`, XMLEventingSpace, EscapeXML(identifier))
+	}
+
+	return header + "</Header>"
+}
+
+// EscapeXML returns text escaped for use in XML character data and attribute values.
+func EscapeXML(text string) string {
+	var escaped strings.Builder
+
+	_ = xml.EscapeText(&escaped, []byte(text))
+
+	return escaped.String()
+}
+
 // createSelector creates a WSMAN string based on Selector Set information provided.
 // It can be used in the header or body.
 // selectorSet is the selector data being passed in. It could take many forms depending on the WSMAN call.
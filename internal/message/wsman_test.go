@@ -74,6 +74,22 @@ func TestCreateHeader(t *testing.T) {
 
 		assert.Equal(t, correctHeader, header)
 	})
+
+	t.Run("creates a correct header for a WS-Eventing subscribe", func(t *testing.T) {
+		correctHeader := fmt.Sprintf(`<Header><a:Action>http://schemas.xmlsoap.org/ws/2004/08/eventing/Subscribe</a:Action><a:To>/wsman</a:To><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/*</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT Device 0</w:Selector></w:SelectorSet></Header>`, messageID)
+		header := wsmanMessageCreator.CreateEventingHeader(BaseActionsSubscribe, selector, "")
+		messageID++
+
+		assert.Equal(t, correctHeader, header)
+	})
+
+	t.Run("creates a correct header for a WS-Eventing unsubscribe", func(t *testing.T) {
+		correctHeader := fmt.Sprintf(`<Header><a:Action>http://schemas.xmlsoap.org/ws/2004/08/eventing/Unsubscribe</a:Action><a:To>/wsman</a:To><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/*</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout><e:Identifier xmlns:e="http://schemas.xmlsoap.org/ws/2004/08/eventing">uuid:00000000-8086-8086-8086-000000000001</e:Identifier></Header>`, messageID)
+		header := wsmanMessageCreator.CreateEventingHeader(BaseActionsUnsubscribe, nil, "uuid:00000000-8086-8086-8086-000000000001")
+		messageID++
+
+		assert.Equal(t, correctHeader, header)
+	})
 }
 
 type TestStruct struct {
@@ -4,10 +4,15 @@ import (
 	"crypto/md5"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
+// ErrInvalidSid is returned by GetSidBytes for a string that is not a SID.
+var ErrInvalidSid = errors.New("invalid SID")
+
 // ReadShort reads a short value from a string at position p.
 func ReadShort(v string, p int) int {
 	return int(v[p])<<8 + int(v[p+1])
@@ -115,3 +120,42 @@ func GetSidString(sid string) string {
 
 	return value
 }
+
+// GetSidBytes converts a SID string such as S-1-5-21-1004336348-1177238915-682003330-512 into its byte array, the reverse of GetSidString.
+func GetSidBytes(sid string) (string, error) {
+	parts := strings.Split(sid, "-")
+	if len(parts) < 3 || parts[0] != "S" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidSid, sid)
+	}
+
+	revision, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidSid, sid)
+	}
+
+	authority, err := strconv.ParseUint(parts[2], 10, 48)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidSid, sid)
+	}
+
+	subAuthorities := parts[3:]
+	if len(subAuthorities) > 255 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidSid, sid)
+	}
+
+	value := []byte{byte(revision), byte(len(subAuthorities))}
+	for shift := 40; shift >= 0; shift -= 8 {
+		value = append(value, byte(authority>>shift))
+	}
+
+	for _, part := range subAuthorities {
+		subAuthority, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("%w: %q", ErrInvalidSid, sid)
+		}
+
+		value = append(value, IntToStrX(int(subAuthority))...)
+	}
+
+	return string(value), nil
+}
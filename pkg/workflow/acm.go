@@ -0,0 +1,207 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
+)
+
+// mcNonceLength is the length, in bytes, of the nonce the management console adds to the firmware nonce before signing.
+const mcNonceLength = 20
+
+var (
+	// ErrProvisioningCertificateRequired is returned for an ACM activation without a provisioning certificate.
+	ErrProvisioningCertificateRequired = errors.New("ACM: a provisioning certificate is required")
+	// ErrAdminPasswordRequired is returned for an activation without an admin password.
+	ErrAdminPasswordRequired = errors.New("activation: an admin password is required")
+	// ErrInvalidProvisioningCertificate is returned when the provisioning certificate cannot be decoded with its password.
+	ErrInvalidProvisioningCertificate = errors.New("ACM: the provisioning certificate cannot be decoded")
+	// ErrIncompleteCertificateChain is returned when the provisioning certificate does not chain up to a self-signed root.
+	ErrIncompleteCertificateChain = errors.New("ACM: the provisioning certificate chain does not end with a root certificate")
+	// ErrUnsupportedProvisioningKey is returned for a provisioning certificate whose private key is not an RSA key.
+	ErrUnsupportedProvisioningKey = errors.New("ACM: the private key of the provisioning certificate must be an RSA key")
+	// ErrAdminControlModeNotAllowed is returned when the firmware does not allow Admin Control Mode.
+	ErrAdminControlModeNotAllowed = errors.New("ACM: the device does not allow Admin Control Mode")
+	// ErrAlreadyAdminControlMode is returned when the device is already in Admin Control Mode.
+	ErrAlreadyAdminControlMode = errors.New("ACM: the device is already in Admin Control Mode")
+)
+
+// ProvisioningCertificate is a decoded provisioning certificate.
+type ProvisioningCertificate struct {
+	Chain      []*x509.Certificate // The certificate chain, leaf first and root last.
+	PrivateKey *rsa.PrivateKey     // The private key of the leaf certificate.
+}
+
+// LoadProvisioningCertificate decodes a base64 encoded PFX provisioning certificate and orders its chain from the leaf to the root.
+func LoadProvisioningCertificate(pfx, password string) (ProvisioningCertificate, error) {
+	data, err := base64.StdEncoding.DecodeString(pfx)
+	if err != nil {
+		return ProvisioningCertificate{}, fmt.Errorf("%w: %w", ErrInvalidProvisioningCertificate, err)
+	}
+
+	key, leaf, caCerts, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return ProvisioningCertificate{}, fmt.Errorf("%w: %w", ErrInvalidProvisioningCertificate, err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return ProvisioningCertificate{}, fmt.Errorf("%w: %T", ErrUnsupportedProvisioningKey, key)
+	}
+
+	chain, err := orderCertificateChain(leaf, caCerts)
+	if err != nil {
+		return ProvisioningCertificate{}, err
+	}
+
+	return ProvisioningCertificate{Chain: chain, PrivateKey: privateKey}, nil
+}
+
+// orderCertificateChain follows the issuers of leaf through certificates up to a self-signed root.
+// Certificates that are not part of the path are left out.
+func orderCertificateChain(leaf *x509.Certificate, certificates []*x509.Certificate) ([]*x509.Certificate, error) {
+	chain := []*x509.Certificate{leaf}
+	remaining := append([]*x509.Certificate{}, certificates...)
+
+	for current := leaf; !bytes.Equal(current.RawIssuer, current.RawSubject); {
+		found := -1
+
+		for i, certificate := range remaining {
+			if bytes.Equal(certificate.RawSubject, current.RawIssuer) && current.CheckSignatureFrom(certificate) == nil {
+				found = i
+
+				break
+			}
+		}
+
+		if found < 0 {
+			return nil, fmt.Errorf("%w: the issuer of %q is missing", ErrIncompleteCertificateChain, current.Subject.CommonName)
+		}
+
+		current = remaining[found]
+		chain = append(chain, current)
+		remaining = append(remaining[:found], remaining[found+1:]...)
+	}
+
+	return chain, nil
+}
+
+// ActivateAdminControlMode moves the device to Admin Control Mode with the provisioning certificate of settings.
+//
+// The chain of the provisioning certificate is sent to the firmware leaf first with AddNextCertInChain, then the firmware
+// ConfigurationNonce followed by a random mcNonce is signed with the private key of the certificate. A device that is not provisioned
+// is set up with AdminSetup, which sets the admin password hashed with the digest realm of the device. A device in Client Control Mode
+// is moved with UpgradeClientToAdmin and keeps its admin password.
+//
+// The root of the chain must match one of the trusted root certificate hashes of the firmware and the domain of the leaf certificate
+// must match the DNS suffix the device sees; the firmware rejects the chain otherwise.
+func (w Workflow) ActivateAdminControlMode(settings config.AMTSpecific) error {
+	if settings.ProvisioningCert == "" {
+		return ErrProvisioningCertificateRequired
+	}
+
+	if settings.AdminPassword == "" {
+		return ErrAdminPasswordRequired
+	}
+
+	certificate, err := LoadProvisioningCertificate(settings.ProvisioningCert, settings.ProvisioningCertPwd)
+	if err != nil {
+		return err
+	}
+
+	service, err := w.messages.IPS.HostBasedSetupService.Get()
+	if err != nil {
+		return err
+	}
+
+	setup := service.Body.GetResponse
+
+	if setup.CurrentControlMode == hostbasedsetup.Admin {
+		return ErrAlreadyAdminControlMode
+	}
+
+	allowed := false
+
+	for _, mode := range setup.AllowedControlModes {
+		allowed = allowed || mode == hostbasedsetup.AllowedControlModesAdmin
+	}
+
+	if !allowed {
+		return ErrAdminControlModeNotAllowed
+	}
+
+	var realm string
+
+	if setup.CurrentControlMode == hostbasedsetup.NotProvisioned {
+		general, err := w.messages.AMT.GeneralSettings.Get()
+		if err != nil {
+			return err
+		}
+
+		realm = general.Body.GetResponse.DigestRealm
+	}
+
+	for i, next := range certificate.Chain {
+		added, err := w.messages.IPS.HostBasedSetupService.AddNextCertInChain(base64.StdEncoding.EncodeToString(next.Raw), i == 0, i == len(certificate.Chain)-1)
+
+		err = methodError("IPS_HostBasedSetupService.AddNextCertInChain", int(added.Body.AddNextCertInChain_OUTPUT.ReturnValue), err)
+		if err != nil {
+			return fmt.Errorf("%q: %w", next.Subject.CommonName, err)
+		}
+	}
+
+	mcNonce, signature, err := signConfigurationNonce(setup.ConfigurationNonce, certificate.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	if setup.CurrentControlMode == hostbasedsetup.Client {
+		upgraded, err := w.messages.IPS.HostBasedSetupService.UpgradeClientToAdmin(mcNonce, hostbasedsetup.SigningAlgorithmRSASHA2256, signature)
+
+		return methodError("IPS_HostBasedSetupService.UpgradeClientToAdmin", int(upgraded.Body.UpgradeClientToAdmin_OUTPUT.ReturnValue), err)
+	}
+
+	setupResponse, err := w.messages.IPS.HostBasedSetupService.AdminSetup(hostbasedsetup.AdminPassEncryptionTypeHTTPDigestMD5A1, realm, settings.AdminPassword, mcNonce, hostbasedsetup.SigningAlgorithmRSASHA2256, signature)
+
+	return methodError("IPS_HostBasedSetupService.AdminSetup", int(setupResponse.Body.AdminSetup_OUTPUT.ReturnValue), err)
+}
+
+// signConfigurationNonce generates an mcNonce and signs the firmware nonce followed by it with RSA SHA-256.
+// Both the mcNonce and the signature are returned base64 encoded.
+func signConfigurationNonce(configurationNonce string, key *rsa.PrivateKey) (mcNonce, signature string, err error) {
+	firmwareNonce, err := base64.StdEncoding.DecodeString(configurationNonce)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding the configuration nonce: %w", err)
+	}
+
+	nonce := make([]byte, mcNonceLength)
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", "", err
+	}
+
+	digest := sha256.Sum256(append(firmwareNonce, nonce...))
+
+	signed, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(nonce), base64.StdEncoding.EncodeToString(signed), nil
+}
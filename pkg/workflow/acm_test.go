@@ -0,0 +1,278 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+)
+
+const testProvisioningCertPwd = "P@ssw0rd"
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         crypto.Signer
+}
+
+// newTestCertificate issues a certificate for commonName, signed by issuer or self-signed when issuer is nil.
+func newTestCertificate(t *testing.T, commonName string, key crypto.Signer, issuer *testCertificate) testCertificate {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.certificate, issuer.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCertificate{certificate: certificate, key: key}
+}
+
+func newTestRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return key
+}
+
+// newTestProvisioningCertificate returns a base64 encoded PFX with a leaf, intermediate and root certificate,
+// the CA certificates stored root first, and the chain in the order expected on the device.
+func newTestProvisioningCertificate(t *testing.T) (string, []*x509.Certificate) {
+	t.Helper()
+
+	root := newTestCertificate(t, "Root CA", newTestRSAKey(t), nil)
+	intermediate := newTestCertificate(t, "Intermediate CA", newTestRSAKey(t), &root)
+	leaf := newTestCertificate(t, "amt.example.com", newTestRSAKey(t), &intermediate)
+
+	pfx, err := pkcs12.Modern.Encode(leaf.key, leaf.certificate, []*x509.Certificate{root.certificate, intermediate.certificate}, testProvisioningCertPwd)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(pfx), []*x509.Certificate{leaf.certificate, intermediate.certificate, root.certificate}
+}
+
+func acmResponses() map[string][]string {
+	return map[string][]string{
+		"IPS_HostBasedSetupService/Get":                  {"workflow/acm/hostbasedsetup-get-notprovisioned"},
+		"IPS_HostBasedSetupService/AddNextCertInChain":   {"ips/hostbasedsetup/addnextcertinchain"},
+		"IPS_HostBasedSetupService/AdminSetup":           {"ips/hostbasedsetup/adminsetup"},
+		"IPS_HostBasedSetupService/UpgradeClientToAdmin": {"ips/hostbasedsetup/upgradeclienttoadmin"},
+		"AMT_GeneralSettings/Get":                        {"amt/general/get"},
+	}
+}
+
+func acmSettings(pfx string) config.AMTSpecific {
+	return config.AMTSpecific{
+		ControlMode:         "acmactivate",
+		AdminPassword:       "Adm1n$ecret",
+		ProvisioningCert:    pfx,
+		ProvisioningCertPwd: testProvisioningCertPwd,
+	}
+}
+
+// verifyMcNonceSignature checks the signature of the firmware nonce of the test fixtures followed by the mcNonce of message.
+func verifyMcNonceSignature(t *testing.T, message string, leaf *x509.Certificate) {
+	t.Helper()
+
+	mcNonce := regexp.MustCompile(`<h:McNonce>([^<]+)</h:McNonce>`).FindStringSubmatch(message)
+	signature := regexp.MustCompile(`<h:DigitalSignature>([^<]+)</h:DigitalSignature>`).FindStringSubmatch(message)
+	require.Len(t, mcNonce, 2)
+	require.Len(t, signature, 2)
+	assert.Contains(t, message, "<h:SigningAlgorithm>2</h:SigningAlgorithm>")
+
+	firmwareNonce, _ := base64.StdEncoding.DecodeString("4P3sY7swlhjkhJNxDkEBIUcmpHE=")
+	nonce, err := base64.StdEncoding.DecodeString(mcNonce[1])
+	require.NoError(t, err)
+	assert.Len(t, nonce, mcNonceLength)
+
+	signed, err := base64.StdEncoding.DecodeString(signature[1])
+	require.NoError(t, err)
+
+	digest := sha256.Sum256(append(firmwareNonce, nonce...))
+	assert.NoError(t, rsa.VerifyPKCS1v15(leaf.PublicKey.(*rsa.PublicKey), crypto.SHA256, digest[:], signed))
+}
+
+func TestLoadProvisioningCertificate(t *testing.T) {
+	pfx, chain := newTestProvisioningCertificate(t)
+
+	certificate, err := LoadProvisioningCertificate(pfx, testProvisioningCertPwd)
+	require.NoError(t, err)
+	assert.Equal(t, chain, certificate.Chain)
+	assert.Equal(t, chain[0].PublicKey, certificate.PrivateKey.Public())
+
+	_, err = LoadProvisioningCertificate(pfx, "wrong")
+	assert.ErrorIs(t, err, ErrInvalidProvisioningCertificate)
+
+	_, err = LoadProvisioningCertificate("not base64!", testProvisioningCertPwd)
+	assert.ErrorIs(t, err, ErrInvalidProvisioningCertificate)
+}
+
+func TestLoadProvisioningCertificateInvalid(t *testing.T) {
+	root := newTestCertificate(t, "Root CA", newTestRSAKey(t), nil)
+	intermediate := newTestCertificate(t, "Intermediate CA", newTestRSAKey(t), &root)
+	leaf := newTestCertificate(t, "amt.example.com", newTestRSAKey(t), &intermediate)
+
+	pfx, err := pkcs12.Modern.Encode(leaf.key, leaf.certificate, []*x509.Certificate{root.certificate}, testProvisioningCertPwd)
+	require.NoError(t, err)
+
+	_, err = LoadProvisioningCertificate(base64.StdEncoding.EncodeToString(pfx), testProvisioningCertPwd)
+	assert.ErrorIs(t, err, ErrIncompleteCertificateChain)
+	assert.ErrorContains(t, err, `"amt.example.com"`)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ecLeaf := newTestCertificate(t, "amt.example.com", ecKey, &root)
+
+	pfx, err = pkcs12.Modern.Encode(ecKey, ecLeaf.certificate, []*x509.Certificate{root.certificate}, testProvisioningCertPwd)
+	require.NoError(t, err)
+
+	_, err = LoadProvisioningCertificate(base64.StdEncoding.EncodeToString(pfx), testProvisioningCertPwd)
+	assert.ErrorIs(t, err, ErrUnsupportedProvisioningKey)
+}
+
+func TestActivateAdminControlMode(t *testing.T) {
+	pfx, chain := newTestProvisioningCertificate(t)
+	w, client, _ := newTestWorkflow(acmResponses())
+	settings := acmSettings(pfx)
+
+	err := w.ActivateAdminControlMode(settings)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"IPS_HostBasedSetupService/Get",
+		"AMT_GeneralSettings/Get",
+		"IPS_HostBasedSetupService/AddNextCertInChain",
+		"IPS_HostBasedSetupService/AddNextCertInChain",
+		"IPS_HostBasedSetupService/AddNextCertInChain",
+		"IPS_HostBasedSetupService/AdminSetup",
+	}, client.Requests)
+
+	for i, certificate := range chain {
+		message := client.Messages[2+i]
+		assert.Contains(t, message, "<h:NextCertificate>"+base64.StdEncoding.EncodeToString(certificate.Raw)+"</h:NextCertificate>")
+		assert.Contains(t, message, fmt.Sprintf("<h:IsLeafCertificate>%t</h:IsLeafCertificate>", i == 0))
+		assert.Contains(t, message, fmt.Sprintf("<h:IsRootCertificate>%t</h:IsRootCertificate>", i == len(chain)-1))
+	}
+
+	adminSetup := client.Messages[5]
+	passwordHash := fmt.Sprintf("%x", md5.Sum([]byte("admin:Digest:F3EB554784E729164447A89F60B641C5:"+settings.AdminPassword)))
+	assert.Contains(t, adminSetup, "<h:NetworkAdminPassword>"+passwordHash+"</h:NetworkAdminPassword>")
+	assert.Contains(t, adminSetup, "<h:NetAdminPassEncryptionType>2</h:NetAdminPassEncryptionType>")
+	verifyMcNonceSignature(t, adminSetup, chain[0])
+}
+
+func TestActivateAdminControlModeUpgrade(t *testing.T) {
+	pfx, chain := newTestProvisioningCertificate(t)
+	responses := acmResponses()
+	responses["IPS_HostBasedSetupService/Get"] = []string{"workflow/acm/hostbasedsetup-get-client"}
+	w, client, _ := newTestWorkflow(responses)
+
+	err := w.ActivateAdminControlMode(acmSettings(pfx))
+	require.NoError(t, err)
+	assert.NotContains(t, client.Requests, "AMT_GeneralSettings/Get")
+	assert.NotContains(t, client.Requests, "IPS_HostBasedSetupService/AdminSetup")
+	assert.Equal(t, "IPS_HostBasedSetupService/UpgradeClientToAdmin", client.Requests[len(client.Requests)-1])
+	verifyMcNonceSignature(t, client.Messages[len(client.Messages)-1], chain[0])
+}
+
+func TestActivateAdminControlModeRejected(t *testing.T) {
+	pfx, _ := newTestProvisioningCertificate(t)
+
+	tests := []struct {
+		name     string
+		get      string
+		settings func(settings *config.AMTSpecific)
+		expected error
+	}{
+		{"no certificate", "workflow/acm/hostbasedsetup-get-notprovisioned", func(settings *config.AMTSpecific) { settings.ProvisioningCert = "" }, ErrProvisioningCertificateRequired},
+		{"no password", "workflow/acm/hostbasedsetup-get-notprovisioned", func(settings *config.AMTSpecific) { settings.AdminPassword = "" }, ErrAdminPasswordRequired},
+		{"wrong certificate password", "workflow/acm/hostbasedsetup-get-notprovisioned", func(settings *config.AMTSpecific) { settings.ProvisioningCertPwd = "" }, ErrInvalidProvisioningCertificate},
+		{"already admin", "ips/hostbasedsetup/get", func(settings *config.AMTSpecific) {}, ErrAlreadyAdminControlMode},
+		{"not allowed", "workflow/acm/hostbasedsetup-get-clientonly", func(settings *config.AMTSpecific) {}, ErrAdminControlModeNotAllowed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			responses := acmResponses()
+			responses["IPS_HostBasedSetupService/Get"] = []string{test.get}
+			w, client, _ := newTestWorkflow(responses)
+			settings := acmSettings(pfx)
+			test.settings(&settings)
+
+			err := w.ActivateAdminControlMode(settings)
+			assert.ErrorIs(t, err, test.expected)
+			assert.NotContains(t, client.Requests, "IPS_HostBasedSetupService/AddNextCertInChain")
+		})
+	}
+}
+
+func TestActivateAdminControlModeChainRejected(t *testing.T) {
+	pfx, _ := newTestProvisioningCertificate(t)
+	responses := acmResponses()
+	responses["IPS_HostBasedSetupService/AddNextCertInChain"] = []string{"ips/hostbasedsetup/addnextcertinchain", "workflow/acm/addnextcertinchain-failure"}
+	w, client, _ := newTestWorkflow(responses)
+
+	err := w.ActivateAdminControlMode(acmSettings(pfx))
+
+	var returnValueError *ReturnValueError
+
+	require.ErrorAs(t, err, &returnValueError)
+	assert.Equal(t, "IPS_HostBasedSetupService.AddNextCertInChain", returnValueError.Operation)
+	assert.ErrorContains(t, err, `"Intermediate CA"`)
+	assert.NotContains(t, client.Requests, "IPS_HostBasedSetupService/AdminSetup")
+}
+
+func TestActivateAdminControlModeError(t *testing.T) {
+	pfx, _ := newTestProvisioningCertificate(t)
+
+	for _, failure := range []string{
+		"IPS_HostBasedSetupService/Get",
+		"AMT_GeneralSettings/Get",
+		"IPS_HostBasedSetupService/AddNextCertInChain",
+		"IPS_HostBasedSetupService/AdminSetup",
+	} {
+		t.Run(failure, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(acmResponses())
+			client.Failures[failure] = errTransport
+
+			assert.ErrorIs(t, w.ActivateAdminControlMode(acmSettings(pfx)), errTransport)
+		})
+	}
+}
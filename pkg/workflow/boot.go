@@ -0,0 +1,284 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	amtboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+)
+
+// BootConfigurationInstanceID is the InstanceID of the CIM_BootConfigSetting of Intel® AMT.
+const BootConfigurationInstanceID = "Intel(r) AMT: Boot Configuration 0"
+
+const (
+	// bootConfigRoleIsNextSingleUse makes the boot configuration apply to the next boot only.
+	bootConfigRoleIsNextSingleUse = 1
+	// ocrBootOptionPrefix starts the InstanceID of the One-Click Recovery boot options the BIOS reports.
+	ocrBootOptionPrefix = "Intel(r) AMT: Force OCR UEFI Boot Option"
+)
+
+var (
+	// ErrBootTargetNotSupported is returned when AMT_BootCapabilities or the BIOS does not offer the boot target.
+	ErrBootTargetNotSupported = errors.New("boot: the device does not support the boot target")
+	// ErrBootSourceNotFound is returned when the BIOS reports no One-Click Recovery boot option for WinRE or PBA.
+	ErrBootSourceNotFound = errors.New("boot: the device reports no boot option for the boot target")
+)
+
+// BootTarget is where BootToTarget makes the device boot.
+type BootTarget int
+
+const (
+	BootTargetPXE         BootTarget = iota // Boot from the network.
+	BootTargetHardDrive                     // Boot from the hard drive.
+	BootTargetCD                            // Boot from the CD/DVD drive.
+	BootTargetBIOSSetup                     // Enter the BIOS setup screen.
+	BootTargetBIOSPause                     // Pause the BIOS for user input.
+	BootTargetDiagnostics                   // Boot the diagnostics partition.
+	BootTargetIDERCD                        // Boot from the CD image of an IDE redirection session, which the caller opens.
+	BootTargetHTTPS                         // Boot from the HTTPS boot URL configured in the BIOS (One-Click Recovery).
+	BootTargetWinRE                         // Boot to the Windows Recovery Environment (One-Click Recovery).
+	BootTargetPBA                           // Boot to the pre-boot application (One-Click Recovery).
+)
+
+// bootTargetToString is a map of BootTarget values to their string representations.
+var bootTargetToString = map[BootTarget]string{
+	BootTargetPXE:         "PXE",
+	BootTargetHardDrive:   "HardDrive",
+	BootTargetCD:          "CD",
+	BootTargetBIOSSetup:   "BIOSSetup",
+	BootTargetBIOSPause:   "BIOSPause",
+	BootTargetDiagnostics: "Diagnostics",
+	BootTargetIDERCD:      "IDERCD",
+	BootTargetHTTPS:       "HTTPS",
+	BootTargetWinRE:       "WinRE",
+	BootTargetPBA:         "PBA",
+}
+
+// String returns the string representation of the BootTarget value.
+func (t BootTarget) String() string {
+	if value, exists := bootTargetToString[t]; exists {
+		return value
+	}
+
+	return amtboot.ValueNotFound
+}
+
+// BootOptions are the settings BootToTarget applies together with the boot target.
+type BootOptions struct {
+	UseSOL bool // Redirect the console of the next boot to Serial over LAN.
+}
+
+// supported reports whether the device offers target, from its capabilities and, for the One-Click Recovery targets, the BIOS settings.
+func (t BootTarget) supported(capabilities amtboot.BootCapabilitiesResponse, settings amtboot.BootSettingDataResponse) bool {
+	switch t {
+	case BootTargetPXE:
+		return capabilities.ForcePXEBoot
+	case BootTargetHardDrive:
+		return capabilities.ForceHardDriveBoot
+	case BootTargetCD:
+		return capabilities.ForceCDorDVDBoot
+	case BootTargetBIOSSetup:
+		return capabilities.BIOSSetup
+	case BootTargetBIOSPause:
+		return capabilities.BIOSPause
+	case BootTargetDiagnostics:
+		return capabilities.ForceDiagnosticBoot
+	case BootTargetIDERCD:
+		return capabilities.IDER
+	case BootTargetHTTPS:
+		return capabilities.ForceUEFIHTTPSBoot && settings.UEFIHTTPSBootEnabled
+	case BootTargetWinRE:
+		return capabilities.ForceWinREBoot && settings.WinREBootEnabled
+	case BootTargetPBA:
+		return capabilities.ForceUEFILocalPBABoot && settings.UEFILocalPBABootEnabled
+	}
+
+	return false
+}
+
+// BootToTarget makes the next boot of the device go to target and restarts the device, or powers it on when it is off.
+// The power action is chosen from the actions the device offers before any setting is changed: a reset when it is offered,
+// and a power on otherwise.
+//
+// The workflow checks target against AMT_BootCapabilities, applies it with AMT_BootSettingData, CIM_BootConfigSetting.ChangeBootOrder
+// and CIM_BootService.SetBootConfigRole, and requests the power action. It then waits until the BIOS reports that it read the boot options
+// and clears the overrides, so that the boot after this one uses the regular boot order. The overrides are also cleared when a step fails
+// or the BIOS does not read them within Timeout.
+func (w Workflow) BootToTarget(target BootTarget, options BootOptions) (result PowerResult, err error) {
+	capabilities, err := w.messages.AMT.BootCapabilities.Get()
+	if err != nil {
+		return result, err
+	}
+
+	current, err := w.messages.AMT.BootSettingData.Get()
+	if err != nil {
+		return result, err
+	}
+
+	settings := current.Body.BootSettingDataGetResponse
+
+	if !target.supported(capabilities.Body.BootCapabilitiesGetResponse, settings) {
+		return result, fmt.Errorf("%w: %s", ErrBootTargetNotSupported, target)
+	}
+
+	if options.UseSOL && !capabilities.Body.BootCapabilitiesGetResponse.SOL {
+		return result, fmt.Errorf("%w: Serial over LAN", ErrBootTargetNotSupported)
+	}
+
+	source, err := w.bootSource(target)
+	if err != nil {
+		return result, err
+	}
+
+	status, err := w.PowerStatus()
+	if err != nil {
+		return result, err
+	}
+
+	result.observe(status.State)
+
+	var action power.PowerState
+
+	switch {
+	case status.Allows(power.MasterBusReset):
+		action = power.MasterBusReset
+	case status.Allows(power.PowerOn):
+		action = power.PowerOn
+	default:
+		return result, fmt.Errorf("%w: neither a reset nor a power on in %s", ErrPowerActionNotAvailable, status.State)
+	}
+
+	undo := rollback{}
+
+	request := bootSettings(settings)
+	request.UseSOL = options.UseSOL
+
+	switch target {
+	case BootTargetBIOSSetup:
+		request.BIOSSetup = true
+	case BootTargetBIOSPause:
+		request.BIOSPause = true
+	case BootTargetIDERCD:
+		request.UseIDER = true
+		request.IDERBootDevice = amtboot.CDBoot
+	}
+
+	if _, err := w.messages.AMT.BootSettingData.Put(request); err != nil {
+		return result, err
+	}
+
+	undo.add(func() error { return w.clearBootOverrides(settings) })
+
+	response, err := w.messages.CIM.BootService.SetBootConfigRole(BootConfigurationInstanceID, bootConfigRoleIsNextSingleUse)
+
+	err = methodError("CIM_BootService.SetBootConfigRole", int(response.Body.SetBootConfigRole_OUTPUT.ReturnValue), err)
+	if err != nil {
+		return result, undo.fail(err)
+	}
+
+	if source != "" {
+		response, err := w.messages.CIM.BootConfigSetting.ChangeBootOrder(source)
+
+		err = methodError("CIM_BootConfigSetting.ChangeBootOrder", int(response.Body.ChangeBootOrder_OUTPUT.ReturnValue), err)
+		if err != nil {
+			return result, undo.fail(err)
+		}
+	}
+
+	if err := w.requestPowerAction(status, action); err != nil {
+		return result, undo.fail(err)
+	}
+
+	result.Actions = append(result.Actions, action)
+
+	if err := w.waitForBootOptionsRead(); err != nil {
+		return result, undo.fail(err)
+	}
+
+	return result, w.clearBootOverrides(settings)
+}
+
+// bootSource returns the CIM_BootSourceSetting that target boots from, or an empty Source for the targets that only need AMT_BootSettingData.
+func (w Workflow) bootSource(target BootTarget) (boot.Source, error) {
+	switch target {
+	case BootTargetPXE:
+		return boot.PXE, nil
+	case BootTargetHardDrive:
+		return boot.HardDrive, nil
+	case BootTargetCD:
+		return boot.CD, nil
+	case BootTargetDiagnostics:
+		return boot.Diagnostic, nil
+	case BootTargetHTTPS:
+		return boot.OCRUEFIHTTPS, nil
+	case BootTargetWinRE:
+		return w.ocrBootOption(target, "winre")
+	case BootTargetPBA:
+		return w.ocrBootOption(target, "pba")
+	}
+
+	return "", nil
+}
+
+// ocrBootOption returns the One-Click Recovery boot option whose BIOS description contains marker, ignoring case.
+func (w Workflow) ocrBootOption(target BootTarget, marker string) (boot.Source, error) {
+	enumerate, err := w.messages.CIM.BootSourceSetting.Enumerate()
+	if err != nil {
+		return "", err
+	}
+
+	pull, err := w.messages.CIM.BootSourceSetting.Pull(enumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return "", err
+	}
+
+	for _, source := range pull.Body.PullResponse.BootSourceSettingItems {
+		if strings.HasPrefix(source.InstanceID, ocrBootOptionPrefix) && strings.Contains(strings.ToLower(source.BIOSBootString), marker) {
+			return boot.Source(source.InstanceID), nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrBootSourceNotFound, target)
+}
+
+// bootSettings returns the AMT_BootSettingData request that keeps the identity of settings and sets every boot option
+// to its default. The read-only properties are left unset.
+func bootSettings(settings amtboot.BootSettingDataResponse) amtboot.BootSettingDataRequest {
+	return amtboot.BootSettingDataRequest{
+		ElementName:  settings.ElementName,
+		InstanceID:   settings.InstanceID,
+		OwningEntity: settings.OwningEntity,
+		RPEEnabled:   settings.RPEEnabled,
+	}
+}
+
+// waitForBootOptionsRead polls AMT_BootSettingData until the BIOS reports that it read and cleared the boot options.
+func (w Workflow) waitForBootOptionsRead() error {
+	return w.poll(func() (bool, error) {
+		current, err := w.messages.AMT.BootSettingData.Get()
+		if err != nil {
+			return false, err
+		}
+
+		return current.Body.BootSettingDataGetResponse.OptionsCleared, nil
+	})
+}
+
+// clearBootOverrides resets the boot options of AMT_BootSettingData and the boot order of CIM_BootConfigSetting.
+func (w Workflow) clearBootOverrides(settings amtboot.BootSettingDataResponse) error {
+	if _, err := w.messages.AMT.BootSettingData.Put(bootSettings(settings)); err != nil {
+		return err
+	}
+
+	response, err := w.messages.CIM.BootConfigSetting.ClearBootOrder()
+
+	return methodError("CIM_BootConfigSetting.ChangeBootOrder", int(response.Body.ChangeBootOrder_OUTPUT.ReturnValue), err)
+}
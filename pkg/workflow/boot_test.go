@@ -0,0 +1,195 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+)
+
+const bootSettingsPending = "workflow/boot/settingdata-get-pending"
+
+func bootResponses(states ...string) map[string][]string {
+	responses := powerResponses(states...)
+	responses["AMT_BootCapabilities/Get"] = []string{"workflow/boot/capabilities-get-ocr"}
+	responses["AMT_BootSettingData/Get"] = []string{"amt/boot/settingdata/get", bootSettingsPending, "amt/boot/settingdata/get"}
+	responses["AMT_BootSettingData/Put"] = []string{"amt/boot/settingdata/put"}
+	responses["CIM_BootService/SetBootConfigRole"] = []string{"cim/boot/service/setbootconfigrole"}
+	responses["CIM_BootConfigSetting/ChangeBootOrder"] = []string{"cim/boot/configsetting/changebootorder"}
+	responses["CIM_BootSourceSetting/Enumerate"] = []string{"cim/boot/sourcesetting/enumerate"}
+	responses["CIM_BootSourceSetting/Pull"] = []string{"workflow/boot/sourcesetting-pull-winre"}
+
+	return responses
+}
+
+func TestBootToTarget(t *testing.T) {
+	w, client, slept := newTestWorkflow(bootResponses(powerOn))
+
+	result, err := w.BootToTarget(BootTargetPXE, BootOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []power.PowerState{power.MasterBusReset}, result.Actions)
+	assert.Equal(t, []string{
+		"AMT_BootCapabilities/Get",
+		"AMT_BootSettingData/Get",
+		"CIM_ServiceAvailableToElement/Enumerate",
+		"CIM_ServiceAvailableToElement/Pull",
+		"AMT_BootSettingData/Put",
+		"CIM_BootService/SetBootConfigRole",
+		"CIM_BootConfigSetting/ChangeBootOrder",
+		"CIM_PowerManagementService/RequestPowerStateChange",
+		"AMT_BootSettingData/Get",
+		"AMT_BootSettingData/Get",
+		"AMT_BootSettingData/Put",
+		"CIM_BootConfigSetting/ChangeBootOrder",
+	}, client.Requests)
+	assert.NotContains(t, client.Messages[4], "<h:BIOSLastStatus>")
+	assert.Contains(t, client.Messages[4], "<h:InstanceID>Intel(r) AMT:BootSettingData 0</h:InstanceID>")
+	assert.Contains(t, client.Messages[5], "<Selector Name=\"InstanceID\">Intel(r) AMT: Boot Configuration 0</Selector>")
+	assert.Contains(t, client.Messages[5], "<h:Role>1</h:Role>")
+	assert.Contains(t, client.Messages[6], "<Selector Name=\"InstanceID\">Intel(r) AMT: Force PXE Boot</Selector>")
+	assert.Contains(t, client.Messages[7], "<h:PowerState>10</h:PowerState>")
+	assert.Contains(t, client.Messages[11], "<h:ChangeBootOrder_INPUT xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting\"></h:ChangeBootOrder_INPUT>")
+	assert.NotContains(t, client.Messages[11], "<h:Source>")
+	assert.Equal(t, DefaultPollInterval, *slept)
+}
+
+func TestBootToTargetSettings(t *testing.T) {
+	tests := []struct {
+		target   BootTarget
+		options  BootOptions
+		settings []string
+		source   string
+	}{
+		{BootTargetHardDrive, BootOptions{}, nil, "Intel(r) AMT: Force Hard-drive Boot"},
+		{BootTargetCD, BootOptions{UseSOL: true}, []string{"<h:UseSOL>true</h:UseSOL>"}, "Intel(r) AMT: Force CD/DVD Boot"},
+		{BootTargetBIOSSetup, BootOptions{}, []string{"<h:BIOSSetup>true</h:BIOSSetup>"}, ""},
+		{BootTargetIDERCD, BootOptions{}, []string{"<h:UseIDER>true</h:UseIDER>", "<h:IDERBootDevice>1</h:IDERBootDevice>"}, ""},
+		{BootTargetHTTPS, BootOptions{}, nil, "Intel(r) AMT: Force OCR UEFI HTTPS Boot"},
+		{BootTargetWinRE, BootOptions{}, nil, "Intel(r) AMT: Force OCR UEFI Boot Option 1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.target.String(), func(t *testing.T) {
+			w, client, _ := newTestWorkflow(bootResponses(powerOff, powerOff))
+
+			result, err := w.BootToTarget(test.target, test.options)
+			require.NoError(t, err)
+			assert.Equal(t, []power.PowerState{power.PowerOn}, result.Actions)
+
+			var put, changeBootOrder []string
+
+			for i, request := range client.Requests {
+				switch request {
+				case "AMT_BootSettingData/Put":
+					put = append(put, client.Messages[i])
+				case "CIM_BootConfigSetting/ChangeBootOrder":
+					changeBootOrder = append(changeBootOrder, client.Messages[i])
+				}
+			}
+
+			require.Len(t, put, 2)
+
+			for _, setting := range test.settings {
+				assert.Contains(t, put[0], setting)
+				assert.NotContains(t, put[1], setting)
+			}
+
+			if test.source == "" {
+				assert.Len(t, changeBootOrder, 1)
+			} else {
+				require.Len(t, changeBootOrder, 2)
+				assert.Contains(t, changeBootOrder[0], "<Selector Name=\"InstanceID\">"+test.source+"</Selector>")
+			}
+		})
+	}
+}
+
+func TestBootToTargetNotSupported(t *testing.T) {
+	tests := []struct {
+		name         string
+		target       BootTarget
+		options      BootOptions
+		capabilities string
+		expected     error
+	}{
+		{"diagnostics", BootTargetDiagnostics, BootOptions{}, "workflow/boot/capabilities-get-ocr", ErrBootTargetNotSupported},
+		{"BIOS pause", BootTargetBIOSPause, BootOptions{}, "workflow/boot/capabilities-get-ocr", ErrBootTargetNotSupported},
+		{"WinRE", BootTargetWinRE, BootOptions{}, "amt/boot/capabilities/get", ErrBootTargetNotSupported},
+		{"PBA boot option", BootTargetPBA, BootOptions{}, "workflow/boot/capabilities-get-ocr", ErrBootSourceNotFound},
+		{"unknown target", BootTarget(42), BootOptions{}, "workflow/boot/capabilities-get-ocr", ErrBootTargetNotSupported},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			responses := bootResponses(powerOn)
+			responses["AMT_BootCapabilities/Get"] = []string{test.capabilities}
+			w, client, _ := newTestWorkflow(responses)
+
+			_, err := w.BootToTarget(test.target, test.options)
+			assert.ErrorIs(t, err, test.expected)
+			assert.NotContains(t, client.Requests, "AMT_BootSettingData/Put")
+		})
+	}
+}
+
+func TestBootToTargetPowerActionNotAvailable(t *testing.T) {
+	w, client, _ := newTestWorkflow(bootResponses("workflow/power/availabletoelement-pull-on-noreset"))
+
+	_, err := w.BootToTarget(BootTargetPXE, BootOptions{})
+	assert.ErrorIs(t, err, ErrPowerActionNotAvailable)
+	assert.NotContains(t, client.Requests, "AMT_BootSettingData/Put")
+	assert.NotContains(t, client.Requests, "CIM_PowerManagementService/RequestPowerStateChange")
+}
+
+func TestBootToTargetClearsOverrides(t *testing.T) {
+	responses := bootResponses(powerOn)
+	responses["CIM_BootConfigSetting/ChangeBootOrder"] = []string{"workflow/boot/changebootorder-failure", "cim/boot/configsetting/changebootorder"}
+	w, client, _ := newTestWorkflow(responses)
+
+	_, err := w.BootToTarget(BootTargetPXE, BootOptions{})
+
+	var returnValueError *ReturnValueError
+
+	require.ErrorAs(t, err, &returnValueError)
+	assert.Equal(t, "CIM_BootConfigSetting.ChangeBootOrder", returnValueError.Operation)
+	assert.NotContains(t, client.Requests, "CIM_PowerManagementService/RequestPowerStateChange")
+	assert.Equal(t, []string{"AMT_BootSettingData/Put", "CIM_BootConfigSetting/ChangeBootOrder"}, client.Requests[len(client.Requests)-2:])
+
+	responses = bootResponses(powerOn)
+	responses["AMT_BootSettingData/Get"] = []string{"amt/boot/settingdata/get", bootSettingsPending}
+	w, client, slept := newTestWorkflow(responses)
+
+	_, err = w.BootToTarget(BootTargetPXE, BootOptions{})
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, DefaultTimeout, *slept)
+	assert.Equal(t, []string{"AMT_BootSettingData/Put", "CIM_BootConfigSetting/ChangeBootOrder"}, client.Requests[len(client.Requests)-2:])
+}
+
+func TestBootToTargetError(t *testing.T) {
+	for _, failure := range []string{
+		"AMT_BootCapabilities/Get",
+		"AMT_BootSettingData/Get",
+		"AMT_BootSettingData/Put",
+		"CIM_BootService/SetBootConfigRole",
+		"CIM_BootConfigSetting/ChangeBootOrder",
+		"CIM_BootSourceSetting/Enumerate",
+		"CIM_BootSourceSetting/Pull",
+		"CIM_ServiceAvailableToElement/Pull",
+		"CIM_PowerManagementService/RequestPowerStateChange",
+	} {
+		t.Run(failure, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(bootResponses(powerOn))
+			client.Failures[failure] = errTransport
+
+			_, err := w.BootToTarget(BootTargetWinRE, BootOptions{})
+			assert.ErrorIs(t, err, errTransport)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
+)
+
+var (
+	// ErrAlreadyProvisioned is returned when activating a device that is already provisioned.
+	ErrAlreadyProvisioned = errors.New("CCM: the device is already provisioned")
+	// ErrClientControlModeNotAllowed is returned when the firmware does not allow Client Control Mode.
+	ErrClientControlModeNotAllowed = errors.New("CCM: the device does not allow Client Control Mode")
+)
+
+// ConfigurationResult holds what ApplyConfiguration changed on the device.
+type ConfigurationResult struct {
+	MEBXPasswordSet bool                  // Whether the MEBx password was changed. The firmware refuses it in Client Control Mode and, depending on the MEBx password policy, after provisioning.
+	WiredIEEE8021x  *WiredIEEE8021xResult // The result of ConfigureWiredIEEE8021x. Nil when the configuration has no wired 802.1X settings.
+	WiFi            *WiFiSyncResult       // The result of SyncWiFiProfiles. Nil when the configuration has no wireless profiles.
+}
+
+// ActivateClientControlMode provisions the device in Client Control Mode with adminPassword.
+//
+// The password is hashed with the digest realm of the device and sent with Setup, then the workflow waits until
+// AMT_SetupAndConfigurationService reports PostProvisioning. When configuration is not nil it is applied with ApplyConfiguration.
+// The device authenticates with adminPassword from then on, so the client of the workflow must use it for the calls that follow Setup.
+func (w Workflow) ActivateClientControlMode(adminPassword string, configuration *config.Configuration) (result ConfigurationResult, err error) {
+	if adminPassword == "" {
+		return result, ErrAdminPasswordRequired
+	}
+
+	service, err := w.messages.IPS.HostBasedSetupService.Get()
+	if err != nil {
+		return result, err
+	}
+
+	setup := service.Body.GetResponse
+
+	if setup.CurrentControlMode != hostbasedsetup.NotProvisioned {
+		return result, fmt.Errorf("%w: %s", ErrAlreadyProvisioned, setup.CurrentControlMode)
+	}
+
+	allowed := false
+
+	for _, mode := range setup.AllowedControlModes {
+		allowed = allowed || mode == hostbasedsetup.AllowedControlModesClient
+	}
+
+	if !allowed {
+		return result, ErrClientControlModeNotAllowed
+	}
+
+	general, err := w.messages.AMT.GeneralSettings.Get()
+	if err != nil {
+		return result, err
+	}
+
+	response, err := w.messages.IPS.HostBasedSetupService.Setup(hostbasedsetup.AdminPassEncryptionTypeHTTPDigestMD5A1, general.Body.GetResponse.DigestRealm, adminPassword)
+
+	err = methodError("IPS_HostBasedSetupService.Setup", int(response.Body.Setup_OUTPUT.ReturnValue), err)
+	if err != nil {
+		return result, err
+	}
+
+	if err := w.waitForProvisioningState(setupandconfiguration.PostProvisioning); err != nil {
+		return result, err
+	}
+
+	if configuration == nil {
+		return result, nil
+	}
+
+	return w.ApplyConfiguration(*configuration)
+}
+
+// ApplyConfiguration applies the settings of configuration that need no other input to a provisioned device:
+// the MEBx password when the firmware allows it, the wired 802.1X settings and the wireless profiles.
+// The TLS settings need a Signer and are applied with EnableTLS.
+func (w Workflow) ApplyConfiguration(configuration config.Configuration) (result ConfigurationResult, err error) {
+	settings := configuration.Configuration
+
+	if password := settings.AMTSpecific.MEBXPassword; password != "" {
+		result.MEBXPasswordSet, err = w.setMEBXPassword(password)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if settings.Network.Wired.IEEE8021x != nil {
+		wired, err := w.ConfigureWiredIEEE8021x(*settings.Network.Wired.IEEE8021x)
+		if err != nil {
+			return result, err
+		}
+
+		result.WiredIEEE8021x = &wired
+	}
+
+	if len(settings.Network.Wireless.Profiles) > 0 {
+		wifi, err := w.SyncWiFiProfiles(settings.Network.Wireless.Profiles, WiFiSyncOptions{})
+		if err != nil {
+			return result, err
+		}
+
+		result.WiFi = &wifi
+	}
+
+	return result, nil
+}
+
+// setMEBXPassword changes the MEBx password and reports false without an error when the firmware does not permit it.
+func (w Workflow) setMEBXPassword(password string) (bool, error) {
+	response, err := w.messages.AMT.SetupAndConfigurationService.SetMEBXPassword(password)
+
+	returnValue := response.Body.SetMEBxPassword_OUTPUT.ReturnValue
+	if returnValue == setupandconfiguration.ReturnValueNotPermitted {
+		return false, nil
+	}
+
+	if err := methodError("AMT_SetupAndConfigurationService.SetMEBxPassword", int(returnValue), err); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Unprovision returns the device to the pre-provisioning state and waits until AMT_SetupAndConfigurationService reports it.
+// A device that is not provisioned is left as is.
+func (w Workflow) Unprovision() error {
+	service, err := w.messages.AMT.SetupAndConfigurationService.Get()
+	if err != nil {
+		return err
+	}
+
+	current := service.Body.GetResponse
+	if current.ProvisioningState == setupandconfiguration.PreProvisioning {
+		return nil
+	}
+
+	response, err := w.messages.AMT.SetupAndConfigurationService.Unprovision(current.ProvisioningMode)
+
+	err = methodError("AMT_SetupAndConfigurationService.Unprovision", int(response.Body.Unprovision_OUTPUT.ReturnValue), err)
+	if err != nil {
+		return err
+	}
+
+	return w.waitForProvisioningState(setupandconfiguration.PreProvisioning)
+}
+
+// waitForProvisioningState polls AMT_SetupAndConfigurationService until it reports state.
+func (w Workflow) waitForProvisioningState(state setupandconfiguration.ProvisioningStateValue) error {
+	return w.poll(func() (bool, error) {
+		service, err := w.messages.AMT.SetupAndConfigurationService.Get()
+		if err != nil {
+			return false, err
+		}
+
+		return service.Body.GetResponse.ProvisioningState == state, nil
+	})
+}
@@ -0,0 +1,197 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"crypto/md5"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+)
+
+func ccmResponses() map[string][]string {
+	return map[string][]string{
+		"IPS_HostBasedSetupService/Get":                    {"workflow/acm/hostbasedsetup-get-notprovisioned"},
+		"IPS_HostBasedSetupService/Setup":                  {"ips/hostbasedsetup/setup"},
+		"AMT_GeneralSettings/Get":                          {"amt/general/get"},
+		"AMT_SetupAndConfigurationService/Get":             {"workflow/ccm/setupandconfiguration-get-in", "workflow/ccm/setupandconfiguration-get-ccm"},
+		"AMT_SetupAndConfigurationService/SetMEBxPassword": {"workflow/ccm/setmebxpassword-notpermitted"},
+		"AMT_SetupAndConfigurationService/Unprovision":     {"amt/setupandconfiguration/unprovision"},
+	}
+}
+
+func TestActivateClientControlMode(t *testing.T) {
+	w, client, slept := newTestWorkflow(ccmResponses())
+
+	result, err := w.ActivateClientControlMode("Adm1n$ecret", nil)
+	require.NoError(t, err)
+	assert.Equal(t, ConfigurationResult{}, result)
+	assert.Equal(t, []string{
+		"IPS_HostBasedSetupService/Get",
+		"AMT_GeneralSettings/Get",
+		"IPS_HostBasedSetupService/Setup",
+		"AMT_SetupAndConfigurationService/Get",
+		"AMT_SetupAndConfigurationService/Get",
+	}, client.Requests)
+	assert.Equal(t, DefaultPollInterval, *slept)
+
+	passwordHash := fmt.Sprintf("%x", md5.Sum([]byte("admin:Digest:F3EB554784E729164447A89F60B641C5:Adm1n$ecret")))
+	assert.Contains(t, client.Messages[2], "<h:NetworkAdminPassword>"+passwordHash+"</h:NetworkAdminPassword>")
+	assert.Contains(t, client.Messages[2], "<h:NetAdminPassEncryptionType>2</h:NetAdminPassEncryptionType>")
+}
+
+func TestActivateClientControlModeWithConfiguration(t *testing.T) {
+	responses := ccmResponses()
+	for key, fixtures := range ieee8021xResponses() {
+		responses[key] = fixtures
+	}
+
+	w, client, _ := newTestWorkflow(responses)
+	configuration := &config.Configuration{}
+	configuration.Configuration.AMTSpecific.MEBXPassword = "MEBxP@ss1"
+	settings := eapTLSSettings()
+	configuration.Configuration.Network.Wired.IEEE8021x = &settings
+
+	result, err := w.ActivateClientControlMode("Adm1n$ecret", configuration)
+	require.NoError(t, err)
+	assert.False(t, result.MEBXPasswordSet)
+	require.NotNil(t, result.WiredIEEE8021x)
+	assert.True(t, result.WiredIEEE8021x.Profile.Enabled)
+	assert.Nil(t, result.WiFi)
+	assert.Contains(t, client.Requests, "AMT_SetupAndConfigurationService/SetMEBxPassword")
+	assert.Contains(t, client.Requests, "IPS_IEEE8021xSettings/Put")
+}
+
+func TestActivateClientControlModeRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		get      string
+		expected error
+	}{
+		{"no password", "", "workflow/acm/hostbasedsetup-get-notprovisioned", ErrAdminPasswordRequired},
+		{"provisioned", "Adm1n$ecret", "ips/hostbasedsetup/get", ErrAlreadyProvisioned},
+		{"client control mode not allowed", "Adm1n$ecret", "workflow/ccm/hostbasedsetup-get-adminonly", ErrClientControlModeNotAllowed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			responses := ccmResponses()
+			responses["IPS_HostBasedSetupService/Get"] = []string{test.get}
+			w, client, _ := newTestWorkflow(responses)
+
+			_, err := w.ActivateClientControlMode(test.password, nil)
+			assert.ErrorIs(t, err, test.expected)
+			assert.NotContains(t, client.Requests, "IPS_HostBasedSetupService/Setup")
+		})
+	}
+}
+
+func TestActivateClientControlModeSetupFailure(t *testing.T) {
+	responses := ccmResponses()
+	responses["IPS_HostBasedSetupService/Setup"] = []string{"workflow/ccm/setup-failure"}
+	w, client, _ := newTestWorkflow(responses)
+
+	_, err := w.ActivateClientControlMode("Adm1n$ecret", nil)
+
+	var returnValueError *ReturnValueError
+
+	require.ErrorAs(t, err, &returnValueError)
+	assert.Equal(t, "IPS_HostBasedSetupService.Setup", returnValueError.Operation)
+	assert.NotContains(t, client.Requests, "AMT_SetupAndConfigurationService/Get")
+}
+
+func TestActivateClientControlModeTimeout(t *testing.T) {
+	responses := ccmResponses()
+	responses["AMT_SetupAndConfigurationService/Get"] = []string{"workflow/ccm/setupandconfiguration-get-in"}
+	w, _, slept := newTestWorkflow(responses)
+
+	_, err := w.ActivateClientControlMode("Adm1n$ecret", nil)
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, DefaultTimeout, *slept)
+}
+
+func TestActivateClientControlModeError(t *testing.T) {
+	for _, failure := range []string{
+		"IPS_HostBasedSetupService/Get",
+		"AMT_GeneralSettings/Get",
+		"IPS_HostBasedSetupService/Setup",
+		"AMT_SetupAndConfigurationService/Get",
+	} {
+		t.Run(failure, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(ccmResponses())
+			client.Failures[failure] = errTransport
+
+			_, err := w.ActivateClientControlMode("Adm1n$ecret", nil)
+			assert.ErrorIs(t, err, errTransport)
+		})
+	}
+}
+
+func TestApplyConfigurationMEBXPassword(t *testing.T) {
+	responses := ccmResponses()
+	responses["AMT_SetupAndConfigurationService/SetMEBxPassword"] = []string{"amt/setupandconfiguration/setmebxpassword"}
+	w, client, _ := newTestWorkflow(responses)
+	configuration := config.Configuration{}
+	configuration.Configuration.AMTSpecific.MEBXPassword = "MEBxP@ss1"
+
+	result, err := w.ApplyConfiguration(configuration)
+	require.NoError(t, err)
+	assert.True(t, result.MEBXPasswordSet)
+	assert.Equal(t, []string{"AMT_SetupAndConfigurationService/SetMEBxPassword"}, client.Requests)
+	assert.Contains(t, client.Messages[0], "MEBxP@ss1")
+
+	client.Failures["AMT_SetupAndConfigurationService/SetMEBxPassword"] = errTransport
+
+	_, err = w.ApplyConfiguration(configuration)
+	assert.ErrorIs(t, err, errTransport)
+}
+
+func TestUnprovision(t *testing.T) {
+	responses := ccmResponses()
+	responses["AMT_SetupAndConfigurationService/Get"] = []string{
+		"workflow/ccm/setupandconfiguration-get-ccm",
+		"workflow/ccm/setupandconfiguration-get-in",
+		"workflow/ccm/setupandconfiguration-get-pre",
+	}
+	w, client, slept := newTestWorkflow(responses)
+
+	err := w.Unprovision()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"AMT_SetupAndConfigurationService/Get",
+		"AMT_SetupAndConfigurationService/Unprovision",
+		"AMT_SetupAndConfigurationService/Get",
+		"AMT_SetupAndConfigurationService/Get",
+	}, client.Requests)
+	assert.Contains(t, client.Messages[1], "<h:ProvisioningMode>4</h:ProvisioningMode>")
+	assert.Equal(t, DefaultPollInterval, *slept)
+
+	responses["AMT_SetupAndConfigurationService/Get"] = []string{"workflow/ccm/setupandconfiguration-get-pre"}
+	w, client, _ = newTestWorkflow(responses)
+
+	require.NoError(t, w.Unprovision())
+	assert.Equal(t, []string{"AMT_SetupAndConfigurationService/Get"}, client.Requests)
+}
+
+func TestUnprovisionError(t *testing.T) {
+	responses := ccmResponses()
+	responses["AMT_SetupAndConfigurationService/Get"] = []string{"workflow/ccm/setupandconfiguration-get-ccm"}
+
+	w, _, _ := newTestWorkflow(responses)
+	assert.ErrorIs(t, w.Unprovision(), ErrTimeout)
+
+	for _, failure := range []string{"AMT_SetupAndConfigurationService/Get", "AMT_SetupAndConfigurationService/Unprovision"} {
+		w, client, _ := newTestWorkflow(responses)
+		client.Failures[failure] = errTransport
+
+		assert.ErrorIs(t, w.Unprovision(), errTransport, failure)
+	}
+}
@@ -0,0 +1,136 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"errors"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+)
+
+var (
+	// ErrClientCertificateRequired is returned for an EAP-TLS configuration without a client certificate.
+	ErrClientCertificateRequired = errors.New("802.1X: EAP-TLS requires a client certificate")
+	// ErrCredentialsRequired is returned for a password based 802.1X configuration without a username or password.
+	ErrCredentialsRequired = errors.New("802.1X: the authentication protocol requires a username and a password")
+	// ErrCertificateNotFound is returned when the device reports a certificate as a duplicate, but does not list it.
+	ErrCertificateNotFound = errors.New("certificate reported as duplicate was not found on the device")
+)
+
+// ieee8021xCredentials holds the handles of the certificates of an 802.1X configuration on the device.
+type ieee8021xCredentials struct {
+	ClientCertificate string
+	CACertificate     string
+}
+
+// validateIEEE8021x checks that an 802.1X configuration holds what its authentication protocol requires.
+func validateIEEE8021x(settings *config.IEEE8021x) error {
+	switch models.AuthenticationProtocol(settings.AuthenticationProtocol) {
+	case models.AuthenticationProtocol_EAPTLS:
+		if settings.ClientCert == "" || settings.PrivateKey == "" {
+			return ErrClientCertificateRequired
+		}
+	default:
+		if settings.Username == "" || settings.Password == "" {
+			return ErrCredentialsRequired
+		}
+	}
+
+	return nil
+}
+
+// addIEEE8021xCredentials adds the private key, client certificate and CA certificate of an 802.1X configuration to the device,
+// and returns the handles of the certificates. Keys and certificates already on the device are reused.
+func (w Workflow) addIEEE8021xCredentials(settings *config.IEEE8021x) (credentials ieee8021xCredentials, err error) {
+	if settings.PrivateKey != "" {
+		response, err := w.messages.AMT.PublicKeyManagementService.AddKey(settings.PrivateKey)
+		returnValue := response.Body.AddKey_OUTPUT.ReturnValue
+
+		if returnValue != publickey.ReturnValueDuplicate {
+			if err := methodError("AMT_PublicKeyManagementService.AddKey", int(returnValue), err); err != nil {
+				return credentials, err
+			}
+		}
+	}
+
+	if settings.ClientCert != "" {
+		credentials.ClientCertificate, err = w.addCertificate(settings.ClientCert, false)
+		if err != nil {
+			return credentials, err
+		}
+	}
+
+	if settings.CACert != "" {
+		credentials.CACertificate, err = w.addCertificate(settings.CACert, true)
+		if err != nil {
+			return credentials, err
+		}
+	}
+
+	return credentials, nil
+}
+
+// addCertificate adds a base64 encoded certificate to the device, as a trusted root certificate if trustedRoot is set,
+// and returns its handle. The handle of the certificate already on the device is returned for a duplicate.
+func (w Workflow) addCertificate(certificate string, trustedRoot bool) (string, error) {
+	operation := "AMT_PublicKeyManagementService.AddCertificate"
+	add := w.messages.AMT.PublicKeyManagementService.AddCertificate
+
+	if trustedRoot {
+		operation = "AMT_PublicKeyManagementService.AddTrustedRootCertificate"
+		add = w.messages.AMT.PublicKeyManagementService.AddTrustedRootCertificate
+	}
+
+	response, err := add(certificate)
+
+	output := response.Body.AddCertificate_OUTPUT
+	if trustedRoot {
+		output.CreatedCertificate = response.Body.AddTrustedRootCertificate_OUTPUT.CreatedCertificate
+		output.ReturnValue = response.Body.AddTrustedRootCertificate_OUTPUT.ReturnValue
+	}
+
+	if output.ReturnValue == publickey.ReturnValueDuplicate {
+		return w.findCertificate(certificate)
+	}
+
+	if err := methodError(operation, int(output.ReturnValue), err); err != nil {
+		return "", err
+	}
+
+	return selectorValue(output.CreatedCertificate.ReferenceParameters.SelectorSet.Selectors, "InstanceID"), nil
+}
+
+// findCertificate returns the handle of the certificate of the device with the base64 encoded blob.
+func (w Workflow) findCertificate(certificate string) (string, error) {
+	enumerate, err := w.messages.AMT.PublicKeyCertificate.Enumerate()
+	if err != nil {
+		return "", err
+	}
+
+	pull, err := w.messages.AMT.PublicKeyCertificate.Pull(enumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return "", err
+	}
+
+	for _, item := range pull.Body.PullResponse.PublicKeyCertificateItems {
+		if item.X509Certificate == certificate {
+			return item.InstanceID, nil
+		}
+	}
+
+	return "", ErrCertificateNotFound
+}
+
+// methodError returns a ReturnValueError for a method that completed with a PT_STATUS other than PT_STATUS_SUCCESS, and err otherwise.
+func methodError(operation string, returnValue int, err error) error {
+	if returnValue != 0 {
+		return checkReturnValue(operation, returnValue)
+	}
+
+	return err
+}
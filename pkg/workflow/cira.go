@@ -0,0 +1,414 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/environmentdetection"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/mps"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/remoteaccess"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/userinitiatedconnection"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/httpproxy"
+)
+
+const (
+	// EnvironmentDetectionInstanceID is the InstanceID of the AMT_EnvironmentDetectionSettingData instance.
+	EnvironmentDetectionInstanceID = "Intel(r) AMT Environment Detection Settings"
+	// DefaultCIRAPeriodicInterval is the time between the connections of a periodic trigger when CIRAConfig.PeriodicInterval is zero.
+	DefaultCIRAPeriodicInterval = 25 * time.Second
+)
+
+var (
+	// ErrMPSRootCertificateRequired is returned for a CIRA configuration without the trusted root certificate of the MPS.
+	ErrMPSRootCertificateRequired = errors.New("CIRA: the trusted root certificate of the MPS is required")
+	// ErrCIRATriggerRequired is returned for a CIRA configuration without triggers.
+	ErrCIRATriggerRequired = errors.New("CIRA: at least one policy trigger is required")
+	// ErrEnvironmentDetectionRequired is returned for a CIRA configuration without environment detection domains.
+	// Intel® AMT opens a CIRA connection only when it detects that it is outside the domains of the intranet.
+	ErrEnvironmentDetectionRequired = errors.New("CIRA: at least one environment detection domain is required")
+)
+
+// CIRAConfig describes the Client Initiated Remote Access configuration applied by ConfigureCIRA.
+type CIRAConfig struct {
+	MPSAddress                  string                                 // The IPv4 address, IPv6 address or FQDN of the MPS.
+	MPSPort                     int                                    // The port of the MPS, typically 4433.
+	MPSCommonName               string                                 // The common name of the certificate of the MPS. Defaults to MPSAddress.
+	MPSRootCertificate          string                                 // The base64 encoded trusted root certificate that issued the certificate of the MPS.
+	Username                    string                                 // The username the device authenticates to the MPS with. Up to 16 alphanumeric characters.
+	Password                    string                                 // The password the device authenticates to the MPS with. Up to 16 characters.
+	Triggers                    []remoteaccess.Trigger                 // The events that open a connection to the MPS.
+	PeriodicInterval            time.Duration                          // The time between the connections of a periodic trigger, in whole seconds. Defaults to DefaultCIRAPeriodicInterval.
+	TunnelLifeTime              int                                    // The time, in seconds, a tunnel stays open. 0 keeps it open until it is closed.
+	MPSType                     remoteaccess.MPSType                   // Whether the MPS is used outside the intranet, inside it or both.
+	EnvironmentDetectionDomains []string                               // The DNS suffixes of the intranet.
+	HTTPProxies                 []httpproxy.AddProxyAccessPointRequest // The HTTP proxies used to reach the MPS, by network DNS suffix.
+}
+
+// CIRAResult holds the handles of the instances created by ConfigureCIRA.
+type CIRAResult struct {
+	MPSName                string   // The Name of the AMT_ManagementPresenceRemoteSAP of the MPS.
+	TrustedRootCertificate string   // The InstanceID of the trusted root certificate of the MPS.
+	PolicyRules            []string // The PolicyRuleNames of the AMT_RemoteAccessPolicyRule instances.
+}
+
+// CIRARemoveOptions selects what RemoveCIRA removes beyond the MPS, policy rules and environment detection domains.
+type CIRARemoveOptions struct {
+	TrustedRootCertificate string // The InstanceID of the trusted root certificate of the MPS, such as CIRAResult.TrustedRootCertificate. Empty keeps it.
+	RemoveHTTPProxies      bool   // HTTP proxy access points are also used by HTTPS boot, so they are kept by default.
+}
+
+// ConfigureCIRA configures the device to connect to an MPS.
+//
+// The MPS and policy rules already on the device are removed first. Then the trusted root certificate of the MPS is added,
+// reusing the one already on the device, the MPS and a policy rule for each trigger are added, the MPS type is applied to the policies,
+// and the environment detection domains and HTTP proxies are written. The user initiated connection interfaces are enabled
+// when the triggers include TriggerUserInitiated.
+//
+// All the requests are validated before the device is changed. The device is left as far as the configuration got when a call fails;
+// RemoveCIRA removes it.
+func (w Workflow) ConfigureCIRA(config CIRAConfig) (result CIRAResult, err error) {
+	mpServer, rules, environment, err := ciraRequests(config)
+	if err != nil {
+		return result, err
+	}
+
+	if err := w.removeCIRAServers(); err != nil {
+		return result, err
+	}
+
+	result.TrustedRootCertificate, err = w.addCertificate(config.MPSRootCertificate, true)
+	if err != nil {
+		return result, err
+	}
+
+	added, err := w.messages.AMT.RemoteAccessService.AddMPS(mpServer)
+
+	output := added.Body.AddMpServerResponse
+	if err := methodError("AMT_RemoteAccessService.AddMpServer", int(output.ReturnValue), err); err != nil {
+		return result, err
+	}
+
+	result.MPSName = selectorText(output.MpServer.ReferenceParameters.SelectorSet.Selectors, "Name")
+
+	for _, rule := range rules {
+		added, err := w.messages.AMT.RemoteAccessService.AddRemoteAccessPolicyRule(rule, result.MPSName)
+
+		output := added.Body.AddRemotePolicyRuleResponse
+		if err := methodError("AMT_RemoteAccessService.AddRemoteAccessPolicyRule", int(output.ReturnValue), err); err != nil {
+			return result, fmt.Errorf("%s trigger: %w", rule.Trigger, err)
+		}
+
+		result.PolicyRules = append(result.PolicyRules, selectorText(output.PolicyRuleResponse.ReferenceParameters.SelectorSet.Selectors, "PolicyRuleName"))
+	}
+
+	if err := w.applyMPSType(config.MPSType); err != nil {
+		return result, err
+	}
+
+	_, err = w.messages.AMT.EnvironmentDetectionSettingData.Put(environment)
+	if err != nil {
+		return result, err
+	}
+
+	for _, proxy := range config.HTTPProxies {
+		added, err := w.messages.IPS.HTTPProxyService.AddProxyAccessPoint(proxy)
+
+		returnValue := added.Body.AddProxyAccessPointResponse.ReturnValue
+		if returnValue != httpproxy.ReturnValueDuplicate {
+			if err := methodError("IPS_HTTPProxyService.AddProxyAccessPoint", int(returnValue), err); err != nil {
+				return result, fmt.Errorf("HTTP proxy %s: %w", proxy.AccessInfo, err)
+			}
+		}
+	}
+
+	for _, trigger := range config.Triggers {
+		if trigger == remoteaccess.TriggerUserInitiated {
+			return result, w.setUserInitiatedConnection(userinitiatedconnection.BIOSandOSInterfacesEnabled)
+		}
+	}
+
+	return result, nil
+}
+
+// ciraRequests converts and validates a CIRA configuration.
+func ciraRequests(config CIRAConfig) (mpServer remoteaccess.AddMpServerRequest, rules []remoteaccess.RemoteAccessPolicyRuleRequest, environment environmentdetection.EnvironmentDetectionSettingDataRequest, err error) {
+	switch {
+	case config.MPSRootCertificate == "":
+		return mpServer, rules, environment, ErrMPSRootCertificateRequired
+	case len(config.Triggers) == 0:
+		return mpServer, rules, environment, ErrCIRATriggerRequired
+	case len(config.EnvironmentDetectionDomains) == 0:
+		return mpServer, rules, environment, ErrEnvironmentDetectionRequired
+	}
+
+	mpServer = remoteaccess.AddMpServerRequest{
+		AccessInfo: config.MPSAddress,
+		InfoFormat: remoteaccess.FQDN,
+		Port:       config.MPSPort,
+		AuthMethod: remoteaccess.UsernamePasswordAuthentication,
+		Username:   config.Username,
+		Password:   config.Password,
+		CommonName: config.MPSCommonName,
+	}
+
+	if ip := net.ParseIP(config.MPSAddress); ip != nil {
+		mpServer.InfoFormat = remoteaccess.IPv6Address
+		if ip.To4() != nil {
+			mpServer.InfoFormat = remoteaccess.IPv4Address
+		}
+	}
+
+	if mpServer.CommonName == "" {
+		mpServer.CommonName = config.MPSAddress
+	}
+
+	if err := mpServer.Validate(); err != nil {
+		return mpServer, rules, environment, err
+	}
+
+	for _, trigger := range config.Triggers {
+		rule := remoteaccess.RemoteAccessPolicyRuleRequest{
+			Trigger:        trigger,
+			TunnelLifeTime: config.TunnelLifeTime,
+		}
+
+		if trigger == remoteaccess.TriggerPeriodic {
+			rule.ExtendedData = periodicExtendedData(config.PeriodicInterval)
+		}
+
+		if err := rule.Validate(); err != nil {
+			return mpServer, rules, environment, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	environment = environmentdetection.EnvironmentDetectionSettingDataRequest{
+		ElementName:        EnvironmentDetectionInstanceID,
+		InstanceID:         EnvironmentDetectionInstanceID,
+		DetectionAlgorithm: environmentdetection.LocalDomains,
+		DetectionStrings:   config.EnvironmentDetectionDomains,
+	}
+
+	if err := environment.Validate(); err != nil {
+		return mpServer, rules, environment, err
+	}
+
+	for _, proxy := range config.HTTPProxies {
+		if err := proxy.Validate(); err != nil {
+			return mpServer, rules, environment, err
+		}
+	}
+
+	return mpServer, rules, environment, nil
+}
+
+// periodicExtendedData returns the ExtendedData of a periodic policy rule that connects every interval:
+// the interval periodic type (0) followed by the interval in seconds, as network order uint32 values.
+func periodicExtendedData(interval time.Duration) string {
+	if interval <= 0 {
+		interval = DefaultCIRAPeriodicInterval
+	}
+
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint32(data[4:], uint32(interval/time.Second))
+
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// applyMPSType sets the MPS type of the AMT_RemoteAccessPolicyAppliesToMPS instances that have another one.
+func (w Workflow) applyMPSType(mpsType remoteaccess.MPSType) error {
+	enumerate, err := w.messages.AMT.RemoteAccessPolicyAppliesToMPS.Enumerate()
+	if err != nil {
+		return err
+	}
+
+	pull, err := w.messages.AMT.RemoteAccessPolicyAppliesToMPS.Pull(enumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range pull.Body.PullResponse.PolicyAppliesItems {
+		if item.MpsType == mpsType {
+			continue
+		}
+
+		_, err := w.messages.AMT.RemoteAccessPolicyAppliesToMPS.Put(&remoteaccess.RemoteAccessPolicyAppliesToMPSRequest{
+			ManagedElement: remoteaccess.ManagedElement{
+				Address:             item.ManagedElement.Address,
+				B:                   "http://schemas.xmlsoap.org/ws/2004/08/addressing",
+				ReferenceParameters: policyReferenceParameters(item.ManagedElement.ReferenceParameters),
+			},
+			OrderOfAccess: item.OrderOfAccess,
+			MPSType:       mpsType,
+			PolicySet: remoteaccess.PolicySet{
+				Address:             item.PolicySet.Address,
+				B:                   "http://schemas.xmlsoap.org/ws/2004/08/addressing",
+				ReferenceParameters: policyReferenceParameters(item.PolicySet.ReferenceParameters),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func policyReferenceParameters(reference remoteaccess.ReferenceParametersResponse) remoteaccess.ReferenceParameters {
+	parameters := remoteaccess.ReferenceParameters{
+		ResourceURI: reference.ResourceURI,
+		C:           "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd",
+	}
+
+	for _, selector := range reference.SelectorSet.Selectors {
+		parameters.SelectorSet.Selectors = append(parameters.SelectorSet.Selectors, remoteaccess.Selector{Name: selector.Name, Text: selector.Text})
+	}
+
+	return parameters
+}
+
+// setUserInitiatedConnection sets the interfaces from which the user can open a CIRA connection.
+func (w Workflow) setUserInitiatedConnection(state userinitiatedconnection.RequestedState) error {
+	response, err := w.messages.AMT.UserInitiatedConnectionService.RequestStateChange(state)
+
+	return methodError("AMT_UserInitiatedConnectionService.RequestStateChange", int(response.Body.RequestStateChange_OUTPUT.ReturnValue), err)
+}
+
+// removeCIRAServers deletes the policy rules, then the MPSs, of the device.
+func (w Workflow) removeCIRAServers() error {
+	rulesEnumerate, err := w.messages.AMT.RemoteAccessPolicyRule.Enumerate()
+	if err != nil {
+		return err
+	}
+
+	rules, err := w.messages.AMT.RemoteAccessPolicyRule.Pull(rulesEnumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules.Body.PullResponse.RemotePolicyRuleItems {
+		_, err := w.messages.AMT.RemoteAccessPolicyRule.Delete(rule.PolicyRuleName)
+		if err != nil {
+			return fmt.Errorf("deleting policy rule %s: %w", rule.PolicyRuleName, err)
+		}
+	}
+
+	serversEnumerate, err := w.messages.AMT.ManagementPresenceRemoteSAP.Enumerate()
+	if err != nil {
+		return err
+	}
+
+	servers, err := w.messages.AMT.ManagementPresenceRemoteSAP.Pull(serversEnumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return err
+	}
+
+	for _, server := range servers.Body.PullResponse.ManagementRemoteItems {
+		_, err := w.messages.AMT.ManagementPresenceRemoteSAP.Delete(server.Name)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %w", server.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveCIRA removes the CIRA configuration of the device: it disables the user initiated connection interfaces,
+// deletes the policy rules and MPSs, and clears the environment detection domains. The trusted root certificate of the MPS
+// and the HTTP proxies are removed as selected by options.
+//
+// Every step is attempted even when an earlier one fails. The errors of the failed steps are returned together.
+func (w Workflow) RemoveCIRA(options CIRARemoveOptions) error {
+	var errs []error
+
+	if err := w.setUserInitiatedConnection(userinitiatedconnection.AllInterfacesDisabled); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := w.removeCIRAServers(); err != nil {
+		errs = append(errs, err)
+	}
+
+	_, err := w.messages.AMT.EnvironmentDetectionSettingData.Put(environmentdetection.EnvironmentDetectionSettingDataRequest{
+		ElementName:        EnvironmentDetectionInstanceID,
+		InstanceID:         EnvironmentDetectionInstanceID,
+		DetectionAlgorithm: environmentdetection.LocalDomains,
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if options.TrustedRootCertificate != "" {
+		_, err := w.messages.AMT.PublicKeyCertificate.Delete(options.TrustedRootCertificate)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deleting %s: %w", options.TrustedRootCertificate, err))
+		}
+	}
+
+	if options.RemoveHTTPProxies {
+		if err := w.removeHTTPProxies(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// removeHTTPProxies deletes the HTTP proxy access points of the device.
+func (w Workflow) removeHTTPProxies() error {
+	enumerate, err := w.messages.IPS.HTTPProxyAccessPoint.Enumerate()
+	if err != nil {
+		return err
+	}
+
+	pull, err := w.messages.IPS.HTTPProxyAccessPoint.Pull(enumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return err
+	}
+
+	for _, accessPoint := range pull.Body.PullResponse.AccessPointItems {
+		_, err := w.messages.IPS.HTTPProxyAccessPoint.Delete(accessPoint.Name)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %w", accessPoint.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SetMPSCredentials changes the username and password the device authenticates to the MPS with, without reconfiguring CIRA.
+func (w Workflow) SetMPSCredentials(username, password string) error {
+	current, err := w.messages.AMT.MPSUsernamePassword.Get()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.messages.AMT.MPSUsernamePassword.Put(mps.MPSUsernamePasswordRequest{
+		InstanceID: current.Body.GetResponse.InstanceID,
+		RemoteID:   username,
+		Secret:     password,
+	})
+
+	return err
+}
+
+// selectorText returns the value of the named selector of a remote access reference.
+func selectorText(selectors []remoteaccess.SelectorResponse, name string) string {
+	for _, selector := range selectors {
+		if selector.Name == name {
+			return selector.Text
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,239 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/remoteaccess"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/httpproxy"
+)
+
+func ciraResponses() map[string][]string {
+	return map[string][]string{
+		"AMT_RemoteAccessPolicyRule/Enumerate":                     {"amt/remoteaccess/policyrule/enumerate"},
+		"AMT_RemoteAccessPolicyRule/Pull":                          {"amt/remoteaccess/policyrule/pull"},
+		"AMT_RemoteAccessPolicyRule/Delete":                        {"amt/remoteaccess/policyrule/delete"},
+		"AMT_ManagementPresenceRemoteSAP/Enumerate":                {"amt/managementpresence/enumerate"},
+		"AMT_ManagementPresenceRemoteSAP/Pull":                     {"amt/managementpresence/pull"},
+		"AMT_ManagementPresenceRemoteSAP/Delete":                   {"amt/managementpresence/delete"},
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate": {"amt/publickey/management/addtrustedrootcertificate"},
+		"AMT_PublicKeyCertificate/Delete":                          {"amt/publickey/certificate/delete"},
+		"AMT_RemoteAccessService/AddMpServer":                      {"amt/remoteaccess/service/addmpsserver"},
+		"AMT_RemoteAccessService/AddRemoteAccessPolicyRule":        {"amt/remoteaccess/service/addremoteaccessservice"},
+		"AMT_RemoteAccessPolicyAppliesToMPS/Enumerate":             {"amt/remoteaccess/policyappliestomps/enumerate"},
+		"AMT_RemoteAccessPolicyAppliesToMPS/Pull":                  {"amt/remoteaccess/policyappliestomps/pull"},
+		"AMT_RemoteAccessPolicyAppliesToMPS/Put":                   {"amt/remoteaccess/policyappliestomps/put"},
+		"AMT_EnvironmentDetectionSettingData/Put":                  {"amt/environmentdetection/put"},
+		"IPS_HTTPProxyService/AddProxyAccessPoint":                 {"ips/httpproxy/service/addproxyaccesspoint"},
+		"IPS_HTTPProxyAccessPoint/Enumerate":                       {"ips/httpproxy/accesspoint/enumerate"},
+		"IPS_HTTPProxyAccessPoint/Pull":                            {"ips/httpproxy/accesspoint/pull"},
+		"IPS_HTTPProxyAccessPoint/Delete":                          {"ips/httpproxy/accesspoint/delete"},
+		"AMT_UserInitiatedConnectionService/RequestStateChange":    {"amt/userinitiatedconnection/request"},
+		"AMT_MPSUsernamePassword/Get":                              {"amt/mps/get"},
+		"AMT_MPSUsernamePassword/Put":                              {"amt/mps/put"},
+	}
+}
+
+func ciraConfig() CIRAConfig {
+	return CIRAConfig{
+		MPSAddress:                  "192.168.1.10",
+		MPSPort:                     4433,
+		MPSCommonName:               "mps.example.com",
+		MPSRootCertificate:          "cm9vdA==",
+		Username:                    "admin",
+		Password:                    "P@ssw0rd",
+		Triggers:                    []remoteaccess.Trigger{remoteaccess.TriggerUserInitiated, remoteaccess.TriggerAlert, remoteaccess.TriggerPeriodic},
+		PeriodicInterval:            time.Minute,
+		MPSType:                     remoteaccess.BothMPS,
+		EnvironmentDetectionDomains: []string{"corp.example.com"},
+		HTTPProxies: []httpproxy.AddProxyAccessPointRequest{
+			{AccessInfo: "proxy.example.com", InfoFormat: httpproxy.InfoFormatFQDN, Port: 911, NetworkDnsSuffix: "example.com"},
+		},
+	}
+}
+
+func TestConfigureCIRA(t *testing.T) {
+	w, client, _ := newTestWorkflow(ciraResponses())
+
+	result, err := w.ConfigureCIRA(ciraConfig())
+	require.NoError(t, err)
+	assert.Equal(t, "Intel(r) AMT:Management Presence Server 0", result.MPSName)
+	assert.Equal(t, "Intel(r) AMT Certificate: Handle: 2", result.TrustedRootCertificate)
+	assert.Len(t, result.PolicyRules, 3)
+	assert.Equal(t, []string{
+		"AMT_RemoteAccessPolicyRule/Enumerate",
+		"AMT_RemoteAccessPolicyRule/Pull",
+		"AMT_RemoteAccessPolicyRule/Delete",
+		"AMT_ManagementPresenceRemoteSAP/Enumerate",
+		"AMT_ManagementPresenceRemoteSAP/Pull",
+		"AMT_ManagementPresenceRemoteSAP/Delete",
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate",
+		"AMT_RemoteAccessService/AddMpServer",
+		"AMT_RemoteAccessService/AddRemoteAccessPolicyRule",
+		"AMT_RemoteAccessService/AddRemoteAccessPolicyRule",
+		"AMT_RemoteAccessService/AddRemoteAccessPolicyRule",
+		"AMT_RemoteAccessPolicyAppliesToMPS/Enumerate",
+		"AMT_RemoteAccessPolicyAppliesToMPS/Pull",
+		"AMT_RemoteAccessPolicyAppliesToMPS/Put",
+		"AMT_EnvironmentDetectionSettingData/Put",
+		"IPS_HTTPProxyService/AddProxyAccessPoint",
+		"AMT_UserInitiatedConnectionService/RequestStateChange",
+	}, client.Requests)
+
+	addMPS := client.Messages[7]
+	assert.Contains(t, addMPS, "<h:AccessInfo>192.168.1.10</h:AccessInfo>")
+	assert.Contains(t, addMPS, "<h:InfoFormat>3</h:InfoFormat>")
+	assert.Contains(t, addMPS, "<h:CN>mps.example.com</h:CN>")
+	assert.Contains(t, addMPS, "<h:AuthMethod>2</h:AuthMethod>")
+
+	assert.Contains(t, client.Messages[10], "<h:ExtendedData>AAAAAAAAADw=</h:ExtendedData>")
+	assert.Contains(t, client.Messages[13], "<h:MpsType>2</h:MpsType>")
+	assert.Contains(t, client.Messages[13], "Intel(r) AMT:Management Presence Server 0")
+	assert.Contains(t, client.Messages[14], "<h:DetectionStrings>corp.example.com</h:DetectionStrings>")
+	assert.Contains(t, client.Messages[16], "<h:RequestedState>32771</h:RequestedState>")
+}
+
+func TestConfigureCIRAKeepsMPSType(t *testing.T) {
+	w, client, _ := newTestWorkflow(ciraResponses())
+	config := ciraConfig()
+	config.MPSType = remoteaccess.ExternalMPS
+	config.Triggers = []remoteaccess.Trigger{remoteaccess.TriggerPeriodic}
+	config.HTTPProxies = nil
+
+	_, err := w.ConfigureCIRA(config)
+	require.NoError(t, err)
+	assert.NotContains(t, client.Requests, "AMT_RemoteAccessPolicyAppliesToMPS/Put")
+	assert.NotContains(t, client.Requests, "AMT_UserInitiatedConnectionService/RequestStateChange")
+}
+
+func TestPeriodicExtendedData(t *testing.T) {
+	assert.Equal(t, "AAAAAAAAABk=", periodicExtendedData(0))
+	assert.Equal(t, "AAAAAAAADhA=", periodicExtendedData(time.Hour))
+}
+
+func TestConfigureCIRAInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(config *CIRAConfig)
+		expected error
+	}{
+		{"no root certificate", func(config *CIRAConfig) { config.MPSRootCertificate = "" }, ErrMPSRootCertificateRequired},
+		{"no triggers", func(config *CIRAConfig) { config.Triggers = nil }, ErrCIRATriggerRequired},
+		{"no domains", func(config *CIRAConfig) { config.EnvironmentDetectionDomains = nil }, ErrEnvironmentDetectionRequired},
+		{"no password", func(config *CIRAConfig) { config.Password = "" }, common.ErrInvalidRequest},
+		{"empty domain", func(config *CIRAConfig) { config.EnvironmentDetectionDomains = []string{""} }, common.ErrInvalidRequest},
+		{"no proxy address", func(config *CIRAConfig) { config.HTTPProxies[0].AccessInfo = "" }, common.ErrInvalidRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(ciraResponses())
+			config := ciraConfig()
+			test.modify(&config)
+
+			_, err := w.ConfigureCIRA(config)
+			assert.ErrorIs(t, err, test.expected)
+			assert.Empty(t, client.Requests)
+		})
+	}
+}
+
+func TestConfigureCIRAAddMPSFailure(t *testing.T) {
+	responses := ciraResponses()
+	responses["AMT_RemoteAccessService/AddMpServer"] = []string{"workflow/cira/addmpsserver-failure"}
+	w, client, _ := newTestWorkflow(responses)
+
+	_, err := w.ConfigureCIRA(ciraConfig())
+
+	var returnValueError *ReturnValueError
+
+	require.ErrorAs(t, err, &returnValueError)
+	assert.Equal(t, "AMT_RemoteAccessService.AddMpServer", returnValueError.Operation)
+	assert.NotContains(t, client.Requests, "AMT_RemoteAccessService/AddRemoteAccessPolicyRule")
+}
+
+func TestConfigureCIRAError(t *testing.T) {
+	for failure := range ciraResponses() {
+		switch failure {
+		case "AMT_PublicKeyCertificate/Delete", "IPS_HTTPProxyAccessPoint/Enumerate", "IPS_HTTPProxyAccessPoint/Pull",
+			"IPS_HTTPProxyAccessPoint/Delete", "AMT_MPSUsernamePassword/Get", "AMT_MPSUsernamePassword/Put":
+			continue
+		}
+
+		t.Run(failure, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(ciraResponses())
+			client.Failures[failure] = errTransport
+
+			_, err := w.ConfigureCIRA(ciraConfig())
+			assert.ErrorIs(t, err, errTransport)
+		})
+	}
+}
+
+func TestRemoveCIRA(t *testing.T) {
+	w, client, _ := newTestWorkflow(ciraResponses())
+
+	err := w.RemoveCIRA(CIRARemoveOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"AMT_UserInitiatedConnectionService/RequestStateChange",
+		"AMT_RemoteAccessPolicyRule/Enumerate",
+		"AMT_RemoteAccessPolicyRule/Pull",
+		"AMT_RemoteAccessPolicyRule/Delete",
+		"AMT_ManagementPresenceRemoteSAP/Enumerate",
+		"AMT_ManagementPresenceRemoteSAP/Pull",
+		"AMT_ManagementPresenceRemoteSAP/Delete",
+		"AMT_EnvironmentDetectionSettingData/Put",
+	}, client.Requests)
+	assert.Contains(t, client.Messages[0], "<h:RequestedState>32768</h:RequestedState>")
+	assert.NotContains(t, client.Messages[7], "DetectionStrings")
+
+	w, client, _ = newTestWorkflow(ciraResponses())
+
+	err = w.RemoveCIRA(CIRARemoveOptions{TrustedRootCertificate: "Intel(r) AMT Certificate: Handle: 2", RemoveHTTPProxies: true})
+	require.NoError(t, err)
+	assert.Contains(t, client.Requests, "AMT_PublicKeyCertificate/Delete")
+	assert.Equal(t, []string{
+		"IPS_HTTPProxyAccessPoint/Enumerate",
+		"IPS_HTTPProxyAccessPoint/Pull",
+		"IPS_HTTPProxyAccessPoint/Delete",
+		"IPS_HTTPProxyAccessPoint/Delete",
+	}, client.Requests[len(client.Requests)-4:])
+}
+
+func TestRemoveCIRAContinuesAfterFailure(t *testing.T) {
+	w, client, _ := newTestWorkflow(ciraResponses())
+	client.Failures["AMT_UserInitiatedConnectionService/RequestStateChange"] = errTransport
+	client.Failures["AMT_RemoteAccessPolicyRule/Enumerate"] = errTransport
+
+	err := w.RemoveCIRA(CIRARemoveOptions{})
+	assert.ErrorIs(t, err, errTransport)
+	assert.Contains(t, client.Requests, "AMT_EnvironmentDetectionSettingData/Put")
+}
+
+func TestSetMPSCredentials(t *testing.T) {
+	w, client, _ := newTestWorkflow(ciraResponses())
+
+	err := w.SetMPSCredentials("device", "S3cret")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AMT_MPSUsernamePassword/Get", "AMT_MPSUsernamePassword/Put"}, client.Requests)
+	assert.Contains(t, client.Messages[1], "<h:InstanceID>Intel(r) AMT:MPS Username Password 0</h:InstanceID>")
+	assert.Contains(t, client.Messages[1], "<h:RemoteID>device</h:RemoteID>")
+	assert.Contains(t, client.Messages[1], "<h:Secret>S3cret</h:Secret>")
+
+	for _, failure := range []string{"AMT_MPSUsernamePassword/Get", "AMT_MPSUsernamePassword/Put"} {
+		w, client, _ := newTestWorkflow(ciraResponses())
+		client.Failures[failure] = errTransport
+
+		assert.ErrorIs(t, w.SetMPSCredentials("device", "S3cret"), errTransport, failure)
+	}
+}
@@ -0,0 +1,165 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+	amtieee8021x "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ieee8021x"
+	ipsieee8021x "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/ieee8021x"
+)
+
+const (
+	// IEEE8021xSettingsInstanceID is the InstanceID of the IPS_IEEE8021xSettings instance of the wired interface.
+	IEEE8021xSettingsInstanceID = "Intel(r) AMT: 8021X Settings"
+	// MaxPXETimeout is the longest time, in seconds, Intel® AMT holds an authenticated 802.1X session while a PXE boot takes place.
+	MaxPXETimeout = 86400
+)
+
+var (
+	// ErrUnsupportedAuthenticationProtocol is returned for a wired 802.1X protocol other than EAP-TLS and PEAPv0/EAP-MSCHAPv2.
+	ErrUnsupportedAuthenticationProtocol = errors.New("802.1X: the wired interface supports EAP-TLS and PEAPv0/EAP-MSCHAPv2 only")
+	// ErrInvalidPXETimeout is returned for a PXE timeout outside 0 to MaxPXETimeout seconds.
+	ErrInvalidPXETimeout = errors.New("802.1X: the PXE timeout must be between 0 and 86400 seconds")
+	// ErrIEEE8021xNotApplied is returned when the 802.1X profile read back from the device does not match the configuration.
+	ErrIEEE8021xNotApplied = errors.New("802.1X: the profile of the device does not match the configuration")
+)
+
+// WiredIEEE8021xResult holds the handles of the certificates bound to the wired 802.1X settings and the profile read back from the device.
+type WiredIEEE8021xResult struct {
+	ClientCertificate string // The InstanceID of the client certificate. Empty for PEAPv0/EAP-MSCHAPv2.
+	CACertificate     string // The InstanceID of the trusted root certificate of the authentication server. Empty when the device looks it up.
+	Profile           amtieee8021x.ProfileResponse
+}
+
+// ConfigureWiredIEEE8021x configures 802.1X authentication on the wired interface of the device.
+//
+// The private key, client certificate and CA certificate of settings are added to the device, reusing the ones already there,
+// then IPS_IEEE8021xSettings is written and bound to the certificates with SetCertificates. Finally the AMT_8021XProfile
+// of the device is read back and compared with settings. A PXETimeout of 0 keeps the firmware default of 120 seconds.
+//
+// The settings are left in place when binding or verifying fails; DisableWiredIEEE8021x turns them off.
+func (w Workflow) ConfigureWiredIEEE8021x(settings config.IEEE8021x) (result WiredIEEE8021xResult, err error) {
+	protocol := settings.AuthenticationProtocol
+	if protocol != ipsieee8021x.AuthenticationProtocolEAPTLS && protocol != ipsieee8021x.AuthenticationProtocolPEAPv0_EAPMSCHAPv2 {
+		return result, fmt.Errorf("%w: %d", ErrUnsupportedAuthenticationProtocol, protocol)
+	}
+
+	if settings.PXETimeout < 0 || settings.PXETimeout > MaxPXETimeout {
+		return result, ErrInvalidPXETimeout
+	}
+
+	if err := validateIEEE8021x(&settings); err != nil {
+		return result, err
+	}
+
+	credentials, err := w.addIEEE8021xCredentials(&settings)
+	if err != nil {
+		return result, err
+	}
+
+	result.ClientCertificate = credentials.ClientCertificate
+	result.CACertificate = credentials.CACertificate
+
+	request := ipsieee8021x.IEEE8021xSettingsRequest{
+		ElementName:            IEEE8021xSettingsInstanceID,
+		InstanceID:             IEEE8021xSettingsInstanceID,
+		AuthenticationProtocol: protocol,
+		Username:               settings.Username,
+		Enabled:                int(ipsieee8021x.EnabledWithoutCertificates),
+		PxeTimeout:             settings.PXETimeout,
+		AvailableInS0:          true,
+	}
+
+	if protocol != ipsieee8021x.AuthenticationProtocolEAPTLS {
+		request.Password = settings.Password
+	}
+
+	bindCertificates := credentials.ClientCertificate != "" || credentials.CACertificate != ""
+	if bindCertificates {
+		request.Enabled = int(ipsieee8021x.EnabledWithCertificates)
+	}
+
+	_, err = w.messages.IPS.IEEE8021xSettings.Put(request)
+	if err != nil {
+		return result, err
+	}
+
+	if bindCertificates {
+		response, err := w.messages.IPS.IEEE8021xSettings.SetCertificates(credentials.CACertificate, credentials.ClientCertificate)
+
+		err = methodError("IPS_IEEE8021xSettings.SetCertificates", int(response.Body.SetCertificatesResponse.ReturnValue), err)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	profile, err := w.messages.AMT.IEEE8021xProfile.Get()
+	if err != nil {
+		return result, err
+	}
+
+	result.Profile = profile.Body.ProfileGetAndPutResponse
+
+	return result, verifyIEEE8021xProfile(result.Profile, settings)
+}
+
+// verifyIEEE8021xProfile checks that the profile read back from the device is enabled with the protocol, username and PXE timeout of settings.
+func verifyIEEE8021xProfile(profile amtieee8021x.ProfileResponse, settings config.IEEE8021x) error {
+	switch {
+	case !profile.Enabled:
+		return fmt.Errorf("%w: the profile is disabled", ErrIEEE8021xNotApplied)
+	case int(profile.AuthenticationProtocol) != settings.AuthenticationProtocol:
+		return fmt.Errorf("%w: the authentication protocol is %s", ErrIEEE8021xNotApplied, profile.AuthenticationProtocol)
+	case profile.Username != settings.Username:
+		return fmt.Errorf("%w: the username is %q", ErrIEEE8021xNotApplied, profile.Username)
+	case settings.PXETimeout != 0 && profile.PxeTimeout != settings.PXETimeout:
+		return fmt.Errorf("%w: the PXE timeout is %d seconds", ErrIEEE8021xNotApplied, profile.PxeTimeout)
+	}
+
+	return nil
+}
+
+// DisableWiredIEEE8021x disables 802.1X authentication on the wired interface of the device, keeping the other settings of its AMT_8021XProfile,
+// and checks that IPS_IEEE8021xSettings reports it disabled. The certificates and key stay on the device; DeleteUnreferenced removes them.
+func (w Workflow) DisableWiredIEEE8021x() error {
+	profile, err := w.messages.AMT.IEEE8021xProfile.Get()
+	if err != nil {
+		return err
+	}
+
+	current := profile.Body.ProfileGetAndPutResponse
+
+	_, err = w.messages.AMT.IEEE8021xProfile.Put(amtieee8021x.ProfileRequest{
+		ElementName:                     current.ElementName,
+		InstanceID:                      current.InstanceID,
+		Enabled:                         false,
+		ActiveInS0:                      current.ActiveInS0,
+		AuthenticationProtocol:          current.AuthenticationProtocol,
+		RoamingIdentity:                 current.RoamingIdentity,
+		ServerCertificateName:           current.ServerCertificateName,
+		ServerCertificateNameComparison: current.ServerCertificateNameComparison,
+		Username:                        current.Username,
+		Domain:                          current.Domain,
+		PxeTimeout:                      current.PxeTimeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	settings, err := w.messages.IPS.IEEE8021xSettings.Get()
+	if err != nil {
+		return err
+	}
+
+	if enabled := settings.Body.IEEE8021xSettingsResponse.Enabled; enabled != ipsieee8021x.Disabled {
+		return fmt.Errorf("%w: the settings are %s", ErrIEEE8021xNotApplied, enabled)
+	}
+
+	return nil
+}
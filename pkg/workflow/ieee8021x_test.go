@@ -0,0 +1,201 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+)
+
+func ieee8021xResponses() map[string][]string {
+	return map[string][]string{
+		"AMT_PublicKeyManagementService/AddKey":                    {"amt/publickey/management/addkey"},
+		"AMT_PublicKeyManagementService/AddCertificate":            {"amt/publickey/management/addcertificate"},
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate": {"amt/publickey/management/addtrustedrootcertificate"},
+		"IPS_IEEE8021xSettings/Put":                                {"workflow/ieee8021x/settings-put"},
+		"IPS_IEEE8021xSettings/SetCertificates":                    {"ips/ieee8021x/settings/setcertificates"},
+		"IPS_IEEE8021xSettings/Get":                                {"ips/ieee8021x/settings/get"},
+		"AMT_8021XProfile/Get":                                     {"workflow/ieee8021x/profile-get-eaptls"},
+		"AMT_8021XProfile/Put":                                     {"amt/ieee8021x/profile/put"},
+	}
+}
+
+func eapTLSSettings() config.IEEE8021x {
+	return config.IEEE8021x{
+		Username:   "device",
+		ClientCert: "Y2xpZW50",
+		CACert:     "Y2E=",
+		PrivateKey: "a2V5",
+		PXETimeout: 120,
+	}
+}
+
+func TestConfigureWiredIEEE8021xEAPTLS(t *testing.T) {
+	w, client, _ := newTestWorkflow(ieee8021xResponses())
+
+	result, err := w.ConfigureWiredIEEE8021x(eapTLSSettings())
+	require.NoError(t, err)
+	assert.Equal(t, "Intel(r) AMT Certificate: Handle: 1", result.ClientCertificate)
+	assert.Equal(t, "Intel(r) AMT Certificate: Handle: 2", result.CACertificate)
+	assert.True(t, result.Profile.Enabled)
+	assert.Equal(t, []string{
+		"AMT_PublicKeyManagementService/AddKey",
+		"AMT_PublicKeyManagementService/AddCertificate",
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate",
+		"IPS_IEEE8021xSettings/Put",
+		"IPS_IEEE8021xSettings/SetCertificates",
+		"AMT_8021XProfile/Get",
+	}, client.Requests)
+
+	put := client.Messages[3]
+	assert.Contains(t, put, "<h:AuthenticationProtocol>0</h:AuthenticationProtocol>")
+	assert.Contains(t, put, "<h:Username>device</h:Username>")
+	assert.Contains(t, put, "<h:Enabled>2</h:Enabled>")
+	assert.Contains(t, put, "<h:PxeTimeout>120</h:PxeTimeout>")
+	assert.NotContains(t, put, "Password")
+
+	setCertificates := client.Messages[4]
+	assert.Regexp(t, `ServerCertificateIssuer>.*Handle: 2.*</h:ServerCertificateIssuer>`, setCertificates)
+	assert.Regexp(t, `ClientCertificate>.*Handle: 1.*</h:ClientCertificate>`, setCertificates)
+}
+
+func TestConfigureWiredIEEE8021xPEAP(t *testing.T) {
+	responses := ieee8021xResponses()
+	responses["AMT_8021XProfile/Get"] = []string{"workflow/ieee8021x/profile-get-peap"}
+	w, client, _ := newTestWorkflow(responses)
+
+	result, err := w.ConfigureWiredIEEE8021x(config.IEEE8021x{
+		Username:               "user",
+		Password:               "P@ssw0rd",
+		AuthenticationProtocol: 2,
+		PXETimeout:             60,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, result.ClientCertificate)
+	assert.Empty(t, result.CACertificate)
+	assert.Equal(t, []string{"IPS_IEEE8021xSettings/Put", "AMT_8021XProfile/Get"}, client.Requests)
+	assert.Contains(t, client.Messages[0], "<h:Password>P@ssw0rd</h:Password>")
+	assert.Contains(t, client.Messages[0], "<h:Enabled>6</h:Enabled>")
+}
+
+func TestConfigureWiredIEEE8021xInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(settings *config.IEEE8021x)
+		expected error
+	}{
+		{"EAP-TTLS", func(settings *config.IEEE8021x) { settings.AuthenticationProtocol = 1 }, ErrUnsupportedAuthenticationProtocol},
+		{"negative PXE timeout", func(settings *config.IEEE8021x) { settings.PXETimeout = -1 }, ErrInvalidPXETimeout},
+		{"PXE timeout over a day", func(settings *config.IEEE8021x) { settings.PXETimeout = MaxPXETimeout + 1 }, ErrInvalidPXETimeout},
+		{"EAP-TLS without client certificate", func(settings *config.IEEE8021x) { settings.ClientCert = "" }, ErrClientCertificateRequired},
+		{"PEAP without password", func(settings *config.IEEE8021x) { settings.AuthenticationProtocol = 2 }, ErrCredentialsRequired},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(ieee8021xResponses())
+			settings := eapTLSSettings()
+			test.modify(&settings)
+
+			_, err := w.ConfigureWiredIEEE8021x(settings)
+			assert.ErrorIs(t, err, test.expected)
+			assert.Empty(t, client.Requests)
+		})
+	}
+}
+
+func TestConfigureWiredIEEE8021xNotApplied(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  string
+		modify   func(settings *config.IEEE8021x)
+		expected string
+	}{
+		{"disabled", "amt/ieee8021x/profile/get", func(settings *config.IEEE8021x) {}, "the profile is disabled"},
+		{"protocol", "workflow/ieee8021x/profile-get-peap", func(settings *config.IEEE8021x) {}, "the authentication protocol is PEAPMSCHAPv2"},
+		{"username", "workflow/ieee8021x/profile-get-eaptls", func(settings *config.IEEE8021x) { settings.Username = "other" }, `the username is "device"`},
+		{"PXE timeout", "workflow/ieee8021x/profile-get-eaptls", func(settings *config.IEEE8021x) { settings.PXETimeout = 60 }, "the PXE timeout is 120 seconds"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			responses := ieee8021xResponses()
+			responses["AMT_8021XProfile/Get"] = []string{test.profile}
+			w, _, _ := newTestWorkflow(responses)
+			settings := eapTLSSettings()
+			test.modify(&settings)
+
+			_, err := w.ConfigureWiredIEEE8021x(settings)
+			assert.ErrorIs(t, err, ErrIEEE8021xNotApplied)
+			assert.ErrorContains(t, err, test.expected)
+		})
+	}
+}
+
+func TestConfigureWiredIEEE8021xSetCertificatesFailure(t *testing.T) {
+	responses := ieee8021xResponses()
+	responses["IPS_IEEE8021xSettings/SetCertificates"] = []string{"workflow/ieee8021x/setcertificates-failure"}
+	w, client, _ := newTestWorkflow(responses)
+
+	_, err := w.ConfigureWiredIEEE8021x(eapTLSSettings())
+
+	var returnValueError *ReturnValueError
+
+	require.ErrorAs(t, err, &returnValueError)
+	assert.Equal(t, "IPS_IEEE8021xSettings.SetCertificates", returnValueError.Operation)
+	assert.NotContains(t, client.Requests, "AMT_8021XProfile/Get")
+}
+
+func TestConfigureWiredIEEE8021xError(t *testing.T) {
+	for _, failure := range []string{
+		"AMT_PublicKeyManagementService/AddKey",
+		"AMT_PublicKeyManagementService/AddCertificate",
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate",
+		"IPS_IEEE8021xSettings/Put",
+		"IPS_IEEE8021xSettings/SetCertificates",
+		"AMT_8021XProfile/Get",
+	} {
+		t.Run(failure, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(ieee8021xResponses())
+			client.Failures[failure] = errTransport
+
+			_, err := w.ConfigureWiredIEEE8021x(eapTLSSettings())
+			assert.ErrorIs(t, err, errTransport)
+		})
+	}
+}
+
+func TestDisableWiredIEEE8021x(t *testing.T) {
+	responses := ieee8021xResponses()
+	w, client, _ := newTestWorkflow(responses)
+
+	err := w.DisableWiredIEEE8021x()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AMT_8021XProfile/Get", "AMT_8021XProfile/Put", "IPS_IEEE8021xSettings/Get"}, client.Requests)
+
+	put := client.Messages[1]
+	assert.Contains(t, put, "<h:Enabled>false</h:Enabled>")
+	assert.Contains(t, put, "<h:Username>device</h:Username>")
+	assert.Contains(t, put, "<h:PxeTimeout>120</h:PxeTimeout>")
+
+	responses["IPS_IEEE8021xSettings/Get"] = []string{"workflow/ieee8021x/settings-get-enabled"}
+	w, _, _ = newTestWorkflow(responses)
+
+	err = w.DisableWiredIEEE8021x()
+	assert.ErrorIs(t, err, ErrIEEE8021xNotApplied)
+	assert.ErrorContains(t, err, "EnabledWithCertificates")
+
+	for _, failure := range []string{"AMT_8021XProfile/Get", "AMT_8021XProfile/Put", "IPS_IEEE8021xSettings/Get"} {
+		w, client, _ := newTestWorkflow(ieee8021xResponses())
+		client.Failures[failure] = errTransport
+
+		assert.ErrorIs(t, w.DisableWiredIEEE8021x(), errTransport, failure)
+	}
+}
@@ -0,0 +1,327 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+)
+
+// CredentialUsage describes a credential context that references a certificate.
+type CredentialUsage struct {
+	Context  string // The class of the credential context, such as AMT_TLSCredentialContext.
+	Provider string // The class of the element that uses the certificate, such as AMT_TLSProtocolEndpointCollection.
+}
+
+// CertificateNode is a certificate of the device with the key pair and credential contexts it is joined to.
+type CertificateNode struct {
+	InstanceID    string
+	Certificate   *x509.Certificate // The parsed X509Certificate. nil when the blob cannot be parsed.
+	TrustedRoot   bool              // The certificate was added with AddTrustedRootCertificate.
+	ReadOnly      bool              // The certificate is an Intel® AMT self-signed certificate, which cannot be deleted.
+	KeyPairHandle string            // The InstanceID of the key pair holding the private key of the certificate. Empty when the device does not hold it.
+	UsedBy        []CredentialUsage // The credential contexts that reference the certificate.
+}
+
+// InUse reports whether a credential context references the certificate.
+func (c CertificateNode) InUse() bool {
+	return len(c.UsedBy) > 0
+}
+
+// KeyPairNode is a key pair of the device with the certificates issued for it.
+type KeyPairNode struct {
+	InstanceID         string
+	CertificateHandles []string // The InstanceIDs of the certificates for the public key of the key pair.
+}
+
+// CredentialGraph joins the certificates and key pairs of the device with the credential contexts that use them.
+type CredentialGraph struct {
+	Certificates []CertificateNode
+	KeyPairs     []KeyPairNode
+}
+
+// CleanupOptions selects the unreferenced items that DeleteUnreferenced may delete, beyond the certificates with a key pair on the device.
+type CleanupOptions struct {
+	IncludeTrustedRoots               bool // Trusted root certificates are used for mutual TLS and CIRA without a credential context, so they are kept by default.
+	IncludeCertificatesWithoutKeyPair bool // Certificates without a key pair, other than trusted roots, such as client certificates whose key was added with AddKey and removed since.
+	IncludeKeyPairsWithoutCertificate bool // Key pairs without a certificate may belong to a certificate request in progress, so they are kept by default.
+}
+
+// CleanupResult holds the InstanceIDs of the items deleted by DeleteUnreferenced.
+type CleanupResult struct {
+	Certificates []string
+	KeyPairs     []string
+}
+
+// CredentialGraph reads the certificates, key pairs and credential contexts of the device, and joins them.
+// Certificates are joined to key pairs by public key, and to the AMT_TLSCredentialContext, AMT_8021xCredentialContext
+// and CIM_CredentialContext instances that reference them.
+func (w Workflow) CredentialGraph() (graph CredentialGraph, err error) {
+	usages, err := w.credentialUsages()
+	if err != nil {
+		return graph, err
+	}
+
+	keyPairsEnumerate, err := w.messages.AMT.PublicPrivateKeyPair.Enumerate()
+	if err != nil {
+		return graph, err
+	}
+
+	keyPairs, err := w.messages.AMT.PublicPrivateKeyPair.Pull(keyPairsEnumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return graph, err
+	}
+
+	certificatesEnumerate, err := w.messages.AMT.PublicKeyCertificate.Enumerate()
+	if err != nil {
+		return graph, err
+	}
+
+	certificates, err := w.messages.AMT.PublicKeyCertificate.Pull(certificatesEnumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return graph, err
+	}
+
+	for _, item := range keyPairs.Body.PullResponse.PublicPrivateKeyPairItems {
+		graph.KeyPairs = append(graph.KeyPairs, KeyPairNode{InstanceID: item.InstanceID})
+	}
+
+	for _, item := range certificates.Body.PullResponse.PublicKeyCertificateItems {
+		node := CertificateNode{
+			InstanceID:  item.InstanceID,
+			TrustedRoot: item.TrustedRootCertificate,
+			ReadOnly:    item.ReadOnlyCertificate,
+			UsedBy:      usages[item.InstanceID],
+		}
+
+		der, err := base64.StdEncoding.DecodeString(item.X509Certificate)
+		if err == nil {
+			node.Certificate, _ = x509.ParseCertificate(der)
+		}
+
+		if node.Certificate != nil {
+			for i, keyPair := range keyPairs.Body.PullResponse.PublicPrivateKeyPairItems {
+				publicKey, err := publickey.KeyPairPublicKey(keyPair)
+				if err == nil && publicKey.Equal(node.Certificate.PublicKey) {
+					node.KeyPairHandle = keyPair.InstanceID
+					graph.KeyPairs[i].CertificateHandles = append(graph.KeyPairs[i].CertificateHandles, node.InstanceID)
+
+					break
+				}
+			}
+		}
+
+		graph.Certificates = append(graph.Certificates, node)
+	}
+
+	return graph, nil
+}
+
+// credentialUsages returns the credential contexts of the device, by InstanceID of the certificate they reference.
+func (w Workflow) credentialUsages() (map[string][]CredentialUsage, error) {
+	usages := map[string][]CredentialUsage{}
+	add := func(certificate string, usage CredentialUsage) {
+		for _, existing := range usages[certificate] {
+			if existing == usage {
+				return
+			}
+		}
+
+		usages[certificate] = append(usages[certificate], usage)
+	}
+
+	tlsEnumerate, err := w.messages.AMT.TLSCredentialContext.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsPull, err := w.messages.AMT.TLSCredentialContext.Pull(tlsEnumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCertificates, err := credentialContextCertificates(tlsPull.XMLOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, certificate := range tlsCertificates {
+		add(certificate, CredentialUsage{Context: "AMT_TLSCredentialContext", Provider: "AMT_TLSProtocolEndpointCollection"})
+	}
+
+	ieee8021xEnumerate, err := w.messages.AMT.IEEE8021xCredentialContext.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+
+	ieee8021xPull, err := w.messages.AMT.IEEE8021xCredentialContext.Pull(ieee8021xEnumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range ieee8021xPull.Body.PullResponse.CredentialContextItems {
+		for _, selector := range item.ElementInContext.ReferenceParameters.SelectorSet.Selectors {
+			if selector.Name == "InstanceID" {
+				add(selector.Text, CredentialUsage{Context: "AMT_8021xCredentialContext", Provider: resourceClass(item.ElementProvidingContext.ReferenceParameters.ResourceURI)})
+			}
+		}
+	}
+
+	cimEnumerate, err := w.messages.CIM.CredentialContext.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+
+	cimPull, err := w.messages.CIM.CredentialContext.Pull(cimEnumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	items := cimPull.Body.PullResponse.Items
+	for _, contexts := range []struct {
+		class string
+		items []credential.CredentialContext
+	}{
+		{"CIM_CredentialContext", items.CredentialContext},
+		{"AMT_TLSCredentialContext", items.CredentialContextTLS},
+		{"IPS_8021xCredentialContext", items.CredentialContext8021x},
+	} {
+		for _, item := range contexts.items {
+			add(referenceInstanceID(item.ElementInContext), CredentialUsage{Context: contexts.class, Provider: resourceClass(item.ElementProvidingContext.ReferenceParameters.ResourceURI)})
+		}
+	}
+
+	return usages, nil
+}
+
+// Expiring returns the certificates that expire before now+within, including the certificates that have already expired.
+func (g CredentialGraph) Expiring(now time.Time, within time.Duration) []CertificateNode {
+	var expiring []CertificateNode
+
+	for _, certificate := range g.Certificates {
+		if certificate.Certificate != nil && certificate.Certificate.NotAfter.Before(now.Add(within)) {
+			expiring = append(expiring, certificate)
+		}
+	}
+
+	return expiring
+}
+
+// Unreferenced returns the InstanceIDs of the certificates and key pairs that no credential context uses, in the order they can be deleted:
+// a key pair is unreferenced only when all its certificates are, and is deleted after them.
+// Read-only certificates are never returned.
+func (g CredentialGraph) Unreferenced(options CleanupOptions) (certificates, keyPairs []string) {
+	unreferenced := map[string]bool{}
+
+	for _, certificate := range g.Certificates {
+		switch {
+		case certificate.ReadOnly || certificate.InUse():
+			continue
+		case certificate.TrustedRoot && !options.IncludeTrustedRoots:
+			continue
+		case !certificate.TrustedRoot && certificate.KeyPairHandle == "" && !options.IncludeCertificatesWithoutKeyPair:
+			continue
+		}
+
+		unreferenced[certificate.InstanceID] = true
+		certificates = append(certificates, certificate.InstanceID)
+	}
+
+	for _, keyPair := range g.KeyPairs {
+		if len(keyPair.CertificateHandles) == 0 && !options.IncludeKeyPairsWithoutCertificate {
+			continue
+		}
+
+		if allUnreferenced(keyPair.CertificateHandles, unreferenced) {
+			keyPairs = append(keyPairs, keyPair.InstanceID)
+		}
+	}
+
+	return certificates, keyPairs
+}
+
+// DeleteUnreferenced deletes the certificates and key pairs that no credential context uses, certificates first.
+// A key pair is kept when one of its certificates could not be deleted. The errors of the failed deletes are returned together.
+func (w Workflow) DeleteUnreferenced(options CleanupOptions) (result CleanupResult, err error) {
+	graph, err := w.CredentialGraph()
+	if err != nil {
+		return result, err
+	}
+
+	certificates, keyPairs := graph.Unreferenced(options)
+	deleted := map[string]bool{}
+
+	var errs []error
+
+	for _, certificate := range certificates {
+		_, err := w.messages.AMT.PublicKeyCertificate.Delete(certificate)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deleting %s: %w", certificate, err))
+
+			continue
+		}
+
+		deleted[certificate] = true
+		result.Certificates = append(result.Certificates, certificate)
+	}
+
+	for _, keyPair := range graph.KeyPairs {
+		if !contains(keyPairs, keyPair.InstanceID) || !allUnreferenced(keyPair.CertificateHandles, deleted) {
+			continue
+		}
+
+		_, err := w.messages.AMT.PublicPrivateKeyPair.Delete(keyPair.InstanceID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deleting %s: %w", keyPair.InstanceID, err))
+
+			continue
+		}
+
+		result.KeyPairs = append(result.KeyPairs, keyPair.InstanceID)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func allUnreferenced(handles []string, unreferenced map[string]bool) bool {
+	for _, handle := range handles {
+		if !unreferenced[handle] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// referenceInstanceID returns the InstanceID selector of an association reference.
+func referenceInstanceID(reference models.AssociationReference) string {
+	return reference.ReferenceParameters.GetSelectorValue("InstanceID")
+}
+
+// resourceClass returns the class name at the end of a resource URI.
+func resourceClass(resourceURI string) string {
+	resourceURI = strings.TrimSpace(resourceURI)
+
+	return resourceURI[strings.LastIndex(resourceURI, "/")+1:]
+}
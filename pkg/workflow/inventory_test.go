@@ -0,0 +1,185 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inventoryResponses returns the responses of a device with:
+//   - Handle 0: trusted root Root A, used by the 802.1X profile;
+//   - Handle 1: trusted root Root B, unused;
+//   - Handle 2: TLS server certificate for key 0, expiring on 2025-06-01;
+//   - Handle 3: previous TLS server certificate for key 1, expired on 2025-01-01 and unused;
+//   - Handle 4: 802.1X client certificate for key 2;
+//   - Handle 5: read-only certificate;
+//   - Handle 6: unused certificate without key pair;
+//   - key 3 without certificate.
+func inventoryResponses() map[string][]string {
+	return map[string][]string{
+		"AMT_TLSCredentialContext/Enumerate":   {"amt/tls/credentialcontext/enumerate"},
+		"AMT_TLSCredentialContext/Pull":        {"workflow/inventory/tls-credentialcontext-pull"},
+		"AMT_8021xCredentialContext/Enumerate": {"amt/ieee8021x/credentialcontext/enumerate"},
+		"AMT_8021xCredentialContext/Pull":      {"workflow/inventory/ieee8021x-credentialcontext-pull"},
+		"CIM_CredentialContext/Enumerate":      {"cim/credential/context/enumerate"},
+		"CIM_CredentialContext/Pull":           {"workflow/inventory/credentialcontext-pull"},
+		"AMT_PublicPrivateKeyPair/Enumerate":   {"amt/publicprivate/enumerate"},
+		"AMT_PublicPrivateKeyPair/Pull":        {"workflow/inventory/publicprivate-pull"},
+		"AMT_PublicPrivateKeyPair/Delete":      {"amt/publicprivate/delete"},
+		"AMT_PublicKeyCertificate/Enumerate":   {"amt/publickey/certificate/enumerate"},
+		"AMT_PublicKeyCertificate/Pull":        {"workflow/inventory/certificate-pull"},
+		"AMT_PublicKeyCertificate/Delete":      {"amt/publickey/certificate/delete"},
+	}
+}
+
+func TestCredentialGraph(t *testing.T) {
+	w, _, _ := newTestWorkflow(inventoryResponses())
+
+	graph, err := w.CredentialGraph()
+	require.NoError(t, err)
+	require.Len(t, graph.Certificates, 7)
+
+	tlsUsage := CredentialUsage{Context: "AMT_TLSCredentialContext", Provider: "AMT_TLSProtocolEndpointCollection"}
+	profileUsage := CredentialUsage{Context: "AMT_8021xCredentialContext", Provider: "AMT_8021XProfile"}
+
+	tests := []struct {
+		name          string
+		trustedRoot   bool
+		readOnly      bool
+		keyPairHandle string
+		usedBy        []CredentialUsage
+	}{
+		{"Root A", true, false, "", []CredentialUsage{profileUsage, {Context: "CIM_CredentialContext", Provider: "CIM_IEEE8021xSettings"}}},
+		{"Root B", true, false, "", nil},
+		{"amt.example.com", false, false, "Intel(r) AMT Key: Handle: 0", []CredentialUsage{tlsUsage}},
+		{"amt.example.com", false, false, "Intel(r) AMT Key: Handle: 1", nil},
+		{"amt-8021x", false, false, "Intel(r) AMT Key: Handle: 2", []CredentialUsage{profileUsage}},
+		{"Intel(r) AMT", false, true, "", nil},
+		{"orphan", false, false, "", nil},
+	}
+
+	for i, test := range tests {
+		certificate := graph.Certificates[i]
+		assert.Equal(t, test.name, certificate.Certificate.Subject.CommonName)
+		assert.Equal(t, test.trustedRoot, certificate.TrustedRoot, test.name)
+		assert.Equal(t, test.readOnly, certificate.ReadOnly, test.name)
+		assert.Equal(t, test.keyPairHandle, certificate.KeyPairHandle, test.name)
+		assert.Equal(t, test.usedBy, certificate.UsedBy, test.name)
+		assert.Equal(t, test.usedBy != nil, certificate.InUse(), test.name)
+	}
+
+	assert.Equal(t, []KeyPairNode{
+		{InstanceID: "Intel(r) AMT Key: Handle: 0", CertificateHandles: []string{"Intel(r) AMT Certificate: Handle: 2"}},
+		{InstanceID: "Intel(r) AMT Key: Handle: 1", CertificateHandles: []string{"Intel(r) AMT Certificate: Handle: 3"}},
+		{InstanceID: "Intel(r) AMT Key: Handle: 2", CertificateHandles: []string{"Intel(r) AMT Certificate: Handle: 4"}},
+		{InstanceID: "Intel(r) AMT Key: Handle: 3"},
+	}, graph.KeyPairs)
+}
+
+func TestCredentialGraphExpiring(t *testing.T) {
+	w, _, _ := newTestWorkflow(inventoryResponses())
+
+	graph, err := w.CredentialGraph()
+	require.NoError(t, err)
+
+	now := time.Date(2025, 5, 15, 0, 0, 0, 0, time.UTC)
+
+	var expiring []string
+	for _, certificate := range graph.Expiring(now, 30*24*time.Hour) {
+		expiring = append(expiring, certificate.InstanceID)
+	}
+
+	assert.Equal(t, []string{"Intel(r) AMT Certificate: Handle: 2", "Intel(r) AMT Certificate: Handle: 3"}, expiring)
+	assert.Len(t, graph.Expiring(now, 0), 1)
+}
+
+func TestCredentialGraphUnreferenced(t *testing.T) {
+	w, _, _ := newTestWorkflow(inventoryResponses())
+
+	graph, err := w.CredentialGraph()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                 string
+		options              CleanupOptions
+		expectedCertificates []string
+		expectedKeyPairs     []string
+	}{
+		{
+			"default",
+			CleanupOptions{},
+			[]string{"Intel(r) AMT Certificate: Handle: 3"},
+			[]string{"Intel(r) AMT Key: Handle: 1"},
+		},
+		{
+			"everything",
+			CleanupOptions{IncludeTrustedRoots: true, IncludeCertificatesWithoutKeyPair: true, IncludeKeyPairsWithoutCertificate: true},
+			[]string{"Intel(r) AMT Certificate: Handle: 1", "Intel(r) AMT Certificate: Handle: 3", "Intel(r) AMT Certificate: Handle: 6"},
+			[]string{"Intel(r) AMT Key: Handle: 1", "Intel(r) AMT Key: Handle: 3"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			certificates, keyPairs := graph.Unreferenced(test.options)
+			assert.Equal(t, test.expectedCertificates, certificates)
+			assert.Equal(t, test.expectedKeyPairs, keyPairs)
+		})
+	}
+}
+
+func TestDeleteUnreferenced(t *testing.T) {
+	w, client, _ := newTestWorkflow(inventoryResponses())
+
+	result, err := w.DeleteUnreferenced(CleanupOptions{IncludeKeyPairsWithoutCertificate: true})
+	require.NoError(t, err)
+	assert.Equal(t, CleanupResult{
+		Certificates: []string{"Intel(r) AMT Certificate: Handle: 3"},
+		KeyPairs:     []string{"Intel(r) AMT Key: Handle: 1", "Intel(r) AMT Key: Handle: 3"},
+	}, result)
+
+	deletes := client.Messages[len(client.Messages)-3:]
+	assert.Contains(t, deletes[0], "AMT_PublicKeyCertificate</w:ResourceURI>")
+	assert.Contains(t, deletes[0], "Intel(r) AMT Certificate: Handle: 3")
+	assert.Contains(t, deletes[1], "Intel(r) AMT Key: Handle: 1")
+	assert.Contains(t, deletes[2], "Intel(r) AMT Key: Handle: 3")
+}
+
+func TestDeleteUnreferencedFailure(t *testing.T) {
+	w, client, _ := newTestWorkflow(inventoryResponses())
+	client.Failures["AMT_PublicKeyCertificate/Delete"] = errTransport
+
+	result, err := w.DeleteUnreferenced(CleanupOptions{})
+	assert.ErrorIs(t, err, errTransport)
+	assert.EqualError(t, err, "deleting Intel(r) AMT Certificate: Handle: 3: connection reset")
+	assert.Equal(t, CleanupResult{}, result)
+	assert.NotContains(t, client.Requests, "AMT_PublicPrivateKeyPair/Delete")
+}
+
+func TestCredentialGraphError(t *testing.T) {
+	for _, failure := range []string{
+		"AMT_TLSCredentialContext/Pull",
+		"AMT_8021xCredentialContext/Pull",
+		"CIM_CredentialContext/Pull",
+		"AMT_PublicPrivateKeyPair/Pull",
+		"AMT_PublicKeyCertificate/Pull",
+	} {
+		t.Run(failure, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(inventoryResponses())
+			client.Failures[failure] = errTransport
+
+			_, err := w.CredentialGraph()
+			assert.ErrorIs(t, err, errTransport)
+
+			_, err = w.DeleteUnreferenced(CleanupOptions{})
+			assert.ErrorIs(t, err, errTransport)
+		})
+	}
+}
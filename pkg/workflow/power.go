@@ -0,0 +1,291 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
+	ipspower "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
+)
+
+// powerCyclePollInterval is the longest time between two checks for the off phase of a power cycle, which lasts only a few seconds.
+const powerCyclePollInterval = time.Second
+
+var (
+	// ErrPowerStateUnavailable is returned when the device reports no CIM_AssociatedPowerManagementService.
+	ErrPowerStateUnavailable = errors.New("power: the device does not report its power state")
+	// ErrPowerActionNotAvailable is returned for a power action the device does not offer in its current power state.
+	ErrPowerActionNotAvailable = errors.New("power: the action is not available in the current power state")
+)
+
+// PowerStatus is the power state of the device and the power actions available in it.
+type PowerStatus struct {
+	State     service.PowerState
+	Available []service.AvailableRequestedPowerStates
+}
+
+// Allows reports whether action is one of the available power actions.
+func (s PowerStatus) Allows(action power.PowerState) bool {
+	for _, available := range s.Available {
+		if int(available) == int(action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PowerResult describes a power workflow.
+type PowerResult struct {
+	Actions     []power.PowerState   // The power actions requested, in order.
+	Transitions []service.PowerState // The power states observed, in order, starting with the state before the first action. Consecutive duplicates are left out.
+}
+
+// observe appends state to the transitions when it differs from the last one.
+func (r *PowerResult) observe(state service.PowerState) {
+	if len(r.Transitions) == 0 || r.Transitions[len(r.Transitions)-1] != state {
+		r.Transitions = append(r.Transitions, state)
+	}
+}
+
+// PowerStatus reads the power state of the device and the power actions available in it from CIM_AssociatedPowerManagementService.
+func (w Workflow) PowerStatus() (status PowerStatus, err error) {
+	enumerate, err := w.messages.CIM.ServiceAvailableToElement.Enumerate()
+	if err != nil {
+		return status, err
+	}
+
+	pull, err := w.messages.CIM.ServiceAvailableToElement.Pull(enumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return status, err
+	}
+
+	items := pull.Body.PullResponse.AssociatedPowerManagementService
+	if len(items) == 0 {
+		return status, ErrPowerStateUnavailable
+	}
+
+	return PowerStatus{State: items[0].PowerState, Available: items[0].AvailableRequestedPowerStates}, nil
+}
+
+// RequestPowerAction requests action from CIM_PowerManagementService after checking that the device offers it in its current power state.
+// It returns without waiting for the device.
+func (w Workflow) RequestPowerAction(action power.PowerState) error {
+	status, err := w.PowerStatus()
+	if err != nil {
+		return err
+	}
+
+	return w.requestPowerAction(status, action)
+}
+
+func (w Workflow) requestPowerAction(status PowerStatus, action power.PowerState) error {
+	if !status.Allows(action) {
+		return fmt.Errorf("%w: %d in %s", ErrPowerActionNotAvailable, action, status.State)
+	}
+
+	response, err := w.messages.CIM.PowerManagementService.RequestPowerStateChange(action)
+
+	return methodError("CIM_PowerManagementService.RequestPowerStateChange", int(response.Body.RequestPowerStateChangeResponse.ReturnValue), err)
+}
+
+// waitForPowerState polls the power state of the device until done reports true for it, recording the states observed in result.
+// Transport errors, which are common while the device changes its power state, are retried until Timeout.
+func (w Workflow) waitForPowerState(result *PowerResult, done func(service.PowerState) bool) error {
+	var transportErr error
+
+	err := w.poll(func() (bool, error) {
+		status, err := w.PowerStatus()
+		if isTransportError(err) {
+			transportErr = err
+
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		transportErr = nil
+		result.observe(status.State)
+
+		return done(status.State), nil
+	})
+	if errors.Is(err, ErrTimeout) && transportErr != nil {
+		return fmt.Errorf("%w: %w", err, transportErr)
+	}
+
+	return err
+}
+
+// isTransportError reports whether err is a network error or a connection closed by the device.
+func isTransportError(err error) bool {
+	var netErr net.Error
+
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isPoweredOn(state service.PowerState) bool {
+	return state == service.PowerStateOn
+}
+
+func isPoweredOff(state service.PowerState) bool {
+	return state == service.PowerStateOffSoft || state == service.PowerStateOffHard
+}
+
+// PowerOn powers the device on and waits until it reports S0.
+//
+// A device that is already on but whose operating system is in a power saving state is brought back to full power
+// through IPS_PowerManagementService instead.
+func (w Workflow) PowerOn() (result PowerResult, err error) {
+	status, err := w.PowerStatus()
+	if err != nil {
+		return result, err
+	}
+
+	result.observe(status.State)
+
+	if isPoweredOn(status.State) {
+		return result, w.wakeOperatingSystem()
+	}
+
+	if err := w.requestPowerAction(status, power.PowerOn); err != nil {
+		return result, err
+	}
+
+	result.Actions = append(result.Actions, power.PowerOn)
+
+	return result, w.waitForPowerState(&result, isPoweredOn)
+}
+
+// wakeOperatingSystem requests full power from the operating system when it is in a power saving state, and waits until it reports it.
+func (w Workflow) wakeOperatingSystem() error {
+	current, err := w.messages.IPS.PowerManagementService.Get()
+	if err != nil {
+		return err
+	}
+
+	if current.Body.GetResponse.OSPowerSavingState != ipspower.OSPowerSaving {
+		return nil
+	}
+
+	response, err := w.messages.IPS.PowerManagementService.RequestOSPowerSavingStateChange(ipspower.FullPower)
+
+	err = methodError("IPS_PowerManagementService.RequestOSPowerSavingStateChange", int(response.Body.RequestOSPowerSavingStateChangeResponse.ReturnValue), err)
+	if err != nil {
+		return err
+	}
+
+	return w.poll(func() (bool, error) {
+		current, err := w.messages.IPS.PowerManagementService.Get()
+		if err != nil {
+			return false, err
+		}
+
+		return current.Body.GetResponse.OSPowerSavingState == ipspower.FullPower, nil
+	})
+}
+
+// GracefulShutdown asks the operating system to shut down and waits until the device is off.
+// When the device is still on after fallbackAfter, or the graceful shutdown is not available, it is powered off hard
+// and the workflow waits up to Timeout for it. A device that is already off, or is off by the time of the fallback, is left as is.
+func (w Workflow) GracefulShutdown(fallbackAfter time.Duration) (result PowerResult, err error) {
+	status, err := w.PowerStatus()
+	if err != nil {
+		return result, err
+	}
+
+	result.observe(status.State)
+
+	if isPoweredOff(status.State) {
+		return result, nil
+	}
+
+	if status.Allows(power.PowerOffSoftGraceful) {
+		if err := w.requestPowerAction(status, power.PowerOffSoftGraceful); err != nil {
+			return result, err
+		}
+
+		result.Actions = append(result.Actions, power.PowerOffSoftGraceful)
+
+		graceful := w
+		graceful.Timeout = fallbackAfter
+
+		err := graceful.waitForPowerState(&result, isPoweredOff)
+		if !errors.Is(err, ErrTimeout) {
+			return result, err
+		}
+
+		status, err = w.PowerStatus()
+		if err != nil {
+			return result, err
+		}
+
+		result.observe(status.State)
+
+		if isPoweredOff(status.State) {
+			return result, nil
+		}
+	}
+
+	if err := w.requestPowerAction(status, power.PowerOffHard); err != nil {
+		return result, err
+	}
+
+	result.Actions = append(result.Actions, power.PowerOffHard)
+
+	return result, w.waitForPowerState(&result, isPoweredOff)
+}
+
+// PowerCycle powers the device off and back on, waits until it reports a state other than S0, and then until it reports S0 again.
+// The off phase lasts only a few seconds, so it is checked for at least every second. The device may drop the connection instead
+// of reporting the off phase, so a transport error also counts as the off phase. ErrTimeout is returned when the device
+// does not leave S0 within Timeout.
+func (w Workflow) PowerCycle() (result PowerResult, err error) {
+	status, err := w.PowerStatus()
+	if err != nil {
+		return result, err
+	}
+
+	result.observe(status.State)
+
+	if err := w.requestPowerAction(status, power.PowerCycleOffHard); err != nil {
+		return result, err
+	}
+
+	result.Actions = append(result.Actions, power.PowerCycleOffHard)
+
+	cycling := w
+	if cycling.PollInterval <= 0 || cycling.PollInterval > powerCyclePollInterval {
+		cycling.PollInterval = powerCyclePollInterval
+	}
+
+	err = cycling.poll(func() (bool, error) {
+		status, err := w.PowerStatus()
+		if isTransportError(err) {
+			return true, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		result.observe(status.State)
+
+		return !isPoweredOn(status.State), nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	return result, w.waitForPowerState(&result, isPoweredOn)
+}
@@ -0,0 +1,265 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"net"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/service"
+)
+
+const (
+	powerOn  = "cim/service/availabletoelement/pull"
+	powerOff = "workflow/power/availabletoelement-pull-off"
+	cycling  = "workflow/power/availabletoelement-pull-cycling"
+)
+
+var errConnectionReset = &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+
+func powerResponses(states ...string) map[string][]string {
+	return map[string][]string{
+		"CIM_ServiceAvailableToElement/Enumerate":                    {"cim/service/availabletoelement/enumerate"},
+		"CIM_ServiceAvailableToElement/Pull":                         states,
+		"CIM_PowerManagementService/RequestPowerStateChange":         {"cim/power/managementservice/requestpowerstatechange"},
+		"IPS_PowerManagementService/Get":                             {"ips/power/managementservice/get"},
+		"IPS_PowerManagementService/RequestOSPowerSavingStateChange": {"ips/power/managementservice/requestospowersavingstatechange"},
+	}
+}
+
+func TestPowerStatus(t *testing.T) {
+	w, _, _ := newTestWorkflow(powerResponses(powerOn))
+
+	status, err := w.PowerStatus()
+	require.NoError(t, err)
+	assert.Equal(t, service.PowerStateOn, status.State)
+	assert.True(t, status.Allows(power.PowerOffSoftGraceful))
+	assert.False(t, status.Allows(power.PowerOn))
+
+	w, client, _ := newTestWorkflow(powerResponses(powerOn))
+	client.Failures["CIM_ServiceAvailableToElement/Pull"] = errTransport
+
+	_, err = w.PowerStatus()
+	assert.ErrorIs(t, err, errTransport)
+}
+
+func TestRequestPowerAction(t *testing.T) {
+	w, client, _ := newTestWorkflow(powerResponses(powerOn))
+
+	require.NoError(t, w.RequestPowerAction(power.MasterBusReset))
+	assert.Contains(t, client.Messages[2], "<h:PowerState>10</h:PowerState>")
+
+	w, client, _ = newTestWorkflow(powerResponses(powerOn))
+
+	err := w.RequestPowerAction(power.PowerOn)
+	assert.ErrorIs(t, err, ErrPowerActionNotAvailable)
+	assert.NotContains(t, client.Requests, "CIM_PowerManagementService/RequestPowerStateChange")
+}
+
+func TestPowerOn(t *testing.T) {
+	w, client, slept := newTestWorkflow(powerResponses(powerOff, powerOff, powerOn))
+
+	result, err := w.PowerOn()
+	require.NoError(t, err)
+	assert.Equal(t, []power.PowerState{power.PowerOn}, result.Actions)
+	assert.Equal(t, []service.PowerState{service.PowerStateOffSoft, service.PowerStateOn}, result.Transitions)
+	assert.Contains(t, client.Messages[2], "<h:PowerState>2</h:PowerState>")
+	assert.Equal(t, DefaultPollInterval, *slept)
+
+	w, client, _ = newTestWorkflow(powerResponses(powerOn))
+
+	result, err = w.PowerOn()
+	require.NoError(t, err)
+	assert.Empty(t, result.Actions)
+	assert.NotContains(t, client.Requests, "CIM_PowerManagementService/RequestPowerStateChange")
+	assert.NotContains(t, client.Requests, "IPS_PowerManagementService/RequestOSPowerSavingStateChange")
+}
+
+func TestPowerOnWakesOperatingSystem(t *testing.T) {
+	responses := powerResponses(powerOn)
+	responses["IPS_PowerManagementService/Get"] = []string{"workflow/power/managementservice-get-powersaving", "ips/power/managementservice/get"}
+	w, client, _ := newTestWorkflow(responses)
+
+	_, err := w.PowerOn()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"CIM_ServiceAvailableToElement/Enumerate",
+		"CIM_ServiceAvailableToElement/Pull",
+		"IPS_PowerManagementService/Get",
+		"IPS_PowerManagementService/RequestOSPowerSavingStateChange",
+		"IPS_PowerManagementService/Get",
+	}, client.Requests)
+	assert.Contains(t, client.Messages[3], "<h:OSPowerSavingState>2</h:OSPowerSavingState>")
+}
+
+func TestPowerOnTimeout(t *testing.T) {
+	w, _, slept := newTestWorkflow(powerResponses(powerOff))
+
+	result, err := w.PowerOn()
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, []service.PowerState{service.PowerStateOffSoft}, result.Transitions)
+	assert.Equal(t, DefaultTimeout, *slept)
+}
+
+func TestGracefulShutdown(t *testing.T) {
+	w, client, _ := newTestWorkflow(powerResponses(powerOn, powerOn, powerOff))
+
+	result, err := w.GracefulShutdown(time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []power.PowerState{power.PowerOffSoftGraceful}, result.Actions)
+	assert.Equal(t, []service.PowerState{service.PowerStateOn, service.PowerStateOffSoft}, result.Transitions)
+	assert.Contains(t, client.Messages[2], "<h:PowerState>12</h:PowerState>")
+
+	w, client, _ = newTestWorkflow(powerResponses(powerOff))
+
+	result, err = w.GracefulShutdown(time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, result.Actions)
+	assert.NotContains(t, client.Requests, "CIM_PowerManagementService/RequestPowerStateChange")
+}
+
+func TestGracefulShutdownFallback(t *testing.T) {
+	// The operating system ignores the graceful shutdown for a minute: 1 state before the action, 13 polls, 1 state before the fallback.
+	states := make([]string, 15, 16)
+	for i := range states {
+		states[i] = powerOn
+	}
+
+	w, client, slept := newTestWorkflow(powerResponses(append(states, powerOff)...))
+
+	result, err := w.GracefulShutdown(time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []power.PowerState{power.PowerOffSoftGraceful, power.PowerOffHard}, result.Actions)
+	assert.Equal(t, []service.PowerState{service.PowerStateOn, service.PowerStateOffSoft}, result.Transitions)
+	assert.Equal(t, time.Minute, *slept)
+
+	hardOff := client.Messages[len(client.Messages)-3]
+	assert.Contains(t, hardOff, "<h:PowerState>8</h:PowerState>")
+
+	// The device turns off on its own after the graceful shutdown times out.
+	states = append(states[:len(states)-1], powerOff)
+	w, client, _ = newTestWorkflow(powerResponses(states...))
+
+	result, err = w.GracefulShutdown(time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []power.PowerState{power.PowerOffSoftGraceful}, result.Actions)
+	assert.Equal(t, []service.PowerState{service.PowerStateOn, service.PowerStateOffSoft}, result.Transitions)
+	assert.NotContains(t, strings.Join(client.Messages, ""), "<h:PowerState>8</h:PowerState>")
+
+	responses := powerResponses("workflow/power/availabletoelement-pull-on-nograceful", powerOff)
+	w, _, slept = newTestWorkflow(responses)
+
+	result, err = w.GracefulShutdown(time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []power.PowerState{power.PowerOffHard}, result.Actions)
+	assert.Zero(t, *slept)
+}
+
+func TestPowerCycle(t *testing.T) {
+	// The device still reports S0 right after the request, and the off phase is seen by the next check.
+	w, client, slept := newTestWorkflow(powerResponses(powerOn, powerOn, cycling, powerOn))
+
+	result, err := w.PowerCycle()
+	require.NoError(t, err)
+	assert.Equal(t, []power.PowerState{power.PowerCycleOffHard}, result.Actions)
+	assert.Equal(t, []service.PowerState{service.PowerStateOn, service.PowerStateOffHard, service.PowerStateOn}, result.Transitions)
+	assert.Contains(t, client.Messages[2], "<h:PowerState>5</h:PowerState>")
+	assert.Equal(t, powerCyclePollInterval, *slept)
+
+	w, _, _ = newTestWorkflow(powerResponses(powerOff))
+
+	_, err = w.PowerCycle()
+	assert.ErrorIs(t, err, ErrPowerActionNotAvailable)
+}
+
+func TestPowerCycleConnectionDropped(t *testing.T) {
+	// The device reports S0 until it drops the connection for the off phase, and reports S0 again once the connection is back.
+	w, client, slept := newTestWorkflow(powerResponses(powerOn, powerOn, powerOn))
+
+	sleeps := 0
+	sleep := w.sleep
+	w.sleep = func(d time.Duration) {
+		sleep(d)
+
+		sleeps++
+		if sleeps == 1 {
+			client.Failures["CIM_ServiceAvailableToElement/Enumerate"] = errConnectionReset
+		} else {
+			delete(client.Failures, "CIM_ServiceAvailableToElement/Enumerate")
+		}
+	}
+
+	result, err := w.PowerCycle()
+	require.NoError(t, err)
+	assert.Equal(t, []power.PowerState{power.PowerCycleOffHard}, result.Actions)
+	assert.Equal(t, []service.PowerState{service.PowerStateOn}, result.Transitions)
+	assert.Equal(t, powerCyclePollInterval+DefaultPollInterval, *slept)
+}
+
+func TestPowerCycleNotObserved(t *testing.T) {
+	w, _, slept := newTestWorkflow(powerResponses(powerOn))
+
+	result, err := w.PowerCycle()
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, []service.PowerState{service.PowerStateOn}, result.Transitions)
+	assert.Equal(t, DefaultTimeout, *slept)
+}
+
+func TestWaitForPowerStateTransportError(t *testing.T) {
+	w, client, slept := newTestWorkflow(powerResponses(cycling, powerOn))
+	client.Failures["CIM_ServiceAvailableToElement/Enumerate"] = errConnectionReset
+
+	// The device drops the connection until the first retry.
+	sleep := w.sleep
+	w.sleep = func(d time.Duration) {
+		sleep(d)
+		delete(client.Failures, "CIM_ServiceAvailableToElement/Enumerate")
+	}
+
+	var result PowerResult
+
+	err := w.waitForPowerState(&result, isPoweredOn)
+	require.NoError(t, err)
+	assert.Equal(t, []service.PowerState{service.PowerStateOffHard, service.PowerStateOn}, result.Transitions)
+	assert.Equal(t, 2*DefaultPollInterval, *slept)
+
+	w, client, slept = newTestWorkflow(powerResponses(cycling))
+	client.Failures["CIM_ServiceAvailableToElement/Enumerate"] = errConnectionReset
+
+	err = w.waitForPowerState(&result, isPoweredOn)
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.ErrorIs(t, err, syscall.ECONNRESET)
+	assert.Equal(t, DefaultTimeout, *slept)
+}
+
+func TestPowerError(t *testing.T) {
+	for _, failure := range []string{
+		"CIM_ServiceAvailableToElement/Enumerate",
+		"CIM_ServiceAvailableToElement/Pull",
+		"CIM_PowerManagementService/RequestPowerStateChange",
+	} {
+		t.Run(failure, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(powerResponses(powerOff, powerOn))
+			client.Failures[failure] = errTransport
+
+			_, err := w.PowerOn()
+			assert.ErrorIs(t, err, errTransport)
+
+			_, err = w.GracefulShutdown(time.Minute)
+			assert.ErrorIs(t, err, errTransport)
+
+			_, err = w.PowerCycle()
+			assert.ErrorIs(t, err, errTransport)
+		})
+	}
+}
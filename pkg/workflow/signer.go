@@ -0,0 +1,118 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// DefaultCertificateValidity is the validity of the certificates issued by a LocalCA created without an explicit validity.
+const DefaultCertificateValidity = 365 * 24 * time.Hour
+
+// ErrNotCA is returned by NewLocalCAFromCertificate when the certificate cannot sign other certificates.
+var ErrNotCA = errors.New("certificate is not a certificate authority")
+
+// Signer issues the certificate for a certificate request signed by the device.
+type Signer interface {
+	Sign(request *x509.CertificateRequest) (*x509.Certificate, error)
+}
+
+// LocalCA is a Signer that issues TLS server certificates in process, with a certificate authority whose key is held in memory.
+type LocalCA struct {
+	Certificate *x509.Certificate // The certificate of the certificate authority. Clients of the device must trust it.
+	Validity    time.Duration     // The validity of the issued certificates.
+	key         crypto.Signer
+}
+
+// NewLocalCA returns a LocalCA with a new self-signed certificate authority named commonName, valid for validity.
+// The certificates it issues are valid for DefaultCertificateValidity.
+func NewLocalCA(commonName string, validity time.Duration) (*LocalCA, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-5 * time.Minute),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalCA{Certificate: certificate, Validity: DefaultCertificateValidity, key: key}, nil
+}
+
+// NewLocalCAFromCertificate returns a LocalCA that issues certificates with an existing certificate authority and its key.
+func NewLocalCAFromCertificate(certificate *x509.Certificate, key crypto.Signer) (*LocalCA, error) {
+	if !certificate.IsCA {
+		return nil, ErrNotCA
+	}
+
+	return &LocalCA{Certificate: certificate, Validity: DefaultCertificateValidity, key: key}, nil
+}
+
+// Sign issues a TLS server certificate for the subject, names and public key of request, after checking the signature of request.
+func (ca *LocalCA) Sign(request *x509.CertificateRequest) (*x509.Certificate, error) {
+	if err := request.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("certificate request: %w", err)
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      request.Subject,
+		DNSNames:     request.DNSNames,
+		IPAddresses:  request.IPAddresses,
+		NotBefore:    now.Add(-5 * time.Minute),
+		NotAfter:     now.Add(ca.Validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Certificate, request.PublicKey, ca.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+// newSerialNumber returns a random 128-bit certificate serial number.
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
@@ -0,0 +1,90 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCertificateRequest(t *testing.T) (*x509.CertificateRequest, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "amt.example.com"},
+		DNSNames:    []string{"amt.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("192.168.0.10")},
+	}, key)
+	require.NoError(t, err)
+
+	request, err := x509.ParseCertificateRequest(der)
+	require.NoError(t, err)
+
+	return request, key
+}
+
+func TestLocalCASign(t *testing.T) {
+	ca, err := NewLocalCA("Test CA", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, ca.Certificate.IsCA)
+	assert.Equal(t, "Test CA", ca.Certificate.Subject.CommonName)
+
+	ca.Validity = 24 * time.Hour
+	request, key := newTestCertificateRequest(t)
+
+	certificate, err := ca.Sign(request)
+	require.NoError(t, err)
+	assert.NoError(t, certificate.CheckSignatureFrom(ca.Certificate))
+	assert.Equal(t, "amt.example.com", certificate.Subject.CommonName)
+	assert.Equal(t, []string{"amt.example.com"}, certificate.DNSNames)
+	assert.True(t, certificate.IPAddresses[0].Equal(net.ParseIP("192.168.0.10")))
+	assert.True(t, key.PublicKey.Equal(certificate.PublicKey))
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, certificate.ExtKeyUsage)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), certificate.NotAfter, time.Minute)
+}
+
+func TestLocalCASignBadSignature(t *testing.T) {
+	ca, err := NewLocalCA("Test CA", time.Hour)
+	require.NoError(t, err)
+
+	request, _ := newTestCertificateRequest(t)
+	request.Signature[0] ^= 0xff
+
+	certificate, err := ca.Sign(request)
+	assert.Nil(t, certificate)
+	assert.Error(t, err)
+}
+
+func TestNewLocalCAFromCertificate(t *testing.T) {
+	ca, err := NewLocalCA("Test CA", time.Hour)
+	require.NoError(t, err)
+
+	request, key := newTestCertificateRequest(t)
+	leaf, err := ca.Sign(request)
+	require.NoError(t, err)
+
+	_, err = NewLocalCAFromCertificate(leaf, key)
+	assert.ErrorIs(t, err, ErrNotCA)
+
+	reused, err := NewLocalCAFromCertificate(ca.Certificate, ca.key)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCertificateValidity, reused.Validity)
+
+	certificate, err := reused.Sign(request)
+	require.NoError(t, err)
+	assert.NoError(t, certificate.CheckSignatureFrom(ca.Certificate))
+}
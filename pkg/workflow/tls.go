@@ -0,0 +1,384 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
+)
+
+const (
+	RemoteTLSSettingDataInstanceID = "Intel(r) AMT 802.3 TLS Settings" // The AMT_TLSSettingData instance of the network interface.
+	LocalTLSSettingDataInstanceID  = "Intel(r) AMT LMS TLS Settings"   // The AMT_TLSSettingData instance of the local interface.
+)
+
+var (
+	ErrCommonNameRequired = errors.New("TLS request: CommonName is required")
+	ErrSignerRequired     = errors.New("TLS request: Signer is required")
+)
+
+// TLSRequest describes the TLS configuration applied by EnableTLS.
+type TLSRequest struct {
+	CommonName              string              // The common name of the subject of the TLS server certificate. Required.
+	DNSNames                []string            // The DNS names of the subject alternative name of the TLS server certificate.
+	IPAddresses             []net.IP            // The IP addresses of the subject alternative name of the TLS server certificate.
+	MutualAuthentication    bool                // Requires clients of the network interface to present a certificate.
+	TrustedCN               []string            // The common names accepted in client certificates. Applies only with MutualAuthentication.
+	TrustedRootCertificates []*x509.Certificate // The certificate authorities of the client certificates, added as trusted root certificates. Applies only with MutualAuthentication.
+	AllowNonTLS             bool                // Keeps accepting non-TLS connections on the network interface.
+	Signer                  Signer              // Issues the TLS server certificate. Required.
+}
+
+// TLSResult holds what EnableTLS created on the device.
+type TLSResult struct {
+	KeyPairHandle                 string            // The InstanceID of the AMT_PublicPrivateKeyPair generated by the device.
+	CertificateHandle             string            // The InstanceID of the AMT_PublicKeyCertificate of the TLS server certificate.
+	TrustedRootCertificateHandles []string          // The InstanceIDs of the trusted root certificates for mutual authentication, including those already on the device.
+	Certificate                   *x509.Certificate // The TLS server certificate.
+}
+
+// EnableTLS enables TLS on the network and local interfaces of the device with a new TLS server certificate.
+//
+// The device generates a key pair and signs a certificate request for it, waiting while the firmware reports PT_STATUS_PKI_GENERATING_KEYS.
+// request.Signer issues the certificate, which is added to the device and bound to TLS through AMT_TLSCredentialContext.
+// The TLS settings are then applied and committed. If a step fails, the changes made by the previous steps are undone,
+// and when the commit itself fails the restored settings are committed.
+func (w Workflow) EnableTLS(request TLSRequest) (result TLSResult, err error) {
+	if request.CommonName == "" {
+		return result, ErrCommonNameRequired
+	}
+
+	if request.Signer == nil {
+		return result, ErrSignerRequired
+	}
+
+	undo := &rollback{}
+
+	result.KeyPairHandle, err = w.generateKeyPair()
+	if err != nil {
+		return result, err
+	}
+
+	keyPairHandle := result.KeyPairHandle
+	undo.add(func() error {
+		_, err := w.messages.AMT.PublicPrivateKeyPair.Delete(keyPairHandle)
+
+		return err
+	})
+
+	certificateRequest, err := w.signCertificateRequest(keyPairHandle, request)
+	if err != nil {
+		return result, undo.fail(err)
+	}
+
+	result.Certificate, err = request.Signer.Sign(certificateRequest)
+	if err != nil {
+		return result, undo.fail(fmt.Errorf("signing the TLS server certificate: %w", err))
+	}
+
+	addCertificate, err := w.messages.AMT.PublicKeyManagementService.AddCertificate(base64.StdEncoding.EncodeToString(result.Certificate.Raw))
+	if err != nil {
+		return result, undo.fail(err)
+	}
+
+	result.CertificateHandle = selectorValue(addCertificate.Body.AddCertificate_OUTPUT.CreatedCertificate.ReferenceParameters.SelectorSet.Selectors, "InstanceID")
+	certificateHandle := result.CertificateHandle
+	undo.add(func() error {
+		_, err := w.messages.AMT.PublicKeyCertificate.Delete(certificateHandle)
+
+		return err
+	})
+
+	if request.MutualAuthentication {
+		result.TrustedRootCertificateHandles, err = w.addTrustedRootCertificates(request.TrustedRootCertificates, undo)
+		if err != nil {
+			return result, undo.fail(err)
+		}
+	}
+
+	err = w.bindTLSCertificate(certificateHandle, undo)
+	if err != nil {
+		return result, undo.fail(err)
+	}
+
+	remote := tls.SettingDataRequest{
+		ElementName:                RemoteTLSSettingDataInstanceID,
+		InstanceID:                 RemoteTLSSettingDataInstanceID,
+		Enabled:                    true,
+		MutualAuthentication:       request.MutualAuthentication,
+		TrustedCN:                  request.TrustedCN,
+		AcceptNonSecureConnections: request.AllowNonTLS,
+	}
+
+	err = w.putTLSSettingData(remote, undo)
+	if err != nil {
+		return result, undo.fail(err)
+	}
+
+	local := tls.SettingDataRequest{
+		ElementName: LocalTLSSettingDataInstanceID,
+		InstanceID:  LocalTLSSettingDataInstanceID,
+		Enabled:     true,
+	}
+
+	err = w.putTLSSettingData(local, undo)
+	if err != nil {
+		return result, undo.fail(err)
+	}
+
+	err = w.commitChanges()
+	if err != nil {
+		err = undo.fail(err)
+
+		// The restored settings take effect only once they are committed.
+		if commitErr := w.commitChanges(); commitErr != nil {
+			err = errors.Join(err, fmt.Errorf("rollback: %w", commitErr))
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+// commitChanges commits the pending changes to the configuration of the device.
+func (w Workflow) commitChanges() error {
+	commit, err := w.messages.AMT.SetupAndConfigurationService.CommitChanges()
+	if err != nil {
+		return err
+	}
+
+	return checkReturnValue("AMT_SetupAndConfigurationService.CommitChanges", int(commit.Body.CommitChanges_OUTPUT.ReturnValue))
+}
+
+// generateKeyPair generates an RSA 2048 key pair on the device and returns its handle.
+func (w Workflow) generateKeyPair() (handle string, err error) {
+	err = w.poll(func() (bool, error) {
+		response, err := w.messages.AMT.PublicKeyManagementService.GenerateKeyPair(publickey.RSA, publickey.KeyLength2048)
+		if err != nil {
+			return false, err
+		}
+
+		output := response.Body.GenerateKeyPair_OUTPUT
+		if output.ReturnValue == publickey.ReturnValuePKIGeneratingKeys {
+			return false, nil
+		}
+
+		handle = selectorValue(output.KeyPair.ReferenceParameters.SelectorSet.Selectors, "InstanceID")
+
+		return true, checkReturnValue("AMT_PublicKeyManagementService.GenerateKeyPair", int(output.ReturnValue))
+	})
+
+	return handle, err
+}
+
+// signCertificateRequest has the device sign a certificate request for the key pair, and returns the signed request after checking that it is for the key pair.
+func (w Workflow) signCertificateRequest(keyPairHandle string, request TLSRequest) (*x509.CertificateRequest, error) {
+	keyPair, err := w.messages.AMT.PublicPrivateKeyPair.Get(keyPairHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	options := publickey.CertificateRequestOptions{
+		Subject:     pkix.Name{CommonName: request.CommonName},
+		DNSNames:    request.DNSNames,
+		IPAddresses: request.IPAddresses,
+	}
+
+	nullSigned, err := publickey.NewNullSignedCertificateRequest(keyPair.Body.GetResponse, options, publickey.SHA256RSA)
+	if err != nil {
+		return nil, err
+	}
+
+	var signed string
+
+	err = w.poll(func() (bool, error) {
+		response, err := w.messages.AMT.PublicKeyManagementService.GeneratePKCS10RequestEx(keyPairHandle, nullSigned, publickey.SHA256RSA)
+		if err != nil {
+			return false, err
+		}
+
+		output := response.Body.GeneratePKCS10RequestEx_OUTPUT
+		if output.ReturnValue == publickey.ReturnValuePKIGeneratingKeys {
+			return false, nil
+		}
+
+		signed = output.SignedCertificateRequest
+
+		return true, checkReturnValue("AMT_PublicKeyManagementService.GeneratePKCS10RequestEx", int(output.ReturnValue))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return publickey.ParseSignedCertificateRequest(signed, keyPair.Body.GetResponse)
+}
+
+// addTrustedRootCertificates adds the certificates as trusted root certificates and returns their handles.
+// A certificate already on the device is not added again: its existing handle is returned, and the rollback leaves it in place.
+func (w Workflow) addTrustedRootCertificates(certificates []*x509.Certificate, undo *rollback) (handles []string, err error) {
+	for _, certificate := range certificates {
+		encoded := base64.StdEncoding.EncodeToString(certificate.Raw)
+
+		response, err := w.messages.AMT.PublicKeyManagementService.AddTrustedRootCertificate(encoded)
+
+		output := response.Body.AddTrustedRootCertificate_OUTPUT
+		if output.ReturnValue == publickey.ReturnValueDuplicate {
+			handle, err := w.findCertificate(encoded)
+			if err != nil {
+				return handles, err
+			}
+
+			handles = append(handles, handle)
+
+			continue
+		}
+
+		if err := methodError("AMT_PublicKeyManagementService.AddTrustedRootCertificate", int(output.ReturnValue), err); err != nil {
+			return handles, err
+		}
+
+		handle := selectorValue(output.CreatedCertificate.ReferenceParameters.SelectorSet.Selectors, "InstanceID")
+		handles = append(handles, handle)
+
+		undo.add(func() error {
+			_, err := w.messages.AMT.PublicKeyCertificate.Delete(handle)
+
+			return err
+		})
+	}
+
+	return handles, nil
+}
+
+// bindTLSCertificate makes the certificate the TLS server certificate, replacing the certificate of an existing AMT_TLSCredentialContext.
+func (w Workflow) bindTLSCertificate(certificateHandle string, undo *rollback) error {
+	enumerate, err := w.messages.AMT.TLSCredentialContext.Enumerate()
+	if err != nil {
+		return err
+	}
+
+	pull, err := w.messages.AMT.TLSCredentialContext.Pull(enumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return err
+	}
+
+	current, err := credentialContextCertificates(pull.XMLOutput)
+	if err != nil {
+		return err
+	}
+
+	if len(current) == 0 {
+		_, err = w.messages.AMT.TLSCredentialContext.Create(certificateHandle)
+		if err != nil {
+			return err
+		}
+
+		undo.add(func() error {
+			_, err := w.messages.AMT.TLSCredentialContext.Delete(certificateHandle)
+
+			return err
+		})
+
+		return nil
+	}
+
+	_, err = w.messages.AMT.TLSCredentialContext.Put(certificateHandle)
+	if err != nil {
+		return err
+	}
+
+	undo.add(func() error {
+		_, err := w.messages.AMT.TLSCredentialContext.Put(current[0])
+
+		return err
+	})
+
+	return nil
+}
+
+// putTLSSettingData applies the settings, and registers the restoring of the current settings with the rollback.
+func (w Workflow) putTLSSettingData(settings tls.SettingDataRequest, undo *rollback) error {
+	current, err := w.messages.AMT.TLSSettingData.Get(settings.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	previous := current.Body.SettingDataGetAndPutResponse
+
+	_, err = w.messages.AMT.TLSSettingData.Put(settings.InstanceID, settings)
+	if err != nil {
+		return err
+	}
+
+	undo.add(func() error {
+		restore := tls.SettingDataRequest{
+			ElementName:          previous.ElementName,
+			InstanceID:           previous.InstanceID,
+			Enabled:              previous.Enabled,
+			MutualAuthentication: previous.MutualAuthentication,
+			TrustedCN:            previous.TrustedCN,
+		}
+		// AcceptNonSecureConnections is read-only on the local interface.
+		if previous.InstanceID != LocalTLSSettingDataInstanceID {
+			restore.AcceptNonSecureConnections = previous.AcceptNonSecureConnections
+		}
+
+		_, err := w.messages.AMT.TLSSettingData.Put(restore.InstanceID, restore)
+
+		return err
+	})
+
+	return nil
+}
+
+// credentialContextCertificates returns the handles of the certificates bound by the AMT_TLSCredentialContext instances of a Pull response.
+// tls.SelectorResponse does not hold the text of a selector, so the handles are decoded from the response itself.
+func credentialContextCertificates(pullResponse string) ([]string, error) {
+	var envelope struct {
+		Contexts []struct {
+			Selectors []struct {
+				Name string `xml:"Name,attr"`
+				Text string `xml:",chardata"`
+			} `xml:"ElementInContext>ReferenceParameters>SelectorSet>Selector"`
+		} `xml:"Body>PullResponse>Items>AMT_TLSCredentialContext"`
+	}
+
+	if err := xml.Unmarshal([]byte(pullResponse), &envelope); err != nil {
+		return nil, err
+	}
+
+	var handles []string
+
+	for _, context := range envelope.Contexts {
+		for _, selector := range context.Selectors {
+			if selector.Name == "InstanceID" {
+				handles = append(handles, selector.Text)
+			}
+		}
+	}
+
+	return handles, nil
+}
+
+// selectorValue returns the value of the named selector of an endpoint reference returned by a publickey method.
+func selectorValue(selectors []publickey.SelectorResponse, name string) string {
+	for _, selector := range selectors {
+		if selector.Name == name {
+			return selector.Text
+		}
+	}
+
+	return ""
+}
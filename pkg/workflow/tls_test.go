@@ -0,0 +1,338 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
+)
+
+// tlsResponses returns the responses of a device that generates the key pair at once and already has a TLS server certificate.
+func tlsResponses() map[string][]string {
+	return map[string][]string{
+		"AMT_PublicKeyManagementService/GenerateKeyPair":           {"amt/publickey/management/generatekeypair"},
+		"AMT_PublicPrivateKeyPair/Get":                             {"workflow/tls/publicprivate-get"},
+		"AMT_PublicPrivateKeyPair/Delete":                          {"amt/publicprivate/delete"},
+		"AMT_PublicKeyManagementService/GeneratePKCS10RequestEx":   {"workflow/tls/generatepkcs10requestex"},
+		"AMT_PublicKeyManagementService/AddCertificate":            {"amt/publickey/management/addcertificate"},
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate": {"amt/publickey/management/addtrustedrootcertificate"},
+		"AMT_PublicKeyCertificate/Delete":                          {"amt/publickey/certificate/delete"},
+		"AMT_TLSCredentialContext/Enumerate":                       {"amt/tls/credentialcontext/enumerate"},
+		"AMT_TLSCredentialContext/Pull":                            {"amt/tls/credentialcontext/pull"},
+		"AMT_TLSCredentialContext/Put":                             {"amt/tls/credentialcontext/put"},
+		"AMT_TLSCredentialContext/Create":                          {"amt/tls/credentialcontext/create"},
+		"AMT_TLSCredentialContext/Delete":                          {"workflow/tls/credentialcontext-delete"},
+		"AMT_TLSSettingData/Get":                                   {"amt/tls/settingdata/get", "workflow/tls/settingdata-get-local"},
+		"AMT_TLSSettingData/Put":                                   {"amt/tls/settingdata/put"},
+		"AMT_SetupAndConfigurationService/CommitChanges":           {"amt/setupandconfiguration/commitchanges"},
+	}
+}
+
+func newTestTLSRequest(t *testing.T) TLSRequest {
+	t.Helper()
+
+	ca, err := NewLocalCA("Test CA", time.Hour)
+	require.NoError(t, err)
+
+	return TLSRequest{
+		CommonName:  "amt.example.com",
+		DNSNames:    []string{"amt.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("192.168.0.10")},
+		Signer:      ca,
+	}
+}
+
+// deviceCertificate returns a certificate of the workflow/inventory/certificate-pull fixture, whose handle is "Intel(r) AMT Certificate: Handle: <index>".
+func deviceCertificate(t *testing.T, index int) *x509.Certificate {
+	t.Helper()
+
+	fixture, err := os.ReadFile("../wsman/wsmantesting/responses/workflow/inventory/certificate-pull.xml")
+	require.NoError(t, err)
+
+	blobs := regexp.MustCompile(`<h:X509Certificate>([^<]*)</h:X509Certificate>`).FindAllStringSubmatch(string(fixture), -1)
+	require.Greater(t, len(blobs), index)
+
+	der, err := base64.StdEncoding.DecodeString(blobs[index][1])
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return certificate
+}
+
+type failingSigner struct{}
+
+func (failingSigner) Sign(*x509.CertificateRequest) (*x509.Certificate, error) {
+	return nil, errTransport
+}
+
+func TestEnableTLS(t *testing.T) {
+	w, client, _ := newTestWorkflow(tlsResponses())
+	request := newTestTLSRequest(t)
+
+	result, err := w.EnableTLS(request)
+	require.NoError(t, err)
+	assert.Equal(t, "Intel(r) AMT Key: Handle: 0", result.KeyPairHandle)
+	assert.Equal(t, "Intel(r) AMT Certificate: Handle: 1", result.CertificateHandle)
+	assert.Empty(t, result.TrustedRootCertificateHandles)
+	assert.Equal(t, "amt.example.com", result.Certificate.Subject.CommonName)
+	assert.NoError(t, result.Certificate.CheckSignatureFrom(request.Signer.(*LocalCA).Certificate))
+	assert.Equal(t, []string{
+		"AMT_PublicKeyManagementService/GenerateKeyPair",
+		"AMT_PublicPrivateKeyPair/Get",
+		"AMT_PublicKeyManagementService/GeneratePKCS10RequestEx",
+		"AMT_PublicKeyManagementService/AddCertificate",
+		"AMT_TLSCredentialContext/Enumerate",
+		"AMT_TLSCredentialContext/Pull",
+		"AMT_TLSCredentialContext/Put",
+		"AMT_TLSSettingData/Get",
+		"AMT_TLSSettingData/Put",
+		"AMT_TLSSettingData/Get",
+		"AMT_TLSSettingData/Put",
+		"AMT_SetupAndConfigurationService/CommitChanges",
+	}, client.Requests)
+
+	remote, local := client.Messages[8], client.Messages[10]
+	assert.Contains(t, remote, "<h:InstanceID>Intel(r) AMT 802.3 TLS Settings</h:InstanceID>")
+	assert.Contains(t, remote, "<h:Enabled>true</h:Enabled>")
+	assert.Contains(t, remote, "<h:AcceptNonSecureConnections>false</h:AcceptNonSecureConnections>")
+	assert.Contains(t, local, "<h:InstanceID>Intel(r) AMT LMS TLS Settings</h:InstanceID>")
+	assert.Contains(t, local, "<h:Enabled>true</h:Enabled>")
+}
+
+func TestEnableTLSMutualAuthentication(t *testing.T) {
+	responses := tlsResponses()
+	responses["AMT_PublicKeyManagementService/AddTrustedRootCertificate"] = []string{
+		"amt/publickey/management/addtrustedrootcertificate",
+		"workflow/tls/addtrustedrootcertificate-duplicate",
+	}
+	responses["AMT_PublicKeyCertificate/Enumerate"] = []string{"amt/publickey/certificate/enumerate"}
+	responses["AMT_PublicKeyCertificate/Pull"] = []string{"workflow/inventory/certificate-pull"}
+	responses["AMT_TLSCredentialContext/Pull"] = []string{"workflow/tls/credentialcontext-pull-empty"}
+	w, client, _ := newTestWorkflow(responses)
+
+	clientCA, err := NewLocalCA("Client CA", time.Hour)
+	require.NoError(t, err)
+
+	existing := deviceCertificate(t, 0)
+
+	request := newTestTLSRequest(t)
+	request.MutualAuthentication = true
+	request.TrustedCN = []string{"console.example.com"}
+	request.TrustedRootCertificates = []*x509.Certificate{clientCA.Certificate, existing}
+	request.AllowNonTLS = true
+
+	result, err := w.EnableTLS(request)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Intel(r) AMT Certificate: Handle: 2", "Intel(r) AMT Certificate: Handle: 0"}, result.TrustedRootCertificateHandles)
+	assert.Equal(t, []string{
+		"AMT_PublicKeyManagementService/GenerateKeyPair",
+		"AMT_PublicPrivateKeyPair/Get",
+		"AMT_PublicKeyManagementService/GeneratePKCS10RequestEx",
+		"AMT_PublicKeyManagementService/AddCertificate",
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate",
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate",
+		"AMT_PublicKeyCertificate/Enumerate",
+		"AMT_PublicKeyCertificate/Pull",
+		"AMT_TLSCredentialContext/Enumerate",
+		"AMT_TLSCredentialContext/Pull",
+		"AMT_TLSCredentialContext/Create",
+		"AMT_TLSSettingData/Get",
+		"AMT_TLSSettingData/Put",
+		"AMT_TLSSettingData/Get",
+		"AMT_TLSSettingData/Put",
+		"AMT_SetupAndConfigurationService/CommitChanges",
+	}, client.Requests)
+
+	remote := client.Messages[12]
+	assert.Contains(t, remote, "<h:MutualAuthentication>true</h:MutualAuthentication>")
+	assert.Contains(t, remote, "<h:TrustedCN>console.example.com</h:TrustedCN>")
+	assert.Contains(t, remote, "<h:AcceptNonSecureConnections>true</h:AcceptNonSecureConnections>")
+}
+
+func TestEnableTLSGeneratingKeys(t *testing.T) {
+	responses := tlsResponses()
+	responses["AMT_PublicKeyManagementService/GenerateKeyPair"] = []string{
+		"workflow/tls/generatekeypair-generating",
+		"workflow/tls/generatekeypair-generating",
+		"amt/publickey/management/generatekeypair",
+	}
+	responses["AMT_PublicKeyManagementService/GeneratePKCS10RequestEx"] = []string{
+		"workflow/tls/generatepkcs10requestex-generating",
+		"workflow/tls/generatepkcs10requestex",
+	}
+	w, client, slept := newTestWorkflow(responses)
+
+	_, err := w.EnableTLS(newTestTLSRequest(t))
+	require.NoError(t, err)
+	assert.Equal(t, 3*DefaultPollInterval, *slept)
+	assert.Equal(t, []string{
+		"AMT_PublicKeyManagementService/GenerateKeyPair",
+		"AMT_PublicKeyManagementService/GenerateKeyPair",
+		"AMT_PublicKeyManagementService/GenerateKeyPair",
+		"AMT_PublicPrivateKeyPair/Get",
+		"AMT_PublicKeyManagementService/GeneratePKCS10RequestEx",
+		"AMT_PublicKeyManagementService/GeneratePKCS10RequestEx",
+	}, client.Requests[:6])
+}
+
+func TestEnableTLSTimeout(t *testing.T) {
+	responses := tlsResponses()
+	responses["AMT_PublicKeyManagementService/GenerateKeyPair"] = []string{"workflow/tls/generatekeypair-generating"}
+	w, client, slept := newTestWorkflow(responses)
+	w.Timeout = 30 * time.Second
+
+	_, err := w.EnableTLS(newTestTLSRequest(t))
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, w.Timeout, *slept)
+	assert.NotContains(t, client.Requests, "AMT_PublicPrivateKeyPair/Delete")
+}
+
+func TestEnableTLSRollback(t *testing.T) {
+	tests := []struct {
+		name     string
+		failure  string
+		rollback []string
+	}{
+		{
+			"signing the certificate request",
+			"AMT_PublicKeyManagementService/GeneratePKCS10RequestEx",
+			[]string{"AMT_PublicPrivateKeyPair/Delete"},
+		},
+		{
+			"adding the certificate",
+			"AMT_PublicKeyManagementService/AddCertificate",
+			[]string{"AMT_PublicPrivateKeyPair/Delete"},
+		},
+		{
+			"binding the certificate",
+			"AMT_TLSCredentialContext/Put",
+			[]string{"AMT_PublicKeyCertificate/Delete", "AMT_PublicPrivateKeyPair/Delete"},
+		},
+		{
+			"committing the changes",
+			"AMT_SetupAndConfigurationService/CommitChanges",
+			[]string{
+				"AMT_TLSSettingData/Put",
+				"AMT_TLSSettingData/Put",
+				"AMT_TLSCredentialContext/Put",
+				"AMT_PublicKeyCertificate/Delete",
+				"AMT_PublicPrivateKeyPair/Delete",
+				"AMT_SetupAndConfigurationService/CommitChanges",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(tlsResponses())
+			client.Failures[test.failure] = errTransport
+
+			_, err := w.EnableTLS(newTestTLSRequest(t))
+			assert.ErrorIs(t, err, errTransport)
+
+			failed := 0
+			for i, request := range client.Requests {
+				if request == test.failure {
+					failed = i
+
+					break
+				}
+			}
+
+			assert.Equal(t, test.rollback, client.Requests[failed+1:])
+		})
+	}
+}
+
+func TestEnableTLSRollbackRestoresSettings(t *testing.T) {
+	w, client, _ := newTestWorkflow(tlsResponses())
+	client.Failures["AMT_SetupAndConfigurationService/CommitChanges"] = errTransport
+
+	_, err := w.EnableTLS(newTestTLSRequest(t))
+	assert.ErrorIs(t, err, errTransport)
+
+	messages := client.Messages[len(client.Messages)-6:]
+	assert.Contains(t, messages[0], "<h:InstanceID>Intel(r) AMT LMS TLS Settings</h:InstanceID>")
+	assert.Contains(t, messages[0], "<h:Enabled>false</h:Enabled>")
+	assert.Contains(t, messages[1], "<h:InstanceID>Intel(r) AMT 802.3 TLS Settings</h:InstanceID>")
+	assert.Contains(t, messages[1], "<h:Enabled>false</h:Enabled>")
+	assert.Contains(t, messages[2], "Intel(r) AMT Certificate: Handle: 3")
+}
+
+func TestEnableTLSRollbackCommitsRestoredSettings(t *testing.T) {
+	responses := tlsResponses()
+	responses["AMT_SetupAndConfigurationService/CommitChanges"] = []string{
+		"workflow/tls/commitchanges-failed",
+		"amt/setupandconfiguration/commitchanges",
+	}
+	w, client, _ := newTestWorkflow(responses)
+
+	_, err := w.EnableTLS(newTestTLSRequest(t))
+
+	var returnValueError *ReturnValueError
+	require.ErrorAs(t, err, &returnValueError)
+	assert.Equal(t, "AMT_SetupAndConfigurationService.CommitChanges", returnValueError.Operation)
+	assert.NotContains(t, err.Error(), "rollback")
+	assert.Equal(t, "AMT_SetupAndConfigurationService/CommitChanges", client.Requests[len(client.Requests)-1])
+}
+
+func TestEnableTLSRollbackFailure(t *testing.T) {
+	errDelete := errors.New("delete failed")
+
+	w, client, _ := newTestWorkflow(tlsResponses())
+	client.Failures["AMT_PublicKeyManagementService/AddCertificate"] = errTransport
+	client.Failures["AMT_PublicPrivateKeyPair/Delete"] = errDelete
+
+	_, err := w.EnableTLS(newTestTLSRequest(t))
+	assert.ErrorIs(t, err, errTransport)
+	assert.ErrorIs(t, err, errDelete)
+	assert.True(t, strings.HasSuffix(err.Error(), "rollback: delete failed"))
+}
+
+func TestEnableTLSInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  func(TLSRequest) TLSRequest
+		expected error
+	}{
+		{"missing common name", func(r TLSRequest) TLSRequest { r.CommonName = ""; return r }, ErrCommonNameRequired},
+		{"missing signer", func(r TLSRequest) TLSRequest { r.Signer = nil; return r }, ErrSignerRequired},
+		{"failing signer", func(r TLSRequest) TLSRequest { r.Signer = failingSigner{}; return r }, errTransport},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, _, _ := newTestWorkflow(tlsResponses())
+
+			_, err := w.EnableTLS(test.request(newTestTLSRequest(t)))
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
+
+func TestEnableTLSPublicKeyMismatch(t *testing.T) {
+	responses := tlsResponses()
+	responses["AMT_PublicPrivateKeyPair/Get"] = []string{"amt/publicprivate/get"}
+	w, client, _ := newTestWorkflow(responses)
+
+	_, err := w.EnableTLS(newTestTLSRequest(t))
+	assert.ErrorIs(t, err, publickey.ErrPublicKeyMismatch)
+	assert.Equal(t, "AMT_PublicPrivateKeyPair/Delete", client.Requests[len(client.Requests)-1])
+}
@@ -0,0 +1,331 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/wifi"
+)
+
+// WiFiEndpointName is the Name of the CIM_WiFiEndpoint the profiles are added to.
+const WiFiEndpointName = "WiFi Endpoint 0"
+
+var (
+	// ErrUnknownAuthenticationMethod is returned for an authentication method that does not name a wifi.AuthenticationMethod.
+	ErrUnknownAuthenticationMethod = errors.New("unknown Wi-Fi authentication method")
+	// ErrUnknownEncryptionMethod is returned for an encryption method that does not name a wifi.EncryptionMethod.
+	ErrUnknownEncryptionMethod = errors.New("unknown Wi-Fi encryption method")
+	// ErrDuplicateProfile is returned when two profiles have the same name.
+	ErrDuplicateProfile = errors.New("duplicate Wi-Fi profile name")
+	// ErrIEEE8021xRequired is returned for a profile with an 802.1X authentication method without 802.1X settings.
+	ErrIEEE8021xRequired = errors.New("the authentication method requires 802.1X settings")
+)
+
+// authenticationMethodAliases maps the normalized names of authentication methods to wifi.AuthenticationMethod.
+var authenticationMethodAliases = map[string]wifi.AuthenticationMethod{
+	"other":             wifi.AuthenticationMethodOther,
+	"opensystem":        wifi.AuthenticationMethodOpenSystem,
+	"open":              wifi.AuthenticationMethodOpenSystem,
+	"sharedkey":         wifi.AuthenticationMethodSharedKey,
+	"wpapsk":            wifi.AuthenticationMethodWPAPSK,
+	"wpaieee8021x":      wifi.AuthenticationMethodWPAIEEE8021x,
+	"wpa8021x":          wifi.AuthenticationMethodWPAIEEE8021x,
+	"wpa2psk":           wifi.AuthenticationMethodWPA2PSK,
+	"wpa2ieee8021x":     wifi.AuthenticationMethodWPA2IEEE8021x,
+	"wpa28021x":         wifi.AuthenticationMethodWPA2IEEE8021x,
+	"wpa2enterprise":    wifi.AuthenticationMethodWPA2IEEE8021x,
+	"wpa3sae":           wifi.AuthenticationMethodWPA3SAE,
+	"wpa3owe":           wifi.AuthenticationMethodWPA3OWE,
+	"wpa2personal":      wifi.AuthenticationMethodWPA2PSK,
+	"wpa3personal":      wifi.AuthenticationMethodWPA3SAE,
+	"wpapersonal":       wifi.AuthenticationMethodWPAPSK,
+	"wpaenterprise":     wifi.AuthenticationMethodWPAIEEE8021x,
+	"enhancedopen":      wifi.AuthenticationMethodWPA3OWE,
+	"opportunisticwpa3": wifi.AuthenticationMethodWPA3OWE,
+}
+
+// encryptionMethodAliases maps the normalized names of encryption methods to wifi.EncryptionMethod.
+var encryptionMethodAliases = map[string]wifi.EncryptionMethod{
+	"other": wifi.EncryptionMethod_Other,
+	"wep":   wifi.EncryptionMethod_WEP,
+	"tkip":  wifi.EncryptionMethod_TKIP,
+	"ccmp":  wifi.EncryptionMethod_CCMP,
+	"aes":   wifi.EncryptionMethod_CCMP,
+	"none":  wifi.EncryptionMethod_None,
+}
+
+// ParseAuthenticationMethod returns the wifi.AuthenticationMethod named by method, such as "WPA3 SAE", "WPA2-PSK" or "wpa2ieee8021x".
+// Case, spaces, dashes and underscores are ignored. The decimal value of the enumeration, such as "6", is accepted too.
+func ParseAuthenticationMethod(method string) (wifi.AuthenticationMethod, error) {
+	if value, err := strconv.Atoi(method); err == nil && wifi.AuthenticationMethod(value).String() != wifi.ValueNotFound {
+		return wifi.AuthenticationMethod(value), nil
+	}
+
+	if value, ok := authenticationMethodAliases[normalizeMethod(method)]; ok {
+		return value, nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownAuthenticationMethod, method)
+}
+
+// ParseEncryptionMethod returns the wifi.EncryptionMethod named by method, such as "CCMP", "AES" or "TKIP".
+// Case, spaces, dashes and underscores are ignored. The decimal value of the enumeration, such as "4", is accepted too.
+func ParseEncryptionMethod(method string) (wifi.EncryptionMethod, error) {
+	if value, err := strconv.Atoi(method); err == nil && wifi.EncryptionMethod(value).String() != wifi.ValueNotFound {
+		return wifi.EncryptionMethod(value), nil
+	}
+
+	if value, ok := encryptionMethodAliases[normalizeMethod(method)]; ok {
+		return value, nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownEncryptionMethod, method)
+}
+
+func normalizeMethod(method string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "", ".", "").Replace(strings.ToLower(method))
+}
+
+// WiFiSyncOptions changes how SyncWiFiProfiles reconciles the profiles of the device.
+type WiFiSyncOptions struct {
+	// ReplaceAll re-adds the profiles whose SSID, methods and priority already match.
+	// The passphrase and 802.1X credentials of a profile cannot be read from the device, so changes to them are applied only with ReplaceAll.
+	ReplaceAll bool
+	// KeepExtra keeps the profiles of the device that are not in the list, instead of removing them.
+	KeepExtra bool
+}
+
+// WiFiSyncResult holds the names of the profiles changed by SyncWiFiProfiles.
+type WiFiSyncResult struct {
+	Added     []string
+	Updated   []string
+	Removed   []string
+	Unchanged []string
+}
+
+// wifiProfile is a config.WirelessProfile converted to the inputs of AddWiFiSettings.
+type wifiProfile struct {
+	settings  wifi.WiFiEndpointSettingsRequest
+	ieee8021x *config.IEEE8021x
+}
+
+// SyncWiFiProfiles makes the Wi-Fi profiles of the device match profiles, then enables the Wi-Fi port.
+//
+// Profiles are matched by name. A profile missing on the device is added, a profile whose settings differ is deleted and re-added,
+// and a profile of the device that is not in profiles is removed. The certificates and key of 802.1X profiles are added to the device first.
+// All the profiles are converted and validated before the device is changed. The missing profiles are added first and the stale profiles
+// are removed last, so the device keeps the profiles it can connect with when a call fails; it is left as far as the sync got.
+func (w Workflow) SyncWiFiProfiles(profiles []config.WirelessProfile, options WiFiSyncOptions) (result WiFiSyncResult, err error) {
+	desired := make([]wifiProfile, 0, len(profiles))
+	names := map[string]bool{}
+
+	for _, profile := range profiles {
+		if names[profile.ProfileName] {
+			return result, fmt.Errorf("%w: %s", ErrDuplicateProfile, profile.ProfileName)
+		}
+
+		names[profile.ProfileName] = true
+
+		converted, err := convertWirelessProfile(profile)
+		if err != nil {
+			return result, fmt.Errorf("Wi-Fi profile %s: %w", profile.ProfileName, err)
+		}
+
+		desired = append(desired, converted)
+	}
+
+	enumerate, err := w.messages.CIM.WiFiEndpointSettings.Enumerate()
+	if err != nil {
+		return result, err
+	}
+
+	pull, err := w.messages.CIM.WiFiEndpointSettings.Pull(enumerate.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return result, err
+	}
+
+	existing := map[string]wifi.WiFiEndpointSettingsResponse{}
+
+	var stale []wifi.WiFiEndpointSettingsResponse
+
+	for _, item := range pull.Body.PullResponse.EndpointSettingsItems {
+		if !names[item.ElementName] && !options.KeepExtra {
+			stale = append(stale, item)
+
+			continue
+		}
+
+		existing[item.ElementName] = item
+	}
+
+	var changed []wifiProfile
+
+	for _, profile := range desired {
+		name := profile.settings.ElementName
+		current, found := existing[name]
+
+		switch {
+		case !found:
+			err = w.addWiFiProfile(profile)
+			if err != nil {
+				return result, fmt.Errorf("Wi-Fi profile %s: %w", name, err)
+			}
+
+			result.Added = append(result.Added, name)
+		case !options.ReplaceAll && sameWiFiSettings(current, profile.settings):
+			result.Unchanged = append(result.Unchanged, name)
+		default:
+			changed = append(changed, profile)
+		}
+	}
+
+	for _, profile := range changed {
+		name := profile.settings.ElementName
+
+		_, err := w.messages.CIM.WiFiEndpointSettings.Delete(existing[name].InstanceID)
+		if err != nil {
+			return result, err
+		}
+
+		err = w.addWiFiProfile(profile)
+		if err != nil {
+			return result, fmt.Errorf("Wi-Fi profile %s: %w", name, err)
+		}
+
+		result.Updated = append(result.Updated, name)
+	}
+
+	for _, item := range stale {
+		_, err := w.messages.CIM.WiFiEndpointSettings.Delete(item.InstanceID)
+		if err != nil {
+			return result, err
+		}
+
+		result.Removed = append(result.Removed, item.ElementName)
+	}
+
+	return result, w.EnableWiFiPort()
+}
+
+// convertWirelessProfile converts and validates a profile.
+func convertWirelessProfile(profile config.WirelessProfile) (converted wifiProfile, err error) {
+	authenticationMethod, err := ParseAuthenticationMethod(profile.AuthenticationMethod)
+	if err != nil {
+		return converted, err
+	}
+
+	encryptionMethod, err := ParseEncryptionMethod(profile.EncryptionMethod)
+	if err != nil {
+		return converted, err
+	}
+
+	converted.settings = wifi.WiFiEndpointSettingsRequest{
+		ElementName:          profile.ProfileName,
+		InstanceID:           "Intel(r) AMT:WiFi Endpoint Settings " + profile.ProfileName,
+		AuthenticationMethod: authenticationMethod,
+		EncryptionMethod:     encryptionMethod,
+		BSSType:              wifi.BSSTypeInfrastructure,
+		SSID:                 profile.SSID,
+		Priority:             profile.Priority,
+	}
+
+	switch authenticationMethod {
+	case wifi.AuthenticationMethodWPAPSK, wifi.AuthenticationMethodWPA2PSK, wifi.AuthenticationMethodWPA3SAE:
+		converted.settings.PSKPassPhrase = profile.Password
+	case wifi.AuthenticationMethodWPAIEEE8021x, wifi.AuthenticationMethodWPA2IEEE8021x:
+		if profile.IEEE8021x == nil {
+			return converted, ErrIEEE8021xRequired
+		}
+
+		if err := validateIEEE8021x(profile.IEEE8021x); err != nil {
+			return converted, err
+		}
+
+		converted.ieee8021x = profile.IEEE8021x
+	}
+
+	return converted, converted.settings.Validate()
+}
+
+// sameWiFiSettings reports whether the readable settings of a profile of the device match the settings to apply.
+func sameWiFiSettings(current wifi.WiFiEndpointSettingsResponse, settings wifi.WiFiEndpointSettingsRequest) bool {
+	return current.SSID == settings.SSID &&
+		current.AuthenticationMethod == settings.AuthenticationMethod &&
+		current.EncryptionMethod == settings.EncryptionMethod &&
+		current.Priority == settings.Priority
+}
+
+// addWiFiProfile adds the profile to the device, after its 802.1X certificates and key.
+func (w Workflow) addWiFiProfile(profile wifiProfile) error {
+	var (
+		ieee8021xSettings models.IEEE8021xSettings
+		credentials       ieee8021xCredentials
+	)
+
+	if profile.ieee8021x != nil {
+		var err error
+
+		credentials, err = w.addIEEE8021xCredentials(profile.ieee8021x)
+		if err != nil {
+			return err
+		}
+
+		ieee8021xSettings = models.IEEE8021xSettings{
+			ElementName:            profile.settings.ElementName,
+			InstanceID:             profile.settings.ElementName,
+			AuthenticationProtocol: models.AuthenticationProtocol(profile.ieee8021x.AuthenticationProtocol),
+			Username:               profile.ieee8021x.Username,
+		}
+
+		if ieee8021xSettings.AuthenticationProtocol != models.AuthenticationProtocol_EAPTLS {
+			ieee8021xSettings.Password = profile.ieee8021x.Password
+		}
+	}
+
+	response, err := w.messages.AMT.WiFiPortConfigurationService.AddWiFiSettings(profile.settings, ieee8021xSettings, WiFiEndpointName, credentials.ClientCertificate, credentials.CACertificate)
+
+	return methodError("AMT_WiFiPortConfigurationService.AddWiFiSettings", int(response.Body.AddWiFiSettingsOutput.ReturnValue), err)
+}
+
+// EnableWiFiPort enables the Wi-Fi port of the device in S0 and Sx/AC, after enabling the synchronization of local profiles if it is disabled.
+func (w Workflow) EnableWiFiPort() error {
+	service, err := w.messages.AMT.WiFiPortConfigurationService.Get()
+	if err != nil {
+		return err
+	}
+
+	current := service.Body.WiFiPortConfigurationService
+	if current.LocalProfileSynchronizationEnabled == wifiportconfiguration.LocalSyncDisabled {
+		_, err = w.messages.AMT.WiFiPortConfigurationService.Put(wifiportconfiguration.WiFiPortConfigurationServiceRequest{
+			RequestedState:                     current.RequestedState,
+			EnabledState:                       current.EnabledState,
+			HealthState:                        current.HealthState,
+			ElementName:                        current.ElementName,
+			SystemCreationClassName:            current.SystemCreationClassName,
+			SystemName:                         current.SystemName,
+			CreationClassName:                  current.CreationClassName,
+			Name:                               current.Name,
+			LocalProfileSynchronizationEnabled: wifiportconfiguration.UnrestrictedSync,
+			LastConnectedSsidUnderMeControl:    current.LastConnectedSsidUnderMeControl,
+			NoHostCsmeSoftwarePolicy:           current.NoHostCsmeSoftwarePolicy,
+			UEFIWiFiProfileShareEnabled:        current.UEFIWiFiProfileShareEnabled,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	response, err := w.messages.CIM.WiFiPort.RequestStateChange(int(wifi.RequestedStateWifiEnabledS0SxAC))
+
+	return methodError("CIM_WiFiPort.RequestStateChange", response.Body.RequestStateChange_OUTPUT.ReturnValue, err)
+}
@@ -0,0 +1,329 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/config"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/wifi"
+)
+
+// wifiResponses returns the responses of a device with the Wi-Fi profiles:
+//   - home: WPA2 PSK, CCMP, priority 1, SSID home-ssid;
+//   - office: WPA PSK, TKIP, priority 2, SSID office-ssid;
+//   - old: WPA2 PSK, CCMP, priority 3, SSID old-ssid.
+func wifiResponses() map[string][]string {
+	return map[string][]string{
+		"CIM_WiFiEndpointSettings/Enumerate":                       {"cim/wifi/endpointsettings/enumerate"},
+		"CIM_WiFiEndpointSettings/Pull":                            {"workflow/wifi/endpointsettings-pull"},
+		"CIM_WiFiEndpointSettings/Delete":                          {"cim/wifi/endpointsettings/delete"},
+		"AMT_WiFiPortConfigurationService/AddWiFiSettings":         {"workflow/wifi/addwifisettings"},
+		"AMT_WiFiPortConfigurationService/Get":                     {"amt/wifiportconfiguration/get"},
+		"AMT_WiFiPortConfigurationService/Put":                     {"amt/wifiportconfiguration/put"},
+		"CIM_WiFiPort/RequestStateChange":                          {"cim/wifi/port/requeststatechange"},
+		"AMT_PublicKeyManagementService/AddKey":                    {"amt/publickey/management/addkey"},
+		"AMT_PublicKeyManagementService/AddCertificate":            {"amt/publickey/management/addcertificate"},
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate": {"amt/publickey/management/addtrustedrootcertificate"},
+		"AMT_PublicKeyCertificate/Enumerate":                       {"amt/publickey/certificate/enumerate"},
+		"AMT_PublicKeyCertificate/Pull":                            {"workflow/inventory/certificate-pull"},
+	}
+}
+
+func wifiProfiles() []config.WirelessProfile {
+	return []config.WirelessProfile{
+		{ProfileName: "home", SSID: "home-ssid", Password: "home-passphrase", AuthenticationMethod: "WPA2 PSK", EncryptionMethod: "CCMP", Priority: 1},
+		{ProfileName: "office", SSID: "office-ssid", Password: "office-passphrase", AuthenticationMethod: "WPA2-PSK", EncryptionMethod: "AES", Priority: 2},
+		{
+			ProfileName:          "corp",
+			SSID:                 "corp-ssid",
+			AuthenticationMethod: "WPA2 IEEE 802.1x",
+			EncryptionMethod:     "CCMP",
+			Priority:             3,
+			IEEE8021x: &config.IEEE8021x{
+				Username:   "device",
+				ClientCert: "Y2xpZW50",
+				CACert:     "Y2E=",
+				PrivateKey: "a2V5",
+			},
+		},
+	}
+}
+
+func TestParseAuthenticationMethod(t *testing.T) {
+	tests := []struct {
+		method   string
+		expected wifi.AuthenticationMethod
+	}{
+		{"WPA3 SAE", wifi.AuthenticationMethodWPA3SAE},
+		{"wpa3-owe", wifi.AuthenticationMethodWPA3OWE},
+		{"WPA2PSK", wifi.AuthenticationMethodWPA2PSK},
+		{"WPA2 Personal", wifi.AuthenticationMethodWPA2PSK},
+		{"WPA_IEEE_802.1x", wifi.AuthenticationMethodWPAIEEE8021x},
+		{"WPA2 802.1X", wifi.AuthenticationMethodWPA2IEEE8021x},
+		{"Open System", wifi.AuthenticationMethodOpenSystem},
+		{"6", wifi.AuthenticationMethodWPA2PSK},
+		{"32768", wifi.AuthenticationMethodWPA3SAE},
+	}
+
+	for _, test := range tests {
+		t.Run(test.method, func(t *testing.T) {
+			method, err := ParseAuthenticationMethod(test.method)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, method)
+		})
+	}
+
+	for _, method := range []string{"", "WPA4", "99"} {
+		_, err := ParseAuthenticationMethod(method)
+		assert.ErrorIs(t, err, ErrUnknownAuthenticationMethod, method)
+	}
+}
+
+func TestParseEncryptionMethod(t *testing.T) {
+	tests := []struct {
+		method   string
+		expected wifi.EncryptionMethod
+	}{
+		{"CCMP", wifi.EncryptionMethod_CCMP},
+		{"aes", wifi.EncryptionMethod_CCMP},
+		{"TKIP", wifi.EncryptionMethod_TKIP},
+		{"None", wifi.EncryptionMethod_None},
+		{"2", wifi.EncryptionMethod_WEP},
+	}
+
+	for _, test := range tests {
+		t.Run(test.method, func(t *testing.T) {
+			method, err := ParseEncryptionMethod(test.method)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, method)
+		})
+	}
+
+	for _, method := range []string{"", "GCMP", "0"} {
+		_, err := ParseEncryptionMethod(method)
+		assert.ErrorIs(t, err, ErrUnknownEncryptionMethod, method)
+	}
+}
+
+func TestSyncWiFiProfiles(t *testing.T) {
+	w, client, _ := newTestWorkflow(wifiResponses())
+
+	result, err := w.SyncWiFiProfiles(wifiProfiles(), WiFiSyncOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, WiFiSyncResult{
+		Added:     []string{"corp"},
+		Updated:   []string{"office"},
+		Removed:   []string{"old"},
+		Unchanged: []string{"home"},
+	}, result)
+
+	assert.Equal(t, []string{
+		"CIM_WiFiEndpointSettings/Enumerate",
+		"CIM_WiFiEndpointSettings/Pull",
+		"AMT_PublicKeyManagementService/AddKey",
+		"AMT_PublicKeyManagementService/AddCertificate",
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate",
+		"AMT_WiFiPortConfigurationService/AddWiFiSettings",
+		"CIM_WiFiEndpointSettings/Delete",
+		"AMT_WiFiPortConfigurationService/AddWiFiSettings",
+		"CIM_WiFiEndpointSettings/Delete",
+		"AMT_WiFiPortConfigurationService/Get",
+		"CIM_WiFiPort/RequestStateChange",
+	}, client.Requests)
+
+	corp := client.Messages[5]
+	assert.Contains(t, corp, "<q:AuthenticationMethod>7</q:AuthenticationMethod>")
+	assert.Contains(t, corp, "<q:Username>device</q:Username>")
+	assert.Contains(t, corp, "Intel(r) AMT Certificate: Handle: 1")
+	assert.Contains(t, corp, "Intel(r) AMT Certificate: Handle: 2")
+	assert.NotContains(t, corp, "PSKPassPhrase")
+
+	assert.Contains(t, client.Messages[6], "Intel(r) AMT:WiFi Endpoint Settings office")
+
+	office := client.Messages[7]
+	assert.Contains(t, office, "<q:AuthenticationMethod>6</q:AuthenticationMethod>")
+	assert.Contains(t, office, "<q:EncryptionMethod>4</q:EncryptionMethod>")
+	assert.Contains(t, office, "<q:PSKPassPhrase>office-passphrase</q:PSKPassPhrase>")
+	assert.NotContains(t, office, "IEEE8021xSettingsInput")
+
+	assert.Contains(t, client.Messages[8], "Intel(r) AMT:WiFi Endpoint Settings old")
+	assert.Contains(t, client.Messages[10], "<h:RequestedState>32769</h:RequestedState>")
+}
+
+func TestSyncWiFiProfilesOptions(t *testing.T) {
+	w, client, _ := newTestWorkflow(wifiResponses())
+
+	result, err := w.SyncWiFiProfiles(wifiProfiles()[:1], WiFiSyncOptions{ReplaceAll: true, KeepExtra: true})
+	require.NoError(t, err)
+	assert.Equal(t, WiFiSyncResult{Updated: []string{"home"}}, result)
+	assert.Equal(t, []string{
+		"CIM_WiFiEndpointSettings/Enumerate",
+		"CIM_WiFiEndpointSettings/Pull",
+		"CIM_WiFiEndpointSettings/Delete",
+		"AMT_WiFiPortConfigurationService/AddWiFiSettings",
+		"AMT_WiFiPortConfigurationService/Get",
+		"CIM_WiFiPort/RequestStateChange",
+	}, client.Requests)
+	assert.Contains(t, client.Messages[2], "Intel(r) AMT:WiFi Endpoint Settings home")
+}
+
+func TestSyncWiFiProfilesInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(profiles []config.WirelessProfile) []config.WirelessProfile
+		expected error
+	}{
+		{
+			"duplicate name",
+			func(profiles []config.WirelessProfile) []config.WirelessProfile {
+				return append(profiles, profiles[0])
+			},
+			ErrDuplicateProfile,
+		},
+		{
+			"unknown authentication method",
+			func(profiles []config.WirelessProfile) []config.WirelessProfile {
+				profiles[1].AuthenticationMethod = "WPA4"
+
+				return profiles
+			},
+			ErrUnknownAuthenticationMethod,
+		},
+		{
+			"unknown encryption method",
+			func(profiles []config.WirelessProfile) []config.WirelessProfile {
+				profiles[1].EncryptionMethod = "GCMP"
+
+				return profiles
+			},
+			ErrUnknownEncryptionMethod,
+		},
+		{
+			"missing 802.1X settings",
+			func(profiles []config.WirelessProfile) []config.WirelessProfile {
+				profiles[2].IEEE8021x = nil
+
+				return profiles
+			},
+			ErrIEEE8021xRequired,
+		},
+		{
+			"EAP-TLS without private key",
+			func(profiles []config.WirelessProfile) []config.WirelessProfile {
+				profiles[2].IEEE8021x.PrivateKey = ""
+
+				return profiles
+			},
+			ErrClientCertificateRequired,
+		},
+		{
+			"PEAP without password",
+			func(profiles []config.WirelessProfile) []config.WirelessProfile {
+				profiles[2].IEEE8021x.AuthenticationProtocol = 2
+
+				return profiles
+			},
+			ErrCredentialsRequired,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(wifiResponses())
+
+			_, err := w.SyncWiFiProfiles(test.modify(wifiProfiles()), WiFiSyncOptions{})
+			assert.ErrorIs(t, err, test.expected)
+			assert.Empty(t, client.Requests)
+		})
+	}
+
+	w, client, _ := newTestWorkflow(wifiResponses())
+	profiles := wifiProfiles()
+	profiles[0].Password = "short"
+
+	_, err := w.SyncWiFiProfiles(profiles, WiFiSyncOptions{})
+	assert.ErrorContains(t, err, "PSKPassPhrase")
+	assert.Empty(t, client.Requests)
+}
+
+func TestSyncWiFiProfilesAddFailure(t *testing.T) {
+	responses := wifiResponses()
+	responses["AMT_WiFiPortConfigurationService/AddWiFiSettings"] = []string{"workflow/wifi/addwifisettings-failure"}
+	w, client, _ := newTestWorkflow(responses)
+
+	result, err := w.SyncWiFiProfiles(wifiProfiles(), WiFiSyncOptions{})
+
+	var returnValueError *ReturnValueError
+
+	require.ErrorAs(t, err, &returnValueError)
+	assert.Equal(t, "AMT_WiFiPortConfigurationService.AddWiFiSettings", returnValueError.Operation)
+	assert.Equal(t, 1, returnValueError.ReturnValue)
+	assert.ErrorContains(t, err, "Wi-Fi profile corp")
+	assert.Equal(t, WiFiSyncResult{Unchanged: []string{"home"}}, result)
+	assert.NotContains(t, client.Requests, "CIM_WiFiEndpointSettings/Delete")
+	assert.NotContains(t, client.Requests, "CIM_WiFiPort/RequestStateChange")
+}
+
+func TestSyncWiFiProfilesError(t *testing.T) {
+	for _, failure := range []string{
+		"CIM_WiFiEndpointSettings/Pull",
+		"CIM_WiFiEndpointSettings/Delete",
+		"AMT_PublicKeyManagementService/AddKey",
+		"AMT_PublicKeyManagementService/AddCertificate",
+		"AMT_PublicKeyManagementService/AddTrustedRootCertificate",
+		"AMT_WiFiPortConfigurationService/AddWiFiSettings",
+		"AMT_WiFiPortConfigurationService/Get",
+		"CIM_WiFiPort/RequestStateChange",
+	} {
+		t.Run(failure, func(t *testing.T) {
+			w, client, _ := newTestWorkflow(wifiResponses())
+			client.Failures[failure] = errTransport
+
+			_, err := w.SyncWiFiProfiles(wifiProfiles(), WiFiSyncOptions{})
+			assert.ErrorIs(t, err, errTransport)
+		})
+	}
+}
+
+func TestEnableWiFiPortLocalSyncDisabled(t *testing.T) {
+	responses := wifiResponses()
+	responses["AMT_WiFiPortConfigurationService/Get"] = []string{"workflow/wifi/wifiportconfiguration-get-disabled"}
+	w, client, _ := newTestWorkflow(responses)
+
+	err := w.EnableWiFiPort()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"AMT_WiFiPortConfigurationService/Get",
+		"AMT_WiFiPortConfigurationService/Put",
+		"CIM_WiFiPort/RequestStateChange",
+	}, client.Requests)
+	assert.Contains(t, client.Messages[1], "<h:localProfileSynchronizationEnabled>3</h:localProfileSynchronizationEnabled>")
+}
+
+func TestAddCertificateDuplicate(t *testing.T) {
+	fixture, err := os.ReadFile("../wsman/wsmantesting/responses/workflow/inventory/certificate-pull.xml")
+	require.NoError(t, err)
+
+	certificates := regexp.MustCompile(`<h:X509Certificate>([^<]*)</h:X509Certificate>`).FindAllStringSubmatch(string(fixture), -1)
+	require.NotEmpty(t, certificates)
+
+	responses := wifiResponses()
+	responses["AMT_PublicKeyManagementService/AddTrustedRootCertificate"] = []string{"workflow/tls/addtrustedrootcertificate-duplicate"}
+	w, _, _ := newTestWorkflow(responses)
+
+	handle, err := w.addCertificate(certificates[0][1], true)
+	require.NoError(t, err)
+	assert.Equal(t, "Intel(r) AMT Certificate: Handle: 0", handle)
+
+	_, err = w.addCertificate("bm90IG9uIHRoZSBkZXZpY2U=", true)
+	assert.ErrorIs(t, err, ErrCertificateNotFound)
+}
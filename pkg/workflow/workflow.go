@@ -0,0 +1,124 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package workflow combines the WS-Man calls of common Intel® AMT configuration tasks into single operations.
+//
+// A workflow performs its calls in the order the firmware requires, waits for the device where a call completes asynchronously,
+// and undoes the changes it has already made when a later step fails.
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+const (
+	DefaultPollInterval = 5 * time.Second
+	DefaultTimeout      = 2 * time.Minute
+)
+
+// ErrTimeout is returned when the device does not reach the expected state within Workflow.Timeout.
+var ErrTimeout = errors.New("timed out waiting for the device")
+
+// Workflow runs configuration tasks against the device reached through its messages.
+type Workflow struct {
+	messages     wsman.Messages
+	PollInterval time.Duration // The time between two checks of the state of the device. A non-positive value falls back to DefaultPollInterval.
+	Timeout      time.Duration // The maximum time to wait for the device to reach the expected state.
+	sleep        func(time.Duration)
+	now          func() time.Time
+}
+
+// NewWorkflow returns a new instance of the Workflow struct, which waits for the device with DefaultPollInterval and DefaultTimeout.
+func NewWorkflow(messages wsman.Messages) Workflow {
+	return Workflow{
+		messages:     messages,
+		PollInterval: DefaultPollInterval,
+		Timeout:      DefaultTimeout,
+		sleep:        time.Sleep,
+		now:          time.Now,
+	}
+}
+
+// poll calls check every PollInterval until check reports that it is done or fails, and returns ErrTimeout once Timeout has elapsed.
+// The time spent in check counts towards Timeout.
+func (w Workflow) poll(check func() (done bool, err error)) error {
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	deadline := w.now().Add(w.Timeout)
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		remaining := deadline.Sub(w.now())
+		if remaining <= 0 {
+			return ErrTimeout
+		}
+
+		if remaining < interval {
+			w.sleep(remaining)
+		} else {
+			w.sleep(interval)
+		}
+	}
+}
+
+// ReturnValueError is returned when a method of the device completes with a PT_STATUS other than PT_STATUS_SUCCESS.
+type ReturnValueError struct {
+	Operation   string // The class and method that failed, such as AMT_PublicKeyManagementService.GenerateKeyPair.
+	ReturnValue int    // The PT_STATUS returned by the method.
+}
+
+func (e *ReturnValueError) Error() string {
+	return fmt.Sprintf("%s failed with %s (%d)", e.Operation, common.ConvertReturnValueToString(e.ReturnValue), e.ReturnValue)
+}
+
+// checkReturnValue returns a ReturnValueError unless returnValue is PT_STATUS_SUCCESS.
+func checkReturnValue(operation string, returnValue int) error {
+	if returnValue != 0 {
+		return &ReturnValueError{Operation: operation, ReturnValue: returnValue}
+	}
+
+	return nil
+}
+
+// rollback holds the steps that undo the changes made so far by a workflow.
+type rollback struct {
+	steps []func() error
+}
+
+// add registers a step that undoes the change just made.
+func (r *rollback) add(step func() error) {
+	r.steps = append(r.steps, step)
+}
+
+// fail runs the registered steps in reverse order and returns err together with the errors of the steps that failed.
+func (r *rollback) fail(err error) error {
+	errs := []error{err}
+
+	for i := len(r.steps) - 1; i >= 0; i-- {
+		if stepErr := r.steps[i](); stepErr != nil {
+			errs = append(errs, fmt.Errorf("rollback: %w", stepErr))
+		}
+	}
+
+	r.steps = nil
+
+	if len(errs) == 1 {
+		return err
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,164 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package workflow
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var errTransport = errors.New("connection reset")
+
+// newTestWorkflow returns a Workflow answered by a RoutingClient with the responses, which records the time it sleeps instead of sleeping.
+func newTestWorkflow(responses map[string][]string) (Workflow, *wsmantesting.RoutingClient, *time.Duration) {
+	client := &wsmantesting.RoutingClient{Responses: responses, Failures: map[string]error{}}
+	slept := new(time.Duration)
+
+	w := NewWorkflow(wsman.Messages{
+		Client: client,
+		AMT:    amt.NewMessages(client),
+		CIM:    cim.NewMessages(client),
+		IPS:    ips.NewMessages(client),
+	})
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	w.sleep = func(d time.Duration) { *slept += d }
+	w.now = func() time.Time { return start.Add(*slept) }
+
+	return w, client, slept
+}
+
+func TestPoll(t *testing.T) {
+	w, _, slept := newTestWorkflow(nil)
+
+	checks := 0
+	err := w.poll(func() (bool, error) {
+		checks++
+
+		return checks == 3, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, checks)
+	assert.Equal(t, 2*DefaultPollInterval, *slept)
+}
+
+func TestPollTimeout(t *testing.T) {
+	w, _, slept := newTestWorkflow(nil)
+	w.PollInterval = time.Second
+	w.Timeout = 3 * time.Second
+
+	checks := 0
+	err := w.poll(func() (bool, error) {
+		checks++
+
+		return false, nil
+	})
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, 4, checks)
+	assert.Equal(t, 3*time.Second, *slept)
+}
+
+func TestPollCountsCheckTime(t *testing.T) {
+	w, _, slept := newTestWorkflow(nil)
+	w.PollInterval = time.Second
+	w.Timeout = 3 * time.Second
+
+	checks := 0
+	err := w.poll(func() (bool, error) {
+		checks++
+		*slept += 2 * time.Second // advances the clock like a slow call to the device
+
+		return false, nil
+	})
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, 2, checks)
+}
+
+func TestPollPartialInterval(t *testing.T) {
+	w, _, slept := newTestWorkflow(nil)
+	w.PollInterval = 2 * time.Second
+	w.Timeout = 3 * time.Second
+
+	checks := 0
+	err := w.poll(func() (bool, error) {
+		checks++
+
+		return false, nil
+	})
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, 3, checks)
+	assert.Equal(t, 3*time.Second, *slept)
+}
+
+func TestPollDefaultInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		w, _, slept := newTestWorkflow(nil)
+		w.PollInterval = interval
+
+		checks := 0
+		err := w.poll(func() (bool, error) {
+			checks++
+
+			return checks == 2, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultPollInterval, *slept)
+	}
+}
+
+func TestPollError(t *testing.T) {
+	w, _, slept := newTestWorkflow(nil)
+
+	err := w.poll(func() (bool, error) {
+		return false, errTransport
+	})
+	assert.ErrorIs(t, err, errTransport)
+	assert.Equal(t, time.Duration(0), *slept)
+}
+
+func TestReturnValueError(t *testing.T) {
+	assert.NoError(t, checkReturnValue("AMT_SetupAndConfigurationService.CommitChanges", 0))
+
+	err := checkReturnValue("AMT_PublicKeyManagementService.GenerateKeyPair", 2061)
+	assert.Equal(t, &ReturnValueError{Operation: "AMT_PublicKeyManagementService.GenerateKeyPair", ReturnValue: 2061}, err)
+	assert.EqualError(t, err, "AMT_PublicKeyManagementService.GenerateKeyPair failed with PT_STATUS_PKI_GENERATING_KEYS (2061)")
+}
+
+func TestRollback(t *testing.T) {
+	errStep := errors.New("step failed")
+	errFirst := errors.New("first step failed")
+
+	var order []int
+
+	undo := &rollback{}
+	undo.add(func() error {
+		order = append(order, 1)
+
+		return errFirst
+	})
+	undo.add(func() error {
+		order = append(order, 2)
+
+		return nil
+	})
+
+	err := undo.fail(errStep)
+	assert.Equal(t, []int{2, 1}, order)
+	assert.ErrorIs(t, err, errStep)
+	assert.ErrorIs(t, err, errFirst)
+	assert.EqualError(t, err, "step failed\nrollback: first step failed")
+
+	assert.Equal(t, errStep, undo.fail(errStep))
+	assert.Equal(t, []int{2, 1}, order)
+}
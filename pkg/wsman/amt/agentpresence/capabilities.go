@@ -0,0 +1,83 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewCapabilitiesWithClient instantiates a new Capabilities.
+func NewCapabilitiesWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Capabilities {
+	return Capabilities{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTAgentPresenceCapabilities, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (capabilities Capabilities) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: capabilities.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = capabilities.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (capabilities Capabilities) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: capabilities.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = capabilities.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (capabilities Capabilities) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: capabilities.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = capabilities.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
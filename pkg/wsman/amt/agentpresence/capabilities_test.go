@@ -0,0 +1,213 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var agentPresenceCapabilities = CapabilitiesResponse{
+	XMLName:                     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceCapabilities), Local: AMTAgentPresenceCapabilities},
+	InstanceID:                  "Intel(r) AMT Agent Presence Capabilities",
+	ElementName:                 "Intel(r) AMT Agent Presence Capabilities",
+	MaxTotalAgents:              16,
+	MaxTotalActions:             64,
+	MinGuaranteedActionListSize: 4,
+}
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			CapabilitiesGetResponse: CapabilitiesResponse{
+				MaxTotalAgents: 16,
+			},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CapabilitiesGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"MaxTotalAgents\":16,\"MaxTotalActions\":0,\"MinGuaranteedActionListSize\":0},\"ServiceGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"EnabledState\":0},\"WatchdogGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"DeviceID\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"CurrentState\":0,\"TimeoutInterval\":0,\"StartupInterval\":0},\"WatchdogActionGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"OldState\":0,\"NewState\":0,\"EventOnTransition\":false,\"ActionSd\":{\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"Selectors\":null}}}},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CapabilitiesItems\":null,\"ServiceItems\":null,\"WatchdogItems\":null,\"WatchdogActionItems\":null},\"CreateResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"Selectors\":null}}},\"RegisterAgent_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"SessionSequenceNumber\":0,\"TimeoutInterval\":0,\"ReturnValue\":0},\"AssertPresence_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"AssertShutdown_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"AddAction_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Action\":{\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"Selectors\":null}}},\"ReturnValue\":0},\"DeleteAllActions_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			CapabilitiesGetResponse: CapabilitiesResponse{
+				MaxTotalAgents: 16,
+			},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ncapabilitiesgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    maxtotalagents: 16\n    maxtotalactions: 0\n    minguaranteedactionlistsize: 0\nservicegetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    enabledstate: 0\nwatchdoggetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    deviceid: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    currentstate: 0\n    timeoutinterval: 0\n    startupinterval: 0\nwatchdogactiongetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    oldstate: 0\n    newstate: 0\n    eventontransition: false\n    actionsd:\n        address: \"\"\n        referenceparameters:\n            resourceuri: \"\"\n            selectorset:\n                selectors: []\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    capabilitiesitems: []\n    serviceitems: []\n    watchdogitems: []\n    watchdogactionitems: []\ncreateresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    address: \"\"\n    referenceparameters:\n        resourceuri: \"\"\n        selectorset:\n            selectors: []\nregisteragent_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    sessionsequencenumber: 0\n    timeoutinterval: 0\n    returnvalue: 0\nassertpresence_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nassertshutdown_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\naddaction_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    action:\n        address: \"\"\n        referenceparameters:\n            resourceuri: \"\"\n            selectorset:\n                selectors: []\n    returnvalue: 0\ndeleteallactions_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveAMT_AgentPresenceCapabilities(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/capabilities",
+	}
+	elementUnderTest := NewCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AgentPresenceCapabilities Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_AgentPresenceCapabilities Get wsman message",
+				AMTAgentPresenceCapabilities,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                 xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CapabilitiesGetResponse: agentPresenceCapabilities,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_AgentPresenceCapabilities Enumerate wsman message",
+				AMTAgentPresenceCapabilities,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_AgentPresenceCapabilities Pull wsman message",
+				AMTAgentPresenceCapabilities,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						CapabilitiesItems: []CapabilitiesResponse{
+							agentPresenceCapabilities,
+						},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_AgentPresenceCapabilities(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/capabilities",
+	}
+	elementUnderTest := NewCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AgentPresenceCapabilities Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_AgentPresenceCapabilities Get wsman message fails",
+				AMTAgentPresenceCapabilities,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceCapabilities Enumerate wsman message fails",
+				AMTAgentPresenceCapabilities,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceCapabilities Pull wsman message fails",
+				AMTAgentPresenceCapabilities,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,114 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import "strings"
+
+const (
+	AMTAgentPresenceCapabilities   string = "AMT_AgentPresenceCapabilities"
+	AMTAgentPresenceService        string = "AMT_AgentPresenceService"
+	AMTAgentPresenceWatchdog       string = "AMT_AgentPresenceWatchdog"
+	AMTAgentPresenceWatchdogAction string = "AMT_AgentPresenceWatchdogAction"
+	AMTSystemDefensePolicy         string = "AMT_SystemDefensePolicy"
+	AddAction                      string = "AddAction"
+	AssertPresence                 string = "AssertPresence"
+	AssertShutdown                 string = "AssertShutdown"
+	DeleteAllActions               string = "DeleteAllActions"
+	RegisterAgent                  string = "RegisterAgent"
+	ValueNotFound                  string = "Value not found in map"
+)
+
+const (
+	StateNotStarted State = 1 << iota
+	StateStopped
+	StateRunning
+	StateExpired
+	StateSuspended
+)
+
+// stateToString is a map of State values to their string representation.
+var stateToString = map[State]string{
+	StateNotStarted: "NotStarted",
+	StateStopped:    "Stopped",
+	StateRunning:    "Running",
+	StateExpired:    "Expired",
+	StateSuspended:  "Suspended",
+}
+
+// String returns the string representation of the State value.
+// A combination of states, as used by the OldState and NewState of an action, is returned as the names of its states separated by "|".
+func (s State) String() string {
+	if value, exists := stateToString[s]; exists {
+		return value
+	}
+
+	states := s.States()
+	if len(states) == 0 {
+		return ValueNotFound
+	}
+
+	names := make([]string, len(states))
+	for i, state := range states {
+		names[i] = stateToString[state]
+	}
+
+	return strings.Join(names, "|")
+}
+
+// States splits a combination of states into the single states it holds.
+// It returns nil when the combination holds a bit that is not a known state.
+func (s State) States() []State {
+	var states []State
+
+	remaining := s
+
+	for state := StateNotStarted; state <= StateSuspended; state <<= 1 {
+		if s&state != 0 {
+			states = append(states, state)
+			remaining &^= state
+		}
+	}
+
+	if remaining != 0 {
+		return nil
+	}
+
+	return states
+}
+
+const (
+	PTStatusSuccess          ReturnValue = 0
+	PTStatusInternalError    ReturnValue = 1
+	PTStatusNotPermitted     ReturnValue = 16
+	PTStatusMaxLimitReached  ReturnValue = 23
+	PTStatusInvalidParameter ReturnValue = 36
+	PTStatusFlashWriteLimit  ReturnValue = 38
+	PTStatusInvalidHandle    ReturnValue = 2053
+	PTStatusDuplicate        ReturnValue = 2058
+	PTStatusNotFound         ReturnValue = 2068
+)
+
+// returnValueToString is a map of ReturnValue values to their string representation.
+var returnValueToString = map[ReturnValue]string{
+	PTStatusSuccess:          "Success",
+	PTStatusInternalError:    "InternalError",
+	PTStatusNotPermitted:     "NotPermitted",
+	PTStatusMaxLimitReached:  "MaxLimitReached",
+	PTStatusInvalidParameter: "InvalidParameter",
+	PTStatusFlashWriteLimit:  "FlashWriteLimitExceeded",
+	PTStatusInvalidHandle:    "InvalidHandle",
+	PTStatusDuplicate:        "Duplicate",
+	PTStatusNotFound:         "NotFound",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
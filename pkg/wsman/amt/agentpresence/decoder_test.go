@@ -0,0 +1,78 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestState_String(t *testing.T) {
+	tests := []struct {
+		state    State
+		expected string
+	}{
+		{StateNotStarted, "NotStarted"},
+		{StateStopped, "Stopped"},
+		{StateRunning, "Running"},
+		{StateExpired, "Expired"},
+		{StateSuspended, "Suspended"},
+		{StateStopped | StateExpired, "Stopped|Expired"},
+		{State(0), "Value not found in map"},
+		{State(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestState_States(t *testing.T) {
+	tests := []struct {
+		state    State
+		expected []State
+	}{
+		{StateRunning, []State{StateRunning}},
+		{StateNotStarted | StateRunning | StateSuspended, []State{StateNotStarted, StateRunning, StateSuspended}},
+		{State(0), nil},
+		{State(32), nil},
+	}
+
+	for _, test := range tests {
+		result := test.state.States()
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Expected %v, but got %v", test.expected, result)
+		}
+	}
+}
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{PTStatusSuccess, "Success"},
+		{PTStatusInternalError, "InternalError"},
+		{PTStatusNotPermitted, "NotPermitted"},
+		{PTStatusMaxLimitReached, "MaxLimitReached"},
+		{PTStatusInvalidParameter, "InvalidParameter"},
+		{PTStatusFlashWriteLimit, "FlashWriteLimitExceeded"},
+		{PTStatusInvalidHandle, "InvalidHandle"},
+		{PTStatusDuplicate, "Duplicate"},
+		{PTStatusNotFound, "NotFound"},
+		{ReturnValue(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Capabilities struct {
+	base message.Base
+}
+
+type Service struct {
+	base message.Base
+}
+
+type Watchdog struct {
+	base message.Base
+}
+
+type WatchdogAction struct {
+	base message.Base
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                   xml.Name `xml:"Body"`
+		CapabilitiesGetResponse   CapabilitiesResponse
+		ServiceGetResponse        ServiceResponse
+		WatchdogGetResponse       WatchdogResponse
+		WatchdogActionGetResponse WatchdogActionResponse
+		EnumerateResponse         common.EnumerateResponse
+		PullResponse              PullResponse
+		CreateResponse            CreateResponse
+		RegisterAgent_OUTPUT      RegisterAgent_OUTPUT    `xml:"RegisterAgent_OUTPUT"`
+		AssertPresence_OUTPUT     AssertPresence_OUTPUT   `xml:"AssertPresence_OUTPUT"`
+		AssertShutdown_OUTPUT     AssertShutdown_OUTPUT   `xml:"AssertShutdown_OUTPUT"`
+		AddAction_OUTPUT          AddAction_OUTPUT        `xml:"AddAction_OUTPUT"`
+		DeleteAllActions_OUTPUT   DeleteAllActions_OUTPUT `xml:"DeleteAllActions_OUTPUT"`
+	}
+	CapabilitiesResponse struct {
+		XMLName                     xml.Name `xml:"AMT_AgentPresenceCapabilities"`
+		InstanceID                  string   `xml:"InstanceID,omitempty"`        // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName                 string   `xml:"ElementName,omitempty"`       // A user-friendly name for the object.
+		MaxTotalAgents              int      `xml:"MaxTotalAgents"`              // The maximum number of agents that can be registered.
+		MaxTotalActions             int      `xml:"MaxTotalActions"`             // The maximum number of actions that can be defined across all the agents.
+		MinGuaranteedActionListSize int      `xml:"MinGuaranteedActionListSize"` // The number of actions that can be defined for each agent, whatever the actions of the other agents.
+	}
+	ServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_AgentPresenceService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int      `xml:"EnabledState"`                      // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+	}
+	WatchdogResponse struct {
+		XMLName                 xml.Name `xml:"AMT_AgentPresenceWatchdog"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		DeviceID                string   `xml:"DeviceID,omitempty"`                // The GUID of the agent, which identifies the watchdog.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		CurrentState            State    `xml:"CurrentState"`                      // The current state of the watchdog.
+		TimeoutInterval         int      `xml:"TimeoutInterval"`                   // The time, in seconds, the agent has to assert its presence before the watchdog expires.
+		StartupInterval         int      `xml:"StartupInterval"`                   // The time, in seconds, the agent has to register after the host starts before the watchdog expires.
+	}
+	WatchdogActionResponse struct {
+		XMLName                 xml.Name                  `xml:"AMT_AgentPresenceWatchdogAction"`
+		CreationClassName       string                    `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string                    `xml:"Name,omitempty"`                    // The label by which the action is known.
+		SystemCreationClassName string                    `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string                    `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string                    `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		OldState                State                     `xml:"OldState"`                          // The states the watchdog transitions from to trigger the action.
+		NewState                State                     `xml:"NewState"`                          // The states the watchdog transitions to to trigger the action.
+		EventOnTransition       bool                      `xml:"EventOnTransition"`                 // Indicates whether an event is generated on the transition.
+		ActionSd                EndpointReferenceResponse `xml:"ActionSd"`                          // The AMT_SystemDefensePolicy activated on the transition.
+	}
+	PullResponse struct {
+		XMLName             xml.Name                 `xml:"PullResponse"`
+		CapabilitiesItems   []CapabilitiesResponse   `xml:"Items>AMT_AgentPresenceCapabilities"`
+		ServiceItems        []ServiceResponse        `xml:"Items>AMT_AgentPresenceService"`
+		WatchdogItems       []WatchdogResponse       `xml:"Items>AMT_AgentPresenceWatchdog"`
+		WatchdogActionItems []WatchdogActionResponse `xml:"Items>AMT_AgentPresenceWatchdogAction"`
+	}
+	CreateResponse struct {
+		XMLName             xml.Name                    `xml:"ResourceCreated"`
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	EndpointReferenceResponse struct {
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	ReferenceParametersResponse struct {
+		ResourceURI string              `xml:"ResourceURI,omitempty"`
+		SelectorSet SelectorSetResponse `xml:"SelectorSet,omitempty"`
+	}
+	SelectorSetResponse struct {
+		Selectors []SelectorResponse `xml:"Selector,omitempty"`
+	}
+	SelectorResponse struct {
+		Name string `xml:"Name,attr"`
+		Text string `xml:",chardata"`
+	}
+	RegisterAgent_OUTPUT struct {
+		XMLName               xml.Name    `xml:"RegisterAgent_OUTPUT"`
+		SessionSequenceNumber int         `xml:"SessionSequenceNumber"` // The sequence number of the session. The agent increments it with each AssertPresence call.
+		TimeoutInterval       int         `xml:"TimeoutInterval"`       // The time, in seconds, the agent has to assert its presence before the watchdog expires.
+		ReturnValue           ReturnValue `xml:"ReturnValue"`
+	}
+	AssertPresence_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AssertPresence_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AssertShutdown_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AssertShutdown_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AddAction_OUTPUT struct {
+		XMLName     xml.Name                  `xml:"AddAction_OUTPUT"`
+		Action      EndpointReferenceResponse `xml:"Action"` // A reference to the new AMT_AgentPresenceWatchdogAction.
+		ReturnValue ReturnValue               `xml:"ReturnValue"`
+	}
+	DeleteAllActions_OUTPUT struct {
+		XMLName     xml.Name    `xml:"DeleteAllActions_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	WatchdogRequest struct {
+		XMLName         xml.Name `xml:"h:AMT_AgentPresenceWatchdog"`
+		H               string   `xml:"xmlns:h,attr"`
+		DeviceID        string   `xml:"h:DeviceID"`              // The GUID of the agent, which identifies the watchdog.
+		ElementName     string   `xml:"h:ElementName,omitempty"` // A user-friendly name for the object.
+		TimeoutInterval int      `xml:"h:TimeoutInterval"`       // The time, in seconds, the agent has to assert its presence before the watchdog expires.
+		StartupInterval int      `xml:"h:StartupInterval"`       // The time, in seconds, the agent has to register after the host starts before the watchdog expires.
+	}
+	AssertPresence_INPUT struct {
+		XMLName        xml.Name `xml:"h:AssertPresence_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SequenceNumber int      `xml:"h:SequenceNumber"` // The sequence number of the assertion, one more than the previous one of the session.
+	}
+	AssertShutdown_INPUT struct {
+		XMLName        xml.Name `xml:"h:AssertShutdown_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SequenceNumber int      `xml:"h:SequenceNumber"` // The sequence number of the assertion, one more than the previous one of the session.
+	}
+	AddAction_INPUT struct {
+		XMLName           xml.Name           `xml:"h:AddAction_INPUT"`
+		H                 string             `xml:"xmlns:h,attr"`
+		OldState          State              `xml:"h:OldState"`          // The states the watchdog transitions from to trigger the action.
+		NewState          State              `xml:"h:NewState"`          // The states the watchdog transitions to to trigger the action.
+		EventOnTransition bool               `xml:"h:EventOnTransition"` // Indicates whether an event is generated on the transition.
+		ActionSd          *EndpointReference `xml:"h:ActionSd"`          // The AMT_SystemDefensePolicy to activate on the transition, if any.
+	}
+	EndpointReference struct {
+		Address             string              `xml:"a:Address"`
+		ReferenceParameters ReferenceParameters `xml:"a:ReferenceParameters"`
+	}
+	ReferenceParameters struct {
+		ResourceURI string      `xml:"w:ResourceURI"`
+		SelectorSet SelectorSet `xml:"w:SelectorSet"`
+	}
+	SelectorSet struct {
+		Selectors []Selector `xml:"w:Selector"`
+	}
+	Selector struct {
+		Name string `xml:"Name,attr"`
+		Text string `xml:",chardata"`
+	}
+)
+
+// Property Types.
+type (
+	// The state of an agent presence watchdog. The states are bit flags, so the OldState and NewState of an action can combine several of them.
+	//
+	// ValueMap={1, 2, 4, 8, 16}
+	//
+	// Values={Not Started, Stopped, Running, Expired, Suspended}.
+	State int
+	// ReturnValue is an integer enumeration that indicates the success or failure of an operation.
+	ReturnValue int
+)
@@ -0,0 +1,216 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package agentpresence facilitates communication with Intel® AMT devices to monitor a software agent running on the host with a watchdog.
+//
+// Watchdog:
+// AMT_AgentPresenceWatchdog is created for an agent, identified by its GUID. Once the agent calls RegisterAgent,
+// it must call AssertPresence before the TimeoutInterval elapses, or the watchdog moves to the Expired state.
+// AssertShutdown stops the monitoring when the agent exits on purpose.
+//
+// Actions:
+// AMT_AgentPresenceWatchdogAction defines what Intel® AMT does when a watchdog moves between states,
+// such as generating an event or activating an AMT_SystemDefensePolicy. Actions are added with the AddAction method of the watchdog.
+//
+// Service and Capabilities:
+// AMT_AgentPresenceService manages the watchdogs, and AMT_AgentPresenceCapabilities reports how many agents and actions can be defined.
+package agentpresence
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewWatchdogWithClient instantiates a new Watchdog.
+func NewWatchdogWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Watchdog {
+	return Watchdog{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTAgentPresenceWatchdog, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (watchdog Watchdog) Get(deviceID string) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "DeviceID",
+		Value: deviceID,
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (watchdog Watchdog) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (watchdog Watchdog) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create adds a watchdog for the agent with the GUID given in the DeviceID of the request.
+func (watchdog Watchdog) Create(request WatchdogRequest) (response Response, err error) {
+	request.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Create(request, nil),
+		},
+	}
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete removes the watchdog of the agent with the given GUID, along with its actions.
+func (watchdog Watchdog) Delete(deviceID string) (response Response, err error) {
+	selector := message.Selector{Name: "DeviceID", Value: deviceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Delete(selector),
+		},
+	}
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// RegisterAgent starts a monitoring session for the agent with the given GUID and moves the watchdog to the Running state.
+// The response holds the SessionSequenceNumber the agent uses in its assertions and the TimeoutInterval within which it must assert its presence.
+func (watchdog Watchdog) RegisterAgent(deviceID string) (response Response, err error) {
+	return watchdog.invoke(deviceID, RegisterAgent, nil)
+}
+
+// AssertPresence tells Intel® AMT that the agent with the given GUID is still running, which restarts the timeout of the watchdog.
+// The sequence number is one more than the one of the previous assertion of the session, starting from the SessionSequenceNumber returned by RegisterAgent.
+func (watchdog Watchdog) AssertPresence(deviceID string, sequenceNumber int) (response Response, err error) {
+	return watchdog.invoke(deviceID, AssertPresence, &AssertPresence_INPUT{SequenceNumber: sequenceNumber})
+}
+
+// AssertShutdown tells Intel® AMT that the agent with the given GUID is stopping on purpose and moves the watchdog to the Stopped state.
+func (watchdog Watchdog) AssertShutdown(deviceID string, sequenceNumber int) (response Response, err error) {
+	return watchdog.invoke(deviceID, AssertShutdown, &AssertShutdown_INPUT{SequenceNumber: sequenceNumber})
+}
+
+// AddAction adds an action to the watchdog of the agent with the given GUID, which is taken when the watchdog moves from one of the old states to one of the new states.
+// The action generates an event when eventOnTransition is set, and activates the AMT_SystemDefensePolicy with the given InstanceID unless policyInstanceID is empty.
+func (watchdog Watchdog) AddAction(deviceID string, oldState, newState State, eventOnTransition bool, policyInstanceID string) (response Response, err error) {
+	input := AddAction_INPUT{
+		OldState:          oldState,
+		NewState:          newState,
+		EventOnTransition: eventOnTransition,
+	}
+
+	if policyInstanceID != "" {
+		input.ActionSd = &EndpointReference{
+			Address: "/wsman",
+			ReferenceParameters: ReferenceParameters{
+				ResourceURI: message.AMTSchema + AMTSystemDefensePolicy,
+				SelectorSet: SelectorSet{Selectors: []Selector{{Name: "InstanceID", Text: policyInstanceID}}},
+			},
+		}
+	}
+
+	return watchdog.invoke(deviceID, AddAction, &input)
+}
+
+// DeleteAllActions removes every action of the watchdog of the agent with the given GUID.
+func (watchdog Watchdog) DeleteAllActions(deviceID string) (response Response, err error) {
+	return watchdog.invoke(deviceID, DeleteAllActions, nil)
+}
+
+// invoke calls the method of the watchdog of the agent with the given GUID.
+func (watchdog Watchdog) invoke(deviceID, method string, input interface{}) (response Response, err error) {
+	selector := []message.Selector{{Name: "DeviceID", Value: deviceID}}
+	header := watchdog.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAgentPresenceWatchdog, method), AMTAgentPresenceWatchdog, selector, "", "")
+	body := watchdog.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(method), AMTAgentPresenceWatchdog, input)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
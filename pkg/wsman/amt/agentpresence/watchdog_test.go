@@ -0,0 +1,467 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	agentID          = "B7D8A2C0-1F2E-4D3C-9B8A-7F6E5D4C3B2A"
+	agentSelector    = "<w:SelectorSet><w:Selector Name=\"DeviceID\">B7D8A2C0-1F2E-4D3C-9B8A-7F6E5D4C3B2A</w:Selector></w:SelectorSet>"
+	watchdogBody     = `<h:AMT_AgentPresenceWatchdog xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:DeviceID>B7D8A2C0-1F2E-4D3C-9B8A-7F6E5D4C3B2A</h:DeviceID><h:ElementName>Endpoint Agent</h:ElementName><h:TimeoutInterval>120</h:TimeoutInterval><h:StartupInterval>300</h:StartupInterval></h:AMT_AgentPresenceWatchdog>`
+	registerBody     = `<h:RegisterAgent_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"></h:RegisterAgent_INPUT>`
+	assertPresBody   = `<h:AssertPresence_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:SequenceNumber>1025</h:SequenceNumber></h:AssertPresence_INPUT>`
+	assertShutBody   = `<h:AssertShutdown_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:SequenceNumber>1026</h:SequenceNumber></h:AssertShutdown_INPUT>`
+	addActionBody    = `<h:AddAction_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:OldState>4</h:OldState><h:NewState>8</h:NewState><h:EventOnTransition>true</h:EventOnTransition><h:ActionSd><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:Handle: 0</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ActionSd></h:AddAction_INPUT>`
+	addEventBody     = `<h:AddAction_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"><h:OldState>4</h:OldState><h:NewState>10</h:NewState><h:EventOnTransition>true</h:EventOnTransition></h:AddAction_INPUT>`
+	deleteActionBody = `<h:DeleteAllActions_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog"></h:DeleteAllActions_INPUT>`
+)
+
+var endpointAgent = WatchdogResponse{
+	XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog), Local: AMTAgentPresenceWatchdog},
+	CreationClassName:       AMTAgentPresenceWatchdog,
+	DeviceID:                agentID,
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+	ElementName:             "Endpoint Agent",
+	CurrentState:            StateRunning,
+	TimeoutInterval:         120,
+	StartupInterval:         300,
+}
+
+var watchdogRequest = WatchdogRequest{
+	DeviceID:        agentID,
+	ElementName:     "Endpoint Agent",
+	TimeoutInterval: 120,
+	StartupInterval: 300,
+}
+
+func TestPositiveAMT_AgentPresenceWatchdog(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdog",
+	}
+	elementUnderTest := NewWatchdogWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AgentPresenceWatchdog Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Get wsman message",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Get,
+				agentSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(agentID)
+				},
+				Body{
+					XMLName:             xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					WatchdogGetResponse: endpointAgent,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Enumerate wsman message",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Pull wsman message",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						WatchdogItems: []WatchdogResponse{
+							endpointAgent,
+							{
+								XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog), Local: AMTAgentPresenceWatchdog},
+								CreationClassName:       AMTAgentPresenceWatchdog,
+								DeviceID:                "0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0",
+								SystemCreationClassName: "CIM_ComputerSystem",
+								SystemName:              "Intel(r) AMT",
+								ElementName:             "Backup Agent",
+								CurrentState:            StateNotStarted,
+								TimeoutInterval:         60,
+								StartupInterval:         600,
+							},
+						},
+					},
+				},
+			},
+			// CREATES
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Create wsman message",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Create,
+				"",
+				watchdogBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(watchdogRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParametersResponse{
+							ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog),
+							SelectorSet: SelectorSetResponse{
+								Selectors: []SelectorResponse{
+									{Name: "CreationClassName", Text: AMTAgentPresenceWatchdog},
+									{Name: "DeviceID", Text: agentID},
+									{Name: "SystemCreationClassName", Text: "CIM_ComputerSystem"},
+									{Name: "SystemName", Text: "Intel(r) AMT"},
+								},
+							},
+						},
+					},
+				},
+			},
+			// DELETE
+			{
+				"should create a valid AMT_AgentPresenceWatchdog Delete wsman message",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Delete,
+				agentSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(agentID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+			// REGISTER AGENT
+			{
+				"should create a valid AMT_AgentPresenceWatchdog RegisterAgent wsman message",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, RegisterAgent),
+				agentSelector,
+				registerBody,
+				func() (Response, error) {
+					client.CurrentMessage = RegisterAgent
+
+					return elementUnderTest.RegisterAgent(agentID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RegisterAgent_OUTPUT: RegisterAgent_OUTPUT{
+						XMLName:               xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog), Local: "RegisterAgent_OUTPUT"},
+						SessionSequenceNumber: 1024,
+						TimeoutInterval:       120,
+						ReturnValue:           PTStatusSuccess,
+					},
+				},
+			},
+			// ASSERT PRESENCE
+			{
+				"should create a valid AMT_AgentPresenceWatchdog AssertPresence wsman message",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, AssertPresence),
+				agentSelector,
+				assertPresBody,
+				func() (Response, error) {
+					client.CurrentMessage = AssertPresence
+
+					return elementUnderTest.AssertPresence(agentID, 1025)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AssertPresence_OUTPUT: AssertPresence_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog), Local: "AssertPresence_OUTPUT"},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			// ASSERT SHUTDOWN
+			{
+				"should create a valid AMT_AgentPresenceWatchdog AssertShutdown wsman message",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, AssertShutdown),
+				agentSelector,
+				assertShutBody,
+				func() (Response, error) {
+					client.CurrentMessage = AssertShutdown
+
+					return elementUnderTest.AssertShutdown(agentID, 1026)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AssertShutdown_OUTPUT: AssertShutdown_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog), Local: "AssertShutdown_OUTPUT"},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			// ADD ACTION
+			{
+				"should create a valid AMT_AgentPresenceWatchdog AddAction wsman message",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, AddAction),
+				agentSelector,
+				addActionBody,
+				func() (Response, error) {
+					client.CurrentMessage = AddAction
+
+					return elementUnderTest.AddAction(agentID, StateRunning, StateExpired, true, "Intel(r) AMT:Handle: 0")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddAction_OUTPUT: AddAction_OUTPUT{
+						XMLName: xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog), Local: "AddAction_OUTPUT"},
+						Action: EndpointReferenceResponse{
+							Address: "/wsman",
+							ReferenceParameters: ReferenceParametersResponse{
+								ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdogAction),
+								SelectorSet: SelectorSetResponse{
+									Selectors: []SelectorResponse{{Name: "Name", Text: "Intel(r) AMT Agent Presence Watchdog Action 0"}},
+								},
+							},
+						},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AgentPresenceWatchdog AddAction wsman message without a policy",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, AddAction),
+				agentSelector,
+				addEventBody,
+				func() (Response, error) {
+					client.CurrentMessage = AddAction
+
+					return elementUnderTest.AddAction(agentID, StateRunning, StateStopped|StateExpired, true, "")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddAction_OUTPUT: AddAction_OUTPUT{
+						XMLName: xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog), Local: "AddAction_OUTPUT"},
+						Action: EndpointReferenceResponse{
+							Address: "/wsman",
+							ReferenceParameters: ReferenceParametersResponse{
+								ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdogAction),
+								SelectorSet: SelectorSetResponse{
+									Selectors: []SelectorResponse{{Name: "Name", Text: "Intel(r) AMT Agent Presence Watchdog Action 0"}},
+								},
+							},
+						},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			// DELETE ALL ACTIONS
+			{
+				"should create a valid AMT_AgentPresenceWatchdog DeleteAllActions wsman message",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, DeleteAllActions),
+				agentSelector,
+				deleteActionBody,
+				func() (Response, error) {
+					client.CurrentMessage = DeleteAllActions
+
+					return elementUnderTest.DeleteAllActions(agentID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					DeleteAllActions_OUTPUT: DeleteAllActions_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdog), Local: "DeleteAllActions_OUTPUT"},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_AgentPresenceWatchdog(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdog",
+	}
+	elementUnderTest := NewWatchdogWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AgentPresenceWatchdog Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Get wsman message fails",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Get,
+				agentSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(agentID)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Enumerate wsman message fails",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Pull wsman message fails",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Create wsman message fails",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Create,
+				"",
+				watchdogBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(watchdogRequest)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Delete wsman message fails",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Delete,
+				agentSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(agentID)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog RegisterAgent wsman message fails",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, RegisterAgent),
+				agentSelector,
+				registerBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.RegisterAgent(agentID)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog AssertPresence wsman message fails",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, AssertPresence),
+				agentSelector,
+				assertPresBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AssertPresence(agentID, 1025)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog AddAction wsman message fails",
+				AMTAgentPresenceWatchdog,
+				methods.GenerateAction(AMTAgentPresenceWatchdog, AddAction),
+				agentSelector,
+				addActionBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AddAction(agentID, StateRunning, StateExpired, true, "Intel(r) AMT:Handle: 0")
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
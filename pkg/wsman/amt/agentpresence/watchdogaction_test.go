@@ -0,0 +1,212 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const actionName = "Intel(r) AMT Agent Presence Watchdog Action 0"
+
+var expiredAction = WatchdogActionResponse{
+	XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdogAction), Local: AMTAgentPresenceWatchdogAction},
+	CreationClassName:       AMTAgentPresenceWatchdogAction,
+	Name:                    actionName,
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+	ElementName:             actionName,
+	OldState:                StateRunning,
+	NewState:                StateExpired,
+	EventOnTransition:       true,
+	ActionSd: EndpointReferenceResponse{
+		Address: "/wsman",
+		ReferenceParameters: ReferenceParametersResponse{
+			ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy),
+			SelectorSet: SelectorSetResponse{
+				Selectors: []SelectorResponse{{Name: "InstanceID", Text: "Intel(r) AMT:Handle: 0"}},
+			},
+		},
+	},
+}
+
+func TestPositiveAMT_AgentPresenceWatchdogAction(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdogaction",
+	}
+	elementUnderTest := NewWatchdogActionWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AgentPresenceWatchdogAction Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_AgentPresenceWatchdogAction Get wsman message",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT Agent Presence Watchdog Action 0</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(actionName)
+				},
+				Body{
+					XMLName:                   xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					WatchdogActionGetResponse: expiredAction,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_AgentPresenceWatchdogAction Enumerate wsman message",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_AgentPresenceWatchdogAction Pull wsman message",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						WatchdogActionItems: []WatchdogActionResponse{
+							expiredAction,
+							{
+								XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAgentPresenceWatchdogAction), Local: AMTAgentPresenceWatchdogAction},
+								CreationClassName:       AMTAgentPresenceWatchdogAction,
+								Name:                    "Intel(r) AMT Agent Presence Watchdog Action 1",
+								SystemCreationClassName: "CIM_ComputerSystem",
+								SystemName:              "Intel(r) AMT",
+								ElementName:             "Intel(r) AMT Agent Presence Watchdog Action 1",
+								OldState:                StateExpired,
+								NewState:                StateRunning,
+								EventOnTransition:       true,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_AgentPresenceWatchdogAction(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdogaction",
+	}
+	elementUnderTest := NewWatchdogActionWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AgentPresenceWatchdogAction Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_AgentPresenceWatchdogAction Get wsman message fails",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT Agent Presence Watchdog Action 0</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(actionName)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdogAction Enumerate wsman message fails",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdogAction Pull wsman message fails",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,192 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package assettable
+
+const (
+	AMTAssetTable        string = "AMT_AssetTable"
+	AMTAssetTableService string = "AMT_AssetTableService"
+	ValueNotFound        string = "Value not found in map"
+)
+
+const (
+	StructureTypeBIOS                StructureType = 0
+	StructureTypeSystem              StructureType = 1
+	StructureTypeBaseboard           StructureType = 2
+	StructureTypeChassis             StructureType = 3
+	StructureTypeProcessor           StructureType = 4
+	StructureTypeCache               StructureType = 7
+	StructureTypePortConnector       StructureType = 8
+	StructureTypeSystemSlots         StructureType = 9
+	StructureTypePhysicalMemoryArray StructureType = 16
+	StructureTypeMemoryDevice        StructureType = 17
+	StructureTypeEndOfTable          StructureType = 127
+)
+
+// structureTypeToString is a map of StructureType values to their string representations.
+var structureTypeToString = map[StructureType]string{
+	StructureTypeBIOS:                "BIOS",
+	StructureTypeSystem:              "System",
+	StructureTypeBaseboard:           "Baseboard",
+	StructureTypeChassis:             "Chassis",
+	StructureTypeProcessor:           "Processor",
+	StructureTypeCache:               "Cache",
+	StructureTypePortConnector:       "PortConnector",
+	StructureTypeSystemSlots:         "SystemSlots",
+	StructureTypePhysicalMemoryArray: "PhysicalMemoryArray",
+	StructureTypeMemoryDevice:        "MemoryDevice",
+	StructureTypeEndOfTable:          "EndOfTable",
+}
+
+// String returns a human-readable string representation of the StructureType enumeration.
+func (e StructureType) String() string {
+	if s, ok := structureTypeToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ProcessorTypeOther            ProcessorType = 1
+	ProcessorTypeUnknown          ProcessorType = 2
+	ProcessorTypeCentralProcessor ProcessorType = 3
+	ProcessorTypeMathProcessor    ProcessorType = 4
+	ProcessorTypeDSPProcessor     ProcessorType = 5
+	ProcessorTypeVideoProcessor   ProcessorType = 6
+)
+
+// processorTypeToString is a map of ProcessorType values to their string representations.
+var processorTypeToString = map[ProcessorType]string{
+	ProcessorTypeOther:            "Other",
+	ProcessorTypeUnknown:          "Unknown",
+	ProcessorTypeCentralProcessor: "CentralProcessor",
+	ProcessorTypeMathProcessor:    "MathProcessor",
+	ProcessorTypeDSPProcessor:     "DSPProcessor",
+	ProcessorTypeVideoProcessor:   "VideoProcessor",
+}
+
+// String returns a human-readable string representation of the ProcessorType enumeration.
+func (e ProcessorType) String() string {
+	if s, ok := processorTypeToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	MemoryFormFactorOther   MemoryFormFactor = 1
+	MemoryFormFactorUnknown MemoryFormFactor = 2
+	MemoryFormFactorSIMM    MemoryFormFactor = 3
+	MemoryFormFactorSIP     MemoryFormFactor = 4
+	MemoryFormFactorChip    MemoryFormFactor = 5
+	MemoryFormFactorDIP     MemoryFormFactor = 6
+	MemoryFormFactorZIP     MemoryFormFactor = 7
+	MemoryFormFactorCard    MemoryFormFactor = 8
+	MemoryFormFactorDIMM    MemoryFormFactor = 9
+	MemoryFormFactorTSOP    MemoryFormFactor = 10
+	MemoryFormFactorRIMM    MemoryFormFactor = 12
+	MemoryFormFactorSODIMM  MemoryFormFactor = 13
+	MemoryFormFactorFBDIMM  MemoryFormFactor = 15
+	MemoryFormFactorDie     MemoryFormFactor = 16
+)
+
+// memoryFormFactorToString is a map of MemoryFormFactor values to their string representations.
+var memoryFormFactorToString = map[MemoryFormFactor]string{
+	MemoryFormFactorOther:   "Other",
+	MemoryFormFactorUnknown: "Unknown",
+	MemoryFormFactorSIMM:    "SIMM",
+	MemoryFormFactorSIP:     "SIP",
+	MemoryFormFactorChip:    "Chip",
+	MemoryFormFactorDIP:     "DIP",
+	MemoryFormFactorZIP:     "ZIP",
+	MemoryFormFactorCard:    "Card",
+	MemoryFormFactorDIMM:    "DIMM",
+	MemoryFormFactorTSOP:    "TSOP",
+	MemoryFormFactorRIMM:    "RIMM",
+	MemoryFormFactorSODIMM:  "SODIMM",
+	MemoryFormFactorFBDIMM:  "FB-DIMM",
+	MemoryFormFactorDie:     "Die",
+}
+
+// String returns a human-readable string representation of the MemoryFormFactor enumeration.
+func (e MemoryFormFactor) String() string {
+	if s, ok := memoryFormFactorToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	MemoryTypeOther   MemoryType = 1
+	MemoryTypeUnknown MemoryType = 2
+	MemoryTypeDRAM    MemoryType = 3
+	MemoryTypeSDRAM   MemoryType = 15
+	MemoryTypeDDR     MemoryType = 18
+	MemoryTypeDDR2    MemoryType = 19
+	MemoryTypeDDR3    MemoryType = 24
+	MemoryTypeDDR4    MemoryType = 26
+	MemoryTypeLPDDR   MemoryType = 27
+	MemoryTypeLPDDR2  MemoryType = 28
+	MemoryTypeLPDDR3  MemoryType = 29
+	MemoryTypeLPDDR4  MemoryType = 30
+	MemoryTypeDDR5    MemoryType = 34
+	MemoryTypeLPDDR5  MemoryType = 35
+)
+
+// memoryTypeToString is a map of MemoryType values to their string representations.
+var memoryTypeToString = map[MemoryType]string{
+	MemoryTypeOther:   "Other",
+	MemoryTypeUnknown: "Unknown",
+	MemoryTypeDRAM:    "DRAM",
+	MemoryTypeSDRAM:   "SDRAM",
+	MemoryTypeDDR:     "DDR",
+	MemoryTypeDDR2:    "DDR2",
+	MemoryTypeDDR3:    "DDR3",
+	MemoryTypeDDR4:    "DDR4",
+	MemoryTypeLPDDR:   "LPDDR",
+	MemoryTypeLPDDR2:  "LPDDR2",
+	MemoryTypeLPDDR3:  "LPDDR3",
+	MemoryTypeLPDDR4:  "LPDDR4",
+	MemoryTypeDDR5:    "DDR5",
+	MemoryTypeLPDDR5:  "LPDDR5",
+}
+
+// String returns a human-readable string representation of the MemoryType enumeration.
+func (e MemoryType) String() string {
+	if s, ok := memoryTypeToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	SlotUsageOther       SlotUsage = 1
+	SlotUsageUnknown     SlotUsage = 2
+	SlotUsageAvailable   SlotUsage = 3
+	SlotUsageInUse       SlotUsage = 4
+	SlotUsageUnavailable SlotUsage = 5
+)
+
+// slotUsageToString is a map of SlotUsage values to their string representations.
+var slotUsageToString = map[SlotUsage]string{
+	SlotUsageOther:       "Other",
+	SlotUsageUnknown:     "Unknown",
+	SlotUsageAvailable:   "Available",
+	SlotUsageInUse:       "InUse",
+	SlotUsageUnavailable: "Unavailable",
+}
+
+// String returns a human-readable string representation of the SlotUsage enumeration.
+func (e SlotUsage) String() string {
+	if s, ok := slotUsageToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
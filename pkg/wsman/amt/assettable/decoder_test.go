@@ -0,0 +1,106 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package assettable
+
+import "testing"
+
+func TestStructureType_String(t *testing.T) {
+	tests := []struct {
+		state    StructureType
+		expected string
+	}{
+		{StructureTypeBIOS, "BIOS"},
+		{StructureTypeSystem, "System"},
+		{StructureTypeProcessor, "Processor"},
+		{StructureTypeSystemSlots, "SystemSlots"},
+		{StructureTypeMemoryDevice, "MemoryDevice"},
+		{StructureTypeEndOfTable, "EndOfTable"},
+		{StructureType(200), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestProcessorType_String(t *testing.T) {
+	tests := []struct {
+		state    ProcessorType
+		expected string
+	}{
+		{ProcessorTypeOther, "Other"},
+		{ProcessorTypeCentralProcessor, "CentralProcessor"},
+		{ProcessorTypeVideoProcessor, "VideoProcessor"},
+		{ProcessorType(99), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestMemoryFormFactor_String(t *testing.T) {
+	tests := []struct {
+		state    MemoryFormFactor
+		expected string
+	}{
+		{MemoryFormFactorDIMM, "DIMM"},
+		{MemoryFormFactorSODIMM, "SODIMM"},
+		{MemoryFormFactorFBDIMM, "FB-DIMM"},
+		{MemoryFormFactor(11), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestMemoryType_String(t *testing.T) {
+	tests := []struct {
+		state    MemoryType
+		expected string
+	}{
+		{MemoryTypeDDR3, "DDR3"},
+		{MemoryTypeDDR4, "DDR4"},
+		{MemoryTypeLPDDR4, "LPDDR4"},
+		{MemoryTypeDDR5, "DDR5"},
+		{MemoryType(99), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestSlotUsage_String(t *testing.T) {
+	tests := []struct {
+		state    SlotUsage
+		expected string
+	}{
+		{SlotUsageAvailable, "Available"},
+		{SlotUsageInUse, "InUse"},
+		{SlotUsage(0), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
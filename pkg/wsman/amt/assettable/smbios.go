@@ -0,0 +1,414 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package assettable
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrTruncatedStructure is returned for SMBIOS data that ends within a structure.
+var ErrTruncatedStructure = errors.New("truncated SMBIOS structure")
+
+const (
+	structureHeaderLength = 4
+	// extendedCount is the 8-bit core or thread count of a processor that defers to the 16-bit count.
+	extendedCount = 0xff
+	// processorFamily2 is the 8-bit family of a processor that defers to the 16-bit Processor Family 2.
+	processorFamily2 = 0xfe
+	// extendedMemorySize is the 16-bit size of a memory device that defers to the 32-bit Extended Size.
+	extendedMemorySize = 0x7fff
+	unknownMemorySize  = 0xffff
+	// memorySizeInKilobytes is the bit of the size of a memory device that selects kilobytes rather than megabytes.
+	memorySizeInKilobytes = 0x8000
+	memoryRankMask        = 0x0f
+	// cacheSizeIn64K and cacheSize2In64K are the bits of the sizes of a cache that select a granularity of 64 kilobytes rather than 1 kilobyte.
+	cacheSizeIn64K  = 0x8000
+	cacheSize2In64K = 0x80000000
+	cacheLevelMask  = 0x07
+	cacheEnabled    = 0x80
+	// biosExtendedROMSize is the 8-bit ROM size of a BIOS that defers to the 16-bit Extended BIOS ROM Size.
+	biosExtendedROMSize  = 0xff
+	biosROMSizeUnit      = 64
+	extendedROMSizeInGB  = 0x4000
+	extendedROMSizeMask  = 0x3fff
+	kilobytesPerMegabyte = 1024
+	kilobytesPerGigabyte = 1024 * 1024
+	uuidLength           = 16
+)
+
+// Decode decodes the SMBIOS structures held by the given asset tables.
+func Decode(tables []TableResponse) (SMBIOS, error) {
+	smbios := SMBIOS{}
+
+	for _, table := range tables {
+		data := make([]byte, len(table.TableData))
+		for i, b := range table.TableData {
+			data[i] = byte(b)
+		}
+
+		structures, err := ParseStructures(data)
+		if err != nil {
+			return smbios, fmt.Errorf("asset table %s: %w", table.InstanceID, err)
+		}
+
+		smbios.add(structures)
+	}
+
+	return smbios, nil
+}
+
+// Parse decodes a raw SMBIOS structure table, such as the one read from the system firmware.
+func Parse(data []byte) (SMBIOS, error) {
+	smbios := SMBIOS{}
+
+	structures, err := ParseStructures(data)
+	if err != nil {
+		return smbios, err
+	}
+
+	smbios.add(structures)
+
+	return smbios, nil
+}
+
+// ParseStructures splits raw SMBIOS data into its structures. It stops at the End-of-Table structure or at the end of the data.
+func ParseStructures(data []byte) ([]Structure, error) {
+	structures := []Structure{}
+
+	for offset := 0; offset < len(data); {
+		if len(data)-offset < structureHeaderLength {
+			return structures, ErrTruncatedStructure
+		}
+
+		length := int(data[offset+1])
+		if length < structureHeaderLength || offset+length > len(data) {
+			return structures, ErrTruncatedStructure
+		}
+
+		formatted := data[offset : offset+length]
+
+		terminator := bytes.Index(data[offset+length:], []byte{0, 0})
+		if terminator < 0 {
+			return structures, ErrTruncatedStructure
+		}
+
+		structure := Structure{
+			Type:      StructureType(formatted[0]),
+			Handle:    binary.LittleEndian.Uint16(formatted[2:]),
+			Formatted: formatted,
+		}
+
+		if area := data[offset+length : offset+length+terminator]; len(area) > 0 {
+			structure.Strings = strings.Split(string(area), "\x00")
+		}
+
+		offset += length + terminator + 2
+
+		if structure.Type == StructureTypeEndOfTable {
+			break
+		}
+
+		structures = append(structures, structure)
+	}
+
+	return structures, nil
+}
+
+// add decodes the given structures into the SMBIOS.
+func (smbios *SMBIOS) add(structures []Structure) {
+	for _, structure := range structures {
+		switch structure.Type {
+		case StructureTypeBIOS:
+			smbios.BIOS = append(smbios.BIOS, structure.bios())
+		case StructureTypeSystem:
+			smbios.Systems = append(smbios.Systems, structure.system())
+		case StructureTypeBaseboard:
+			smbios.Baseboards = append(smbios.Baseboards, structure.baseboard())
+		case StructureTypeProcessor:
+			smbios.Processors = append(smbios.Processors, structure.processor())
+		case StructureTypeCache:
+			smbios.Caches = append(smbios.Caches, structure.cache())
+		case StructureTypeSystemSlots:
+			smbios.Slots = append(smbios.Slots, structure.slot())
+		case StructureTypeMemoryDevice:
+			smbios.MemoryDevices = append(smbios.MemoryDevices, structure.memoryDevice())
+		default:
+			smbios.Other = append(smbios.Other, structure)
+		}
+	}
+}
+
+func (s Structure) bios() BIOSInformation {
+	bios := BIOSInformation{
+		Handle:                         s.Handle,
+		Vendor:                         s.str(0x04),
+		Version:                        s.str(0x05),
+		StartingAddressSegment:         s.word(0x06),
+		ReleaseDate:                    s.str(0x08),
+		ROMSize:                        (int(s.octet(0x09)) + 1) * biosROMSizeUnit,
+		Characteristics:                s.qword(0x0a),
+		SystemBIOSMajorRelease:         s.octet(0x14),
+		SystemBIOSMinorRelease:         s.octet(0x15),
+		EmbeddedControllerMajorRelease: s.octet(0x16),
+		EmbeddedControllerMinorRelease: s.octet(0x17),
+	}
+
+	if len(s.Formatted) >= 0x14 {
+		bios.CharacteristicsExtension = append([]byte{}, s.Formatted[0x12:0x14]...)
+	}
+
+	if s.octet(0x09) == biosExtendedROMSize {
+		size := int(s.word(0x18))
+		if size&extendedROMSizeInGB != 0 {
+			bios.ROMSize = (size & extendedROMSizeMask) * kilobytesPerGigabyte
+		} else {
+			bios.ROMSize = (size & extendedROMSizeMask) * kilobytesPerMegabyte
+		}
+	}
+
+	return bios
+}
+
+func (s Structure) system() SystemInformation {
+	return SystemInformation{
+		Handle:       s.Handle,
+		Manufacturer: s.str(0x04),
+		ProductName:  s.str(0x05),
+		Version:      s.str(0x06),
+		SerialNumber: s.str(0x07),
+		UUID:         s.uuid(0x08),
+		WakeUpType:   s.octet(0x18),
+		SKUNumber:    s.str(0x19),
+		Family:       s.str(0x1a),
+	}
+}
+
+func (s Structure) baseboard() BaseboardInformation {
+	return BaseboardInformation{
+		Handle:            s.Handle,
+		Manufacturer:      s.str(0x04),
+		Product:           s.str(0x05),
+		Version:           s.str(0x06),
+		SerialNumber:      s.str(0x07),
+		AssetTag:          s.str(0x08),
+		FeatureFlags:      s.octet(0x09),
+		LocationInChassis: s.str(0x0a),
+		ChassisHandle:     s.word(0x0b),
+		BoardType:         s.octet(0x0d),
+	}
+}
+
+func (s Structure) processor() ProcessorInformation {
+	processor := ProcessorInformation{
+		Handle:            s.Handle,
+		SocketDesignation: s.str(0x04),
+		Type:              ProcessorType(s.octet(0x05)),
+		Family:            int(s.octet(0x06)),
+		Manufacturer:      s.str(0x07),
+		ID:                s.qword(0x08),
+		Version:           s.str(0x10),
+		Voltage:           s.octet(0x11),
+		ExternalClock:     int(s.word(0x12)),
+		MaxSpeed:          int(s.word(0x14)),
+		CurrentSpeed:      int(s.word(0x16)),
+		Status:            s.octet(0x18),
+		Upgrade:           s.octet(0x19),
+		L1CacheHandle:     s.word(0x1a),
+		L2CacheHandle:     s.word(0x1c),
+		L3CacheHandle:     s.word(0x1e),
+		SerialNumber:      s.str(0x20),
+		AssetTag:          s.str(0x21),
+		PartNumber:        s.str(0x22),
+		CoreCount:         int(s.octet(0x23)),
+		CoreEnabled:       int(s.octet(0x24)),
+		ThreadCount:       int(s.octet(0x25)),
+		Characteristics:   s.word(0x26),
+	}
+
+	if processor.Family == processorFamily2 {
+		processor.Family = int(s.word(0x28))
+	}
+
+	if processor.CoreCount == extendedCount {
+		processor.CoreCount = int(s.word(0x2a))
+	}
+
+	if processor.CoreEnabled == extendedCount {
+		processor.CoreEnabled = int(s.word(0x2c))
+	}
+
+	if processor.ThreadCount == extendedCount {
+		processor.ThreadCount = int(s.word(0x2e))
+	}
+
+	return processor
+}
+
+func (s Structure) cache() CacheInformation {
+	configuration := s.word(0x05)
+
+	cache := CacheInformation{
+		Handle:              s.Handle,
+		SocketDesignation:   s.str(0x04),
+		Level:               int(configuration&cacheLevelMask) + 1,
+		Enabled:             configuration&cacheEnabled != 0,
+		Configuration:       configuration,
+		MaximumSize:         cacheSize(s.word(0x07)),
+		InstalledSize:       cacheSize(s.word(0x09)),
+		SupportedSRAMType:   s.word(0x0b),
+		CurrentSRAMType:     s.word(0x0d),
+		Speed:               int(s.octet(0x0f)),
+		ErrorCorrectionType: s.octet(0x10),
+		SystemCacheType:     s.octet(0x11),
+		Associativity:       s.octet(0x12),
+	}
+
+	// The 32-bit sizes of SMBIOS 3.1 take precedence, as the 16-bit sizes cannot hold caches of 2 gigabytes or more.
+	if len(s.Formatted) >= 0x1b {
+		cache.MaximumSize = cacheSize2(s.dword(0x13))
+		cache.InstalledSize = cacheSize2(s.dword(0x17))
+	}
+
+	return cache
+}
+
+func (s Structure) slot() SystemSlot {
+	return SystemSlot{
+		Handle:           s.Handle,
+		Designation:      s.str(0x04),
+		Type:             s.octet(0x05),
+		DataBusWidth:     s.octet(0x06),
+		CurrentUsage:     SlotUsage(s.octet(0x07)),
+		Length:           s.octet(0x08),
+		ID:               s.word(0x09),
+		Characteristics1: s.octet(0x0b),
+		Characteristics2: s.octet(0x0c),
+		SegmentGroup:     s.word(0x0d),
+		Bus:              s.octet(0x0f),
+		DeviceFunction:   s.octet(0x10),
+	}
+}
+
+func (s Structure) memoryDevice() MemoryDevice {
+	device := MemoryDevice{
+		Handle:                    s.Handle,
+		PhysicalMemoryArrayHandle: s.word(0x04),
+		ErrorInformationHandle:    s.word(0x06),
+		TotalWidth:                int(s.word(0x08)),
+		DataWidth:                 int(s.word(0x0a)),
+		FormFactor:                MemoryFormFactor(s.octet(0x0e)),
+		DeviceSet:                 s.octet(0x0f),
+		DeviceLocator:             s.str(0x10),
+		BankLocator:               s.str(0x11),
+		Type:                      MemoryType(s.octet(0x12)),
+		TypeDetail:                s.word(0x13),
+		Speed:                     int(s.word(0x15)),
+		Manufacturer:              s.str(0x17),
+		SerialNumber:              s.str(0x18),
+		AssetTag:                  s.str(0x19),
+		PartNumber:                s.str(0x1a),
+		Rank:                      int(s.octet(0x1b) & memoryRankMask),
+		ConfiguredSpeed:           int(s.word(0x20)),
+		MinimumVoltage:            int(s.word(0x22)),
+		MaximumVoltage:            int(s.word(0x24)),
+		ConfiguredVoltage:         int(s.word(0x26)),
+	}
+
+	switch size := s.word(0x0c); {
+	case size == unknownMemorySize:
+		device.Size = 0
+	case size == extendedMemorySize:
+		device.Size = int(s.dword(0x1c))
+	case size&memorySizeInKilobytes != 0:
+		device.Size = int(size&^memorySizeInKilobytes) / kilobytesPerMegabyte
+	default:
+		device.Size = int(size)
+	}
+
+	return device
+}
+
+// octet returns the byte at offset of the formatted area, or 0 when the structure is too short to hold it.
+func (s Structure) octet(offset int) uint8 {
+	if offset+1 > len(s.Formatted) {
+		return 0
+	}
+
+	return s.Formatted[offset]
+}
+
+func (s Structure) word(offset int) uint16 {
+	if offset+2 > len(s.Formatted) {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint16(s.Formatted[offset:])
+}
+
+func (s Structure) dword(offset int) uint32 {
+	if offset+4 > len(s.Formatted) {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint32(s.Formatted[offset:])
+}
+
+func (s Structure) qword(offset int) uint64 {
+	if offset+8 > len(s.Formatted) {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(s.Formatted[offset:])
+}
+
+// str returns the string referenced by the string number at offset of the formatted area, without its padding.
+// String numbers start from 1, and 0 references no string.
+func (s Structure) str(offset int) string {
+	index := int(s.octet(offset))
+	if index == 0 || index > len(s.Strings) {
+		return ""
+	}
+
+	return strings.TrimSpace(s.Strings[index-1])
+}
+
+// uuid returns the UUID at offset of the formatted area. Since SMBIOS 2.6, its first three fields are little-endian.
+func (s Structure) uuid(offset int) string {
+	if offset+uuidLength > len(s.Formatted) {
+		return ""
+	}
+
+	b := s.Formatted[offset : offset+uuidLength]
+
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(b[0:4]),
+		binary.LittleEndian.Uint16(b[4:6]),
+		binary.LittleEndian.Uint16(b[6:8]),
+		b[8:10],
+		b[10:16])
+}
+
+// cacheSize returns the size in kilobytes of a 16-bit cache size.
+func cacheSize(size uint16) int {
+	if size&cacheSizeIn64K != 0 {
+		return int(size&^cacheSizeIn64K) * 64
+	}
+
+	return int(size)
+}
+
+// cacheSize2 returns the size in kilobytes of a 32-bit cache size.
+func cacheSize2(size uint32) int {
+	if size&cacheSize2In64K != 0 {
+		return int(size&^cacheSize2In64K) * 64
+	}
+
+	return int(size)
+}
@@ -0,0 +1,232 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package assettable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const toBeFilled = "To Be Filled By O.E.M."
+
+func pullSMBIOS(t *testing.T, currentMessage string) SMBIOS {
+	t.Helper()
+
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/assettable/table",
+		CurrentMessage:   currentMessage,
+	}
+	table := NewTableWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	response, err := table.Pull(wsmantesting.EnumerationContext)
+	assert.NoError(t, err)
+
+	return response.Body.DecodedSMBIOS
+}
+
+func TestDecodeNUC(t *testing.T) {
+	smbios := pullSMBIOS(t, wsmantesting.CurrentMessagePull)
+
+	assert.Equal(t, SMBIOS{
+		BIOS: []BIOSInformation{{
+			Handle:                         0x0000,
+			Vendor:                         "Intel Corp.",
+			Version:                        "BNKBL357.86A.0062.2018.0222.1644",
+			StartingAddressSegment:         0xf000,
+			ReleaseDate:                    "02/22/2018",
+			ROMSize:                        16384,
+			Characteristics:                0x0b9bf8080,
+			CharacteristicsExtension:       []byte{0x03, 0x0d},
+			SystemBIOSMajorRelease:         5,
+			SystemBIOSMinorRelease:         6,
+			EmbeddedControllerMajorRelease: 255,
+			EmbeddedControllerMinorRelease: 255,
+		}},
+		Systems: []SystemInformation{nucSystem},
+		Baseboards: []BaseboardInformation{{
+			Handle:            0x0002,
+			Manufacturer:      "Intel Corporation",
+			Product:           "NUC7i5BNB",
+			Version:           "J31144-313",
+			SerialNumber:      "GEBN81100G2T",
+			FeatureFlags:      0x09,
+			LocationInChassis: "Default string",
+			ChassisHandle:     0x0003,
+			BoardType:         0x0a,
+		}},
+		Processors: []ProcessorInformation{{
+			Handle:            0x0035,
+			SocketDesignation: "U3E1",
+			Type:              ProcessorTypeCentralProcessor,
+			Family:            0xcd,
+			Manufacturer:      "Intel(R) Corporation",
+			ID:                0xbfebfbff000806e9,
+			Version:           "Intel(R) Core(TM) i5-7260U CPU @ 2.20GHz",
+			Voltage:           0x8b,
+			ExternalClock:     100,
+			MaxSpeed:          8300,
+			CurrentSpeed:      2200,
+			Status:            0x41,
+			Upgrade:           0x01,
+			L1CacheHandle:     0x0032,
+			L2CacheHandle:     0x0033,
+			L3CacheHandle:     0x0034,
+			SerialNumber:      toBeFilled,
+			AssetTag:          toBeFilled,
+			PartNumber:        toBeFilled,
+			CoreCount:         2,
+			CoreEnabled:       2,
+			ThreadCount:       4,
+			Characteristics:   0x00fc,
+		}},
+		Caches: []CacheInformation{
+			{Handle: 0x0032, SocketDesignation: "L1 Cache", Level: 1, Enabled: true, Configuration: 0x0180, MaximumSize: 128, InstalledSize: 128, SupportedSRAMType: 0x0020, CurrentSRAMType: 0x0020, ErrorCorrectionType: 4, SystemCacheType: 4, Associativity: 8},
+			{Handle: 0x0033, SocketDesignation: "L2 Cache", Level: 2, Enabled: true, Configuration: 0x0181, MaximumSize: 512, InstalledSize: 512, SupportedSRAMType: 0x0020, CurrentSRAMType: 0x0020, ErrorCorrectionType: 5, SystemCacheType: 5, Associativity: 7},
+			{Handle: 0x0034, SocketDesignation: "L3 Cache", Level: 3, Enabled: true, Configuration: 0x0182, MaximumSize: 4096, InstalledSize: 4096, SupportedSRAMType: 0x0020, CurrentSRAMType: 0x0020, ErrorCorrectionType: 6, SystemCacheType: 5, Associativity: 8},
+		},
+		Slots: []SystemSlot{{
+			Handle:           0x0024,
+			Designation:      "M.2 Socket 3 (Key M)",
+			Type:             0xa6,
+			DataBusWidth:     0x0d,
+			CurrentUsage:     SlotUsageInUse,
+			Length:           3,
+			ID:               3,
+			Characteristics1: 0x04,
+			Characteristics2: 0x01,
+			Bus:              0x3a,
+		}},
+		MemoryDevices: []MemoryDevice{
+			{
+				Handle:                    0x0030,
+				PhysicalMemoryArrayHandle: 0x002f,
+				ErrorInformationHandle:    0xfffe,
+				TotalWidth:                64,
+				DataWidth:                 64,
+				Size:                      8192,
+				FormFactor:                MemoryFormFactorSODIMM,
+				DeviceLocator:             "ChannelA-DIMM0",
+				BankLocator:               "BANK 0",
+				Type:                      MemoryTypeDDR4,
+				TypeDetail:                0x0080,
+				Speed:                     2133,
+				Manufacturer:              "Kingston",
+				SerialNumber:              "1D2C3B4A",
+				AssetTag:                  "9876543210",
+				PartNumber:                "KHX2133C13S4/8G",
+				Rank:                      1,
+				ConfiguredSpeed:           2133,
+				MinimumVoltage:            1200,
+				MaximumVoltage:            1200,
+				ConfiguredVoltage:         1200,
+			},
+			{
+				Handle:                    0x0031,
+				PhysicalMemoryArrayHandle: 0x002f,
+				ErrorInformationHandle:    0xfffe,
+				TotalWidth:                64,
+				DataWidth:                 64,
+				FormFactor:                MemoryFormFactorSODIMM,
+				DeviceLocator:             "ChannelB-DIMM0",
+				BankLocator:               "BANK 2",
+				Type:                      MemoryTypeUnknown,
+			},
+		},
+	}, smbios)
+}
+
+func TestDecodeOptiPlex(t *testing.T) {
+	smbios := pullSMBIOS(t, "PullOptiPlex")
+
+	assert.Len(t, smbios.BIOS, 1)
+	assert.Equal(t, "Dell Inc.", smbios.BIOS[0].Vendor)
+	assert.Equal(t, "1.14.0", smbios.BIOS[0].Version)
+	assert.Equal(t, 32768, smbios.BIOS[0].ROMSize)
+
+	assert.Len(t, smbios.Systems, 1)
+	assert.Equal(t, "OptiPlex 7090", smbios.Systems[0].ProductName)
+	assert.Equal(t, "", smbios.Systems[0].Version)
+	assert.Equal(t, "7XQ9KL3", smbios.Systems[0].SerialNumber)
+	assert.Equal(t, "4c4c4544-0058-5110-8039-b7c04f4b4c33", smbios.Systems[0].UUID)
+
+	assert.Len(t, smbios.Processors, 1)
+	assert.Equal(t, 0xc6, smbios.Processors[0].Family)
+	assert.Equal(t, 8, smbios.Processors[0].CoreCount)
+	assert.Equal(t, 16, smbios.Processors[0].ThreadCount)
+
+	assert.Len(t, smbios.Caches, 1)
+	assert.Equal(t, 3, smbios.Caches[0].Level)
+	assert.Equal(t, 16384, smbios.Caches[0].MaximumSize)
+	assert.Equal(t, 16384, smbios.Caches[0].InstalledSize)
+
+	assert.Len(t, smbios.MemoryDevices, 1)
+	assert.Equal(t, 32768, smbios.MemoryDevices[0].Size)
+	assert.Equal(t, MemoryFormFactorDIMM, smbios.MemoryDevices[0].FormFactor)
+	assert.Equal(t, "HMA82GU6DJR8N-XN", smbios.MemoryDevices[0].PartNumber)
+	assert.Equal(t, 2, smbios.MemoryDevices[0].Rank)
+
+	assert.Empty(t, smbios.Baseboards)
+	assert.Empty(t, smbios.Other)
+}
+
+func TestParse(t *testing.T) {
+	data := []byte{
+		// Processor Information with 0xFF core, enabled and thread counts, and their 16-bit counterparts.
+		4, 0x30, 0x00, 0x04, 1, 3, 0xfe, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0, 0, 0x01, 0x01, 0x80, 0x00, 0x80, 0x00, 0x00, 0x01,
+		'C', 'P', 'U', '0', 0, 0,
+		// Memory Device with the size in kilobytes.
+		17, 0x15, 0x01, 0x11, 0, 0, 0, 0, 0, 0, 0, 0, 0x00, 0x88, 0, 0, 0, 0, 0, 0, 0,
+		0, 0,
+		// Chassis, which is not decoded.
+		3, 0x05, 0x00, 0x03, 0x0a, 0, 0,
+		// End-of-Table, which ends the parsing.
+		127, 0x04, 0xff, 0xfe, 0, 0,
+		1, 0x04, 0x00, 0x01, 0, 0,
+	}
+
+	smbios, err := Parse(data)
+	assert.NoError(t, err)
+	assert.Len(t, smbios.Processors, 1)
+	assert.Equal(t, "CPU0", smbios.Processors[0].SocketDesignation)
+	assert.Equal(t, 0x101, smbios.Processors[0].Family)
+	assert.Equal(t, 128, smbios.Processors[0].CoreCount)
+	assert.Equal(t, 128, smbios.Processors[0].CoreEnabled)
+	assert.Equal(t, 256, smbios.Processors[0].ThreadCount)
+	assert.Len(t, smbios.MemoryDevices, 1)
+	assert.Equal(t, 2, smbios.MemoryDevices[0].Size)
+	assert.Len(t, smbios.Other, 1)
+	assert.Equal(t, StructureTypeChassis, smbios.Other[0].Type)
+	assert.Equal(t, uint16(0x0300), smbios.Other[0].Handle)
+	assert.Nil(t, smbios.Other[0].Strings)
+	assert.Empty(t, smbios.Systems)
+}
+
+func TestParseStructuresTruncated(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"header", []byte{1, 0x1b}},
+		{"formatted area", []byte{1, 0x1b, 0x01, 0x00, 1, 2}},
+		{"length shorter than the header", []byte{1, 0x02, 0x01, 0x00, 0, 0}},
+		{"strings", []byte{2, 0x04, 0x02, 0x00, 'a', 'b', 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseStructures(test.data)
+			assert.Equal(t, ErrTruncatedStructure, err)
+
+			_, err = Parse(test.data)
+			assert.Equal(t, ErrTruncatedStructure, err)
+		})
+	}
+}
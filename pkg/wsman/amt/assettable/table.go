@@ -0,0 +1,98 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package assettable facilitates communication with Intel® AMT devices to read the hardware asset tables of the platform.
+//
+// Table:
+// Represents a hardware asset table of the platform, which holds raw SMBIOS structures collected by the BIOS at boot. Get and Pull decode the structures of the returned tables into DecodedSMBIOS.
+//
+// Service:
+// Represents the service that collects the hardware asset tables, available in the HARDWARE_ASSET realm.
+package assettable
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewTableWithClient instantiates a new Table.
+func NewTableWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Table {
+	return Table{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTAssetTable, client),
+	}
+}
+
+// Get retrieves the representation of the instance with the given InstanceID and decodes its SMBIOS structures.
+func (table Table) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "InstanceID",
+		Value: instanceID,
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: table.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = table.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	response.Body.DecodedSMBIOS, err = Decode([]TableResponse{response.Body.TableGetResponse})
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (table Table) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: table.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = table.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class and decodes the SMBIOS structures of all of them. An enumeration context provided by the Enumerate call is used as input.
+func (table Table) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: table.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = table.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	response.Body.DecodedSMBIOS, err = Decode(response.Body.PullResponse.TableItems)
+
+	return
+}
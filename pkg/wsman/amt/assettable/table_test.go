@@ -0,0 +1,216 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package assettable
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const systemTableID = "Intel(r) AMT:Asset Table 1"
+
+var systemTable = TableResponse{
+	XMLName:       xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAssetTable), Local: AMTAssetTable},
+	InstanceID:    systemTableID,
+	ElementName:   "Intel(r) AMT Asset Table",
+	TableType:     StructureTypeSystem,
+	TableTypeInfo: "System",
+	TableData: []int{
+		1, 27, 1, 0, 1, 2, 3, 4, 164, 193, 210, 143, 62, 123, 42, 76, 157, 65, 148, 198, 145, 160, 178, 231, 6, 5, 6,
+		73, 110, 116, 101, 108, 32, 67, 111, 114, 112, 111, 114, 97, 116, 105, 111, 110, 0,
+		78, 85, 67, 55, 105, 53, 66, 78, 72, 0,
+		74, 51, 49, 49, 54, 57, 45, 51, 49, 48, 0,
+		71, 54, 66, 78, 56, 49, 50, 48, 48, 56, 72, 53, 0,
+		66, 79, 88, 78, 85, 67, 55, 105, 53, 66, 78, 72, 0,
+		73, 110, 116, 101, 108, 32, 78, 85, 67, 0, 0,
+	},
+}
+
+var nucSystem = SystemInformation{
+	Handle:       1,
+	Manufacturer: "Intel Corporation",
+	ProductName:  "NUC7i5BNH",
+	Version:      "J31169-310",
+	SerialNumber: "G6BN812008H5",
+	UUID:         "8fd2c1a4-7b3e-4c2a-9d41-94c691a0b2e7",
+	WakeUpType:   6,
+	SKUNumber:    "BOXNUC7i5BNH",
+	Family:       "Intel NUC",
+}
+
+func TestPositiveAMT_AssetTable(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/assettable/table",
+	}
+	elementUnderTest := NewTableWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AssetTable Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_AssetTable Get wsman message",
+				AMTAssetTable,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Asset Table 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(systemTableID)
+				},
+				Body{
+					XMLName:          xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					TableGetResponse: systemTable,
+					DecodedSMBIOS: SMBIOS{
+						Systems: []SystemInformation{nucSystem},
+					},
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_AssetTable Enumerate wsman message",
+				AMTAssetTable,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+
+	t.Run("should create a valid AMT_AssetTable Pull wsman message", func(t *testing.T) {
+		expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTAssetTable, wsmantesting.Pull, "", wsmantesting.PullBody)
+		messageID++
+		client.CurrentMessage = wsmantesting.CurrentMessagePull
+		response, err := elementUnderTest.Pull(wsmantesting.EnumerationContext)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedXMLInput, response.XMLInput)
+		assert.Len(t, response.Body.PullResponse.TableItems, 7)
+		assert.Equal(t, systemTable, response.Body.PullResponse.TableItems[1])
+		assert.Equal(t, []SystemInformation{nucSystem}, response.Body.DecodedSMBIOS.Systems)
+		assert.Len(t, response.Body.DecodedSMBIOS.Caches, 3)
+		assert.Len(t, response.Body.DecodedSMBIOS.MemoryDevices, 2)
+	})
+}
+
+func TestNegativeAMT_AssetTable(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/assettable/table",
+	}
+	elementUnderTest := NewTableWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AssetTable Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_AssetTable Get wsman message",
+				AMTAssetTable,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Asset Table 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(systemTableID)
+				},
+			},
+			{
+				"should handle error when AMT_AssetTable Enumerate wsman message",
+				AMTAssetTable,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_AssetTable Pull wsman message",
+				AMTAssetTable,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_AssetTable Pull returns a truncated table",
+				AMTAssetTable,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = "PullTruncated"
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,249 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package assettable
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Table struct {
+	base message.Base
+}
+
+type Service struct {
+	base message.Base
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName            xml.Name `xml:"Body"`
+		TableGetResponse   TableResponse
+		ServiceGetResponse ServiceResponse
+		EnumerateResponse  common.EnumerateResponse
+		PullResponse       PullResponse
+		DecodedSMBIOS      SMBIOS
+	}
+	TableResponse struct {
+		XMLName       xml.Name      `xml:"AMT_AssetTable"`
+		InstanceID    string        `xml:"InstanceID,omitempty"`    // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName   string        `xml:"ElementName,omitempty"`   // A user-friendly name for the object.
+		TableType     StructureType `xml:"TableType"`               // The SMBIOS structure type of the structures held in TableData.
+		TableTypeInfo string        `xml:"TableTypeInfo,omitempty"` // A description of the type of the table.
+		TableData     []int         `xml:"TableData"`               // The raw SMBIOS structures of the table, one byte per element.
+	}
+	ServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_AssetTableService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int      `xml:"EnabledState"`                      // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+	}
+	PullResponse struct {
+		XMLName      xml.Name          `xml:"PullResponse"`
+		TableItems   []TableResponse   `xml:"Items>AMT_AssetTable"`
+		ServiceItems []ServiceResponse `xml:"Items>AMT_AssetTableService"`
+	}
+)
+
+// SMBIOS Types.
+type (
+	// SMBIOS holds the structures decoded from one or more asset tables, by structure type.
+	SMBIOS struct {
+		BIOS          []BIOSInformation
+		Systems       []SystemInformation
+		Baseboards    []BaseboardInformation
+		Processors    []ProcessorInformation
+		Caches        []CacheInformation
+		Slots         []SystemSlot
+		MemoryDevices []MemoryDevice
+		Other         []Structure // Structures of the types not decoded above.
+	}
+
+	// Structure is a raw SMBIOS structure: the formatted area, including the header, and the strings that follow it.
+	Structure struct {
+		Type      StructureType
+		Handle    uint16
+		Formatted []byte
+		Strings   []string
+	}
+
+	// BIOSInformation is the SMBIOS BIOS Information (Type 0) structure.
+	BIOSInformation struct {
+		Handle                         uint16
+		Vendor                         string
+		Version                        string
+		StartingAddressSegment         uint16
+		ReleaseDate                    string
+		ROMSize                        int    // The size of the BIOS ROM, in kilobytes.
+		Characteristics                uint64 // Bit field of the functions supported by the BIOS.
+		CharacteristicsExtension       []byte
+		SystemBIOSMajorRelease         uint8
+		SystemBIOSMinorRelease         uint8
+		EmbeddedControllerMajorRelease uint8
+		EmbeddedControllerMinorRelease uint8
+	}
+
+	// SystemInformation is the SMBIOS System Information (Type 1) structure.
+	SystemInformation struct {
+		Handle       uint16
+		Manufacturer string
+		ProductName  string
+		Version      string
+		SerialNumber string
+		UUID         string // The UUID of the system, in its canonical text form.
+		WakeUpType   uint8
+		SKUNumber    string
+		Family       string
+	}
+
+	// BaseboardInformation is the SMBIOS Baseboard Information (Type 2) structure.
+	BaseboardInformation struct {
+		Handle            uint16
+		Manufacturer      string
+		Product           string
+		Version           string
+		SerialNumber      string
+		AssetTag          string
+		FeatureFlags      uint8
+		LocationInChassis string
+		ChassisHandle     uint16
+		BoardType         uint8
+	}
+
+	// ProcessorInformation is the SMBIOS Processor Information (Type 4) structure.
+	ProcessorInformation struct {
+		Handle            uint16
+		SocketDesignation string
+		Type              ProcessorType
+		Family            int // The processor family, taken from Processor Family 2 when the structure defers to it.
+		Manufacturer      string
+		ID                uint64
+		Version           string
+		Voltage           uint8
+		ExternalClock     int // The external clock frequency, in MHz.
+		MaxSpeed          int // The maximum speed supported by the system for the processor, in MHz.
+		CurrentSpeed      int // The speed of the processor at boot, in MHz.
+		Status            uint8
+		Upgrade           uint8
+		L1CacheHandle     uint16
+		L2CacheHandle     uint16
+		L3CacheHandle     uint16
+		SerialNumber      string
+		AssetTag          string
+		PartNumber        string
+		CoreCount         int
+		CoreEnabled       int
+		ThreadCount       int
+		Characteristics   uint16
+	}
+
+	// CacheInformation is the SMBIOS Cache Information (Type 7) structure.
+	CacheInformation struct {
+		Handle              uint16
+		SocketDesignation   string
+		Level               int  // The level of the cache, starting from 1.
+		Enabled             bool // Indicates whether the cache is enabled at boot.
+		Configuration       uint16
+		MaximumSize         int // The maximum size of the cache, in kilobytes.
+		InstalledSize       int // The installed size of the cache, in kilobytes.
+		SupportedSRAMType   uint16
+		CurrentSRAMType     uint16
+		Speed               int // The speed of the cache, in nanoseconds.
+		ErrorCorrectionType uint8
+		SystemCacheType     uint8
+		Associativity       uint8
+	}
+
+	// SystemSlot is the SMBIOS System Slots (Type 9) structure.
+	SystemSlot struct {
+		Handle           uint16
+		Designation      string
+		Type             uint8
+		DataBusWidth     uint8
+		CurrentUsage     SlotUsage
+		Length           uint8
+		ID               uint16
+		Characteristics1 uint8
+		Characteristics2 uint8
+		SegmentGroup     uint16
+		Bus              uint8
+		DeviceFunction   uint8
+	}
+
+	// MemoryDevice is the SMBIOS Memory Device (Type 17) structure.
+	MemoryDevice struct {
+		Handle                    uint16
+		PhysicalMemoryArrayHandle uint16
+		ErrorInformationHandle    uint16
+		TotalWidth                int // The total width of the device, including error correction bits, in bits.
+		DataWidth                 int // The data width of the device, in bits.
+		Size                      int // The size of the device, in megabytes. 0 indicates that no device is installed in the socket.
+		FormFactor                MemoryFormFactor
+		DeviceSet                 uint8
+		DeviceLocator             string
+		BankLocator               string
+		Type                      MemoryType
+		TypeDetail                uint16
+		Speed                     int // The maximum speed of the device, in MT/s.
+		Manufacturer              string
+		SerialNumber              string
+		AssetTag                  string
+		PartNumber                string
+		Rank                      int
+		ConfiguredSpeed           int // The configured speed of the device, in MT/s.
+		MinimumVoltage            int // In millivolts.
+		MaximumVoltage            int // In millivolts.
+		ConfiguredVoltage         int // In millivolts.
+	}
+)
+
+// Property Types.
+type (
+	// StructureType is the type of an SMBIOS structure, as defined by the DMTF System Management BIOS Reference Specification.
+	StructureType int
+
+	// ProcessorType is the type of the processor of a ProcessorInformation structure.
+	//
+	// ValueMap={1, 2, 3, 4, 5, 6}
+	//
+	// Values={Other, Unknown, Central Processor, Math Processor, DSP Processor, Video Processor}
+	ProcessorType int
+
+	// MemoryFormFactor is the implementation form factor of a MemoryDevice.
+	//
+	// ValueMap={1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 13, 15, 16}
+	//
+	// Values={Other, Unknown, SIMM, SIP, Chip, DIP, ZIP, Proprietary Card, DIMM, TSOP, RIMM, SODIMM, FB-DIMM, Die}
+	MemoryFormFactor int
+
+	// MemoryType is the type of a MemoryDevice.
+	//
+	// ValueMap={1, 2, 3, 15, 18, 19, 24, 26, 27, 28, 29, 30, 34, 35}
+	//
+	// Values={Other, Unknown, DRAM, SDRAM, DDR, DDR2, DDR3, DDR4, LPDDR, LPDDR2, LPDDR3, LPDDR4, DDR5, LPDDR5}
+	MemoryType int
+
+	// SlotUsage is the current usage of a SystemSlot.
+	//
+	// ValueMap={1, 2, 3, 4, 5}
+	//
+	// Values={Other, Unknown, Available, In use, Unavailable}
+	SlotUsage int
+)
@@ -149,6 +149,7 @@ func (r StoragePolicy) String() string {
 var provisioningMethodToString = map[int]string{
 	2: "Remote Configuration",
 	3: "Manual Provisioning via MEBx",
+	4: "Host-Based Provisioning Client Mode",
 	5: "Host-Based Provisioning Admin Mode",
 }
 
@@ -1330,6 +1331,11 @@ func readProvisioningCompletedEventData(data []byte) ProvisioningParameters {
 	return event
 }
 
+// String returns the provisioning parameters in the form shown in the extended data of an AMT Provisioning Completed record, one parameter per line.
+func (p ProvisioningParameters) String() string {
+	return strings.TrimPrefix(provisioningCompletedToString(&p), "\n")
+}
+
 func provisioningCompletedToString(provisioningCompleted *ProvisioningParameters) string {
 	s := fmt.Sprintf("\nProvisioning Method: %s", provisioningMethodToString[int(provisioningCompleted.ProvisioningMethod)])
 
@@ -264,3 +264,24 @@ func TestGetAuditLogExtendedDataString(t *testing.T) {
 		})
 	}
 }
+
+func TestProvisioningParameters_String(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters ProvisioningParameters
+		expected   string
+	}{
+		{"Manual", ProvisioningParameters{ProvisioningMethod: 3}, "Provisioning Method: Manual Provisioning via MEBx"},
+		{"Client Mode", ProvisioningParameters{ProvisioningMethod: 4}, "Provisioning Method: Host-Based Provisioning Client Mode"},
+		{"Admin Mode", ProvisioningParameters{ProvisioningMethod: 5, HashType: 2, TrustedRootCertHash: []byte{0xcb, 0x3c}, NumberOfCertificates: 1, CertSerialNumbers: []string{"0c8e"}, ProvServFQDNLength: 18, ProvServFQDN: "Intel.vprodemo.com"}, "Provisioning Method: Host-Based Provisioning Admin Mode\nHash Type: SHA 256\nTrusted Root Cert Hash: cb3c\nNumber of Certificates: 1\nCert Serial Numbers (first 3): [0c8e]\nProvisioning Server FQDN: Intel.vprodemo.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.parameters.String()
+			if result != test.expected {
+				t.Errorf("Expected %s, but got %s", test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package authorization
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/common"
+)
+
+// ErrDigestRealmRequired is returned when a digest password is given without the digest realm of the device to hash it against.
+var ErrDigestRealmRequired = errors.New("digest realm is required to hash the digest password")
+
+// DigestPassword returns the DigestPassword parameter of a digest user ACL entry.
+// Like client.AuthChallenge.HashCredentials it is the MD5 hash of username:digestRealm:password, here base64 encoded as Intel® AMT expects it.
+// digestRealm is the DigestRealm field of AMT_GeneralSettings.
+func DigestPassword(username, digestRealm, password string) string {
+	hash := md5.Sum([]byte(fmt.Sprintf("%s:%s:%s", username, digestRealm, password)))
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// KerberosUserSid returns the KerberosUserSid parameter of a Kerberos user ACL entry from a SID string such as S-1-5-21-1004336348-1177238915-682003330-512.
+func KerberosUserSid(sid string) (string, error) {
+	value, err := common.GetSidBytes(sid)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(value)), nil
+}
+
+// sidLength returns the length in bytes of the SID string sid.
+func sidLength(sid string) (int, error) {
+	value, err := common.GetSidBytes(sid)
+
+	return len(value), err
+}
+
+// credentials returns the hashed digest password and the encoded Kerberos SID of the entry, each empty when not set.
+func (entry UserAclEntry) credentials() (digestPassword, kerberosUserSid string, err error) {
+	if entry.DigestPassword != "" {
+		if entry.DigestRealm == "" {
+			return "", "", ErrDigestRealmRequired
+		}
+
+		digestPassword = DigestPassword(entry.DigestUsername, entry.DigestRealm, entry.DigestPassword)
+	}
+
+	if entry.KerberosUserSid != "" {
+		kerberosUserSid, err = KerberosUserSid(entry.KerberosUserSid)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return digestPassword, kerberosUserSid, nil
+}
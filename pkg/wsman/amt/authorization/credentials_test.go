@@ -0,0 +1,58 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package authorization
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestDigestPassword(t *testing.T) {
+	assert.Equal(t, "TIT2F99BQenmB94tswfoGA==", DigestPassword("test", "Digest:A3829B3827DE4D33D4449B366831B8C7", "P@ssw0rd"))
+	assert.NotEqual(t, DigestPassword("test", "Digest:A3829B3827DE4D33D4449B366831B8C7", "P@ssw0rd"), DigestPassword("test", "Digest:00000000000000000000000000000000", "P@ssw0rd"))
+}
+
+func TestKerberosUserSid(t *testing.T) {
+	sid := "S-1-5-21-1004336348-1177238915-682003330-512"
+
+	encoded, err := KerberosUserSid(sid)
+	assert.NoError(t, err)
+	assert.Equal(t, "AQUAAAAAAAUVAAAA3PTcO4M9K0aCi6YoAAIAAA==", encoded)
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, sid, common.GetSidString(string(decoded)))
+
+	for _, invalid := range []string{"", "S-1", "X-1-5-21", "S-1-5-domain", "S-1-5-4294967296"} {
+		_, err = KerberosUserSid(invalid)
+		assert.ErrorIs(t, err, common.ErrInvalidSid, invalid)
+	}
+}
+
+func TestUserAclEntryCredentials(t *testing.T) {
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/authorization",
+	}
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	_, err := elementUnderTest.AddUserAclEntryEx(UserAclEntry{DigestUsername: "test", DigestPassword: "P@ssw0rd", Realms: []RealmValues{RealmValuesRedirectionRealm}})
+	assert.ErrorIs(t, err, ErrDigestRealmRequired)
+
+	_, err = elementUnderTest.UpdateUserAclEntryEx(1, UserAclEntry{KerberosUserSid: "S-1-5-domain", Realms: []RealmValues{RealmValuesRedirectionRealm}})
+	assert.ErrorIs(t, err, common.ErrInvalidSid)
+
+	wsmanMessageCreator.ValidateRequests = true
+
+	_, err = elementUnderTest.AddUserAclEntryEx(UserAclEntry{Realms: []RealmValues{RealmValuesRedirectionRealm}})
+	assert.EqualError(t, err, "invalid request: AMT_AuthorizationService.UserAclEntry.DigestUsername or KerberosUserSid is required")
+}
@@ -29,6 +29,22 @@ const (
 	AccessPermissionLocalAndNetworkAccess
 )
 
+// accessPermissionToString is a map of AccessPermission values to their string representations.
+var accessPermissionToString = map[AccessPermission]string{
+	AccessPermissionLocalAccessOnly:       "LocalAccessOnly",
+	AccessPermissionNetworkAccessOnly:     "NetworkAccessOnly",
+	AccessPermissionLocalAndNetworkAccess: "LocalAndNetworkAccess",
+}
+
+// String returns the string representation of an AccessPermission value.
+func (a AccessPermission) String() string {
+	if value, exists := accessPermissionToString[a]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 const (
 	RealmValuesInvalidRealm RealmValues = iota
 	RealmValuesReservedRealm0
@@ -326,3 +326,91 @@ func (as Service) SetAdminAclEntryEx(username, digestPassword string) (response
 
 	return
 }
+
+// Adds a user entry to the Intel® AMT device. The entry is a digest user when entry.DigestUsername is set and a Kerberos user when entry.KerberosUserSid is set.
+// The plain text entry.DigestPassword is hashed against entry.DigestRealm before it is sent, see DigestPassword.
+func (as Service) AddUserAclEntryEx(entry UserAclEntry) (response Response, err error) {
+	err = as.base.Validate(entry)
+	if err != nil {
+		return
+	}
+
+	digestPassword, kerberosUserSid, err := entry.credentials()
+	if err != nil {
+		return
+	}
+
+	input := AddUserAclEntry{
+		DigestUsername:   entry.DigestUsername,
+		DigestPassword:   digestPassword,
+		KerberosUserSid:  kerberosUserSid,
+		AccessPermission: entry.AccessPermission,
+		Realms:           entry.Realms,
+	}
+
+	header := as.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, AddUserACLEntryEx), AMTAuthorizationService, nil, "", "")
+	body := as.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AddUserACLEntryEx), AMTAuthorizationService, &input)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: as.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = as.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Updates the user entry with the given handle in the Intel® AMT device. An empty entry.DigestPassword keeps the current password of a digest user.
+func (as Service) UpdateUserAclEntryEx(handle int, entry UserAclEntry) (response Response, err error) {
+	err = as.base.Validate(entry)
+	if err != nil {
+		return
+	}
+
+	digestPassword, kerberosUserSid, err := entry.credentials()
+	if err != nil {
+		return
+	}
+
+	input := UpdateUserAclEntry{
+		Handle:           handle,
+		DigestUsername:   entry.DigestUsername,
+		DigestPassword:   digestPassword,
+		KerberosUserSid:  kerberosUserSid,
+		AccessPermission: entry.AccessPermission,
+		Realms:           entry.Realms,
+	}
+
+	header := as.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuthorizationService, UpdateUserACLEntryEx), AMTAuthorizationService, nil, "", "")
+	body := as.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(UpdateUserACLEntryEx), AMTAuthorizationService, &input)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: as.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = as.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
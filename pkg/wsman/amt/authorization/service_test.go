@@ -22,7 +22,7 @@ func TestJson(t *testing.T) {
 			GetResponse: AuthorizationOccurrence{},
 		},
 	}
-	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AllowHttpQopAuthOnly\":0,\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"Name\":\"\",\"RequestedState\":0,\"SystemCreationClassName\":\"\",\"SystemName\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuthorizationOccurrenceItems\":null},\"SetAdminResponse\":{\"ReturnValue\":0}}"
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AllowHttpQopAuthOnly\":0,\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"Name\":\"\",\"RequestedState\":0,\"SystemCreationClassName\":\"\",\"SystemName\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuthorizationOccurrenceItems\":null},\"SetAdminResponse\":{\"ReturnValue\":0},\"AddUserResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Handle\":0,\"ReturnValue\":0},\"UpdateUserResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
 	result := response.JSON()
 	assert.Equal(t, expectedResult, result)
 }
@@ -33,7 +33,7 @@ func TestYaml(t *testing.T) {
 			GetResponse: AuthorizationOccurrence{},
 		},
 	}
-	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    allowhttpqopauthonly: 0\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    name: \"\"\n    requestedstate: 0\n    systemcreationclassname: \"\"\n    systemname: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    authorizationoccurrenceitems: []\nsetadminresponse:\n    returnvalue: 0\n"
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    allowhttpqopauthonly: 0\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    name: \"\"\n    requestedstate: 0\n    systemcreationclassname: \"\"\n    systemname: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    authorizationoccurrenceitems: []\nsetadminresponse:\n    returnvalue: 0\nadduserresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    handle: 0\n    returnvalue: 0\nupdateuserresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
 	result := response.YAML()
 	assert.Equal(t, expectedResult, result)
 }
@@ -132,18 +132,55 @@ func TestPositiveAMT_AuthorizationService(t *testing.T) {
 			},
 			// // AUTHORIZATION SERVICE
 
-			// // ADD USER ACL ENTRY EX
-			// // Verify with Matt - Typescript is referring to wrong realm values
-			// // {"should return a valid amt_AuthorizationService ADD_USER_ACL_ENTRY_EX wsman message using digest", AMT_AuthorizationService, ADD_USER_ACL_ENTRY_EX, logrus.Sprintf(`<h:AddUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:DigestUsername>%s</h:DigestUsername><h:DigestPassword>%s</h:DigestPassword><h:AccessPermission>%d</h:AccessPermission><h:Realms>%d</h:Realms></h:AddUserAclEntryEx_INPUT>`, "test", "P@ssw0rd", 2, 3), func() string {
-			// // 	return elementUnderTest.AddUserAclEntryEx(authorization.AccessPermissionLocalAndNetworkAccess, []authorization.RealmValues{authorization.RedirectionRealm}, "test", "P@ssw0rd", "")
-			// // }},
-			// // {"should return a valid amt_AuthorizationService ADD_USER_ACL_ENTRY_EX wsman message using kerberos", AMT_AuthorizationService, ADD_USER_ACL_ENTRY_EX, logrus.Sprintf(`<h:AddUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:KerberosUserSid>%d</h:KerberosUserSid><h:AccessPermission>%d</h:AccessPermission><h:Realms>%d3</h:Realms></h:AddUserAclEntryEx_INPUT>`, 64, 2, 3), func() string {
-			// // 	return elementUnderTest.AddUserAclEntryEx(authorization.AccessPermissionLocalAndNetworkAccess, []authorization.RealmValues{authorization.RedirectionRealm}, "", "", "64")
-			// // }},
-			// // // Check how to verify for exceptions
-			// // // {"should throw an error if the digestUsername is longer than 16 when calling AddUserAclEntryEx", "", "", "", func() string {
-			// // // 	return elementUnderTest.AddUserAclEntryEx(2, []models.RealmValues{models.RedirectionRealm}, "thisusernameistoolong", "test", "")
-			// // // }},
+			// ADD USER ACL ENTRY EX
+			{
+				"should return a valid amt_AuthorizationService AddUserAclEntryEx wsman message using digest",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/AddUserAclEntryEx`,
+				`<h:AddUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:DigestUsername>test</h:DigestUsername><h:DigestPassword>TIT2F99BQenmB94tswfoGA==</h:DigestPassword><h:AccessPermission>2</h:AccessPermission><h:Realms>2</h:Realms><h:Realms>5</h:Realms></h:AddUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "AddUserAclEntryEx"
+
+					return elementUnderTest.AddUserAclEntryEx(UserAclEntry{
+						DigestUsername:   "test",
+						DigestPassword:   "P@ssw0rd",
+						DigestRealm:      "Digest:A3829B3827DE4D33D4449B366831B8C7",
+						AccessPermission: AccessPermissionLocalAndNetworkAccess,
+						Realms:           []RealmValues{RealmValuesRedirectionRealm, RealmValuesRemoteControlRealm},
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddUserResponse: AddUserAclEntryEx_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService", Local: "AddUserAclEntryEx_OUTPUT"},
+						Handle:      3,
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			{
+				"should return a valid amt_AuthorizationService AddUserAclEntryEx wsman message using kerberos",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/AddUserAclEntryEx`,
+				`<h:AddUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:KerberosUserSid>AQUAAAAAAAUVAAAA3PTcO4M9K0aCi6YoAAIAAA==</h:KerberosUserSid><h:AccessPermission>1</h:AccessPermission><h:Realms>2</h:Realms></h:AddUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "AddUserAclEntryEx"
+
+					return elementUnderTest.AddUserAclEntryEx(UserAclEntry{
+						KerberosUserSid:  "S-1-5-21-1004336348-1177238915-682003330-512",
+						AccessPermission: AccessPermissionNetworkAccessOnly,
+						Realms:           []RealmValues{RealmValuesRedirectionRealm},
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddUserResponse: AddUserAclEntryEx_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService", Local: "AddUserAclEntryEx_OUTPUT"},
+						Handle:      3,
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
 			// // ENUMERATE USER ACL ENTRIES
 			// {"should return a valid amt_AuthorizationService EnumerateUserAclEntries wsman message when startIndex is undefined", AMT_AuthorizationService, `http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/EnumerateUserAclEntries`, logrus.Sprintf(`<h:EnumerateUserAclEntries_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:StartIndex>%d</h:StartIndex></h:EnumerateUserAclEntries_INPUT>`, 1), func() string {
 			// 	var index int
@@ -156,15 +193,75 @@ func TestPositiveAMT_AuthorizationService(t *testing.T) {
 			// {"should return a valid amt_AuthorizationService GetUserAclEntryEx wsman message", AMT_AuthorizationService, `http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/GetUserAclEntryEx`, `<h:GetUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>1</h:Handle></h:GetUserAclEntryEx_INPUT>`, func() string {
 			// 	return elementUnderTest.GetUserAclEntryEx(1)
 			// }},
-			// // UPDATE USER ACL ENTRY EX
-			// // {"should return a valid amt_AuthorizationService UpdateUserAclEntryEx wsman message using digest", AMT_AuthorizationService, `http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/UpdateUserAclEntryEx`, `<h:GetUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>1</h:Handle></h:GetUserAclEntryEx_INPUT>`, func() string {
-			// // 	return elementUnderTest.UpdateUserAclEntryEx(1, 2, []authorization.RealmValues{authorization.RedirectionRealm}, "test", "test123!", "")
-			// // }},
-			// // {"should return a valid amt_AuthorizationService UpdateUserAclEntryEx wsman message using kerberos", AMT_AuthorizationService, `http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/UpdateUserAclEntryEx`, `<h:UpdateUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>1</h:Handle><h:KerberosUserSid>64</h:KerberosUserSid><h:AccessPermission>2</h:AccessPermission><h:Realms>3</h:Realms></h:UpdateUserAclEntryEx_INPUT>`, func() string {
-			// // 	return elementUnderTest.UpdateUserAclEntryEx(1, 2, []authorization.RealmValues{authorization.RedirectionRealm}, "", "", "64")
-			// // }},
-			// // // should throw an error if digest or kerberos credentials are not provided to UpdateUserAclEntryEx
-			// // // should throw an error if the digestUsername is longer than 16 when calling UpdateUserAclEntryEx
+			// UPDATE USER ACL ENTRY EX
+			{
+				"should return a valid amt_AuthorizationService UpdateUserAclEntryEx wsman message using digest",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/UpdateUserAclEntryEx`,
+				`<h:UpdateUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>1</h:Handle><h:DigestUsername>test</h:DigestUsername><h:DigestPassword>TIT2F99BQenmB94tswfoGA==</h:DigestPassword><h:AccessPermission>2</h:AccessPermission><h:Realms>2</h:Realms></h:UpdateUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "UpdateUserAclEntryEx"
+
+					return elementUnderTest.UpdateUserAclEntryEx(1, UserAclEntry{
+						DigestUsername:   "test",
+						DigestPassword:   "P@ssw0rd",
+						DigestRealm:      "Digest:A3829B3827DE4D33D4449B366831B8C7",
+						AccessPermission: AccessPermissionLocalAndNetworkAccess,
+						Realms:           []RealmValues{RealmValuesRedirectionRealm},
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					UpdateUserResponse: UpdateUserAclEntryEx_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService", Local: "UpdateUserAclEntryEx_OUTPUT"},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			{
+				"should return a valid amt_AuthorizationService UpdateUserAclEntryEx wsman message keeping the digest password",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/UpdateUserAclEntryEx`,
+				`<h:UpdateUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>1</h:Handle><h:DigestUsername>test</h:DigestUsername><h:AccessPermission>0</h:AccessPermission><h:Realms>2</h:Realms><h:Realms>3</h:Realms></h:UpdateUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "UpdateUserAclEntryEx"
+
+					return elementUnderTest.UpdateUserAclEntryEx(1, UserAclEntry{
+						DigestUsername:   "test",
+						AccessPermission: AccessPermissionLocalAccessOnly,
+						Realms:           []RealmValues{RealmValuesRedirectionRealm, RealmValuesPTAdministrationRealm},
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					UpdateUserResponse: UpdateUserAclEntryEx_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService", Local: "UpdateUserAclEntryEx_OUTPUT"},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			{
+				"should return a valid amt_AuthorizationService UpdateUserAclEntryEx wsman message using kerberos",
+				AMTAuthorizationService,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/UpdateUserAclEntryEx`,
+				`<h:UpdateUserAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>1</h:Handle><h:KerberosUserSid>AQUAAAAAAAUVAAAA3PTcO4M9K0aCi6YoAAIAAA==</h:KerberosUserSid><h:AccessPermission>2</h:AccessPermission><h:Realms>2</h:Realms></h:UpdateUserAclEntryEx_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "UpdateUserAclEntryEx"
+
+					return elementUnderTest.UpdateUserAclEntryEx(1, UserAclEntry{
+						KerberosUserSid:  "S-1-5-21-1004336348-1177238915-682003330-512",
+						AccessPermission: AccessPermissionLocalAndNetworkAccess,
+						Realms:           []RealmValues{RealmValuesRedirectionRealm},
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					UpdateUserResponse: UpdateUserAclEntryEx_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService", Local: "UpdateUserAclEntryEx_OUTPUT"},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
 
 			// // REMOVE USER ACL ENTRY
 			// {"should return a valid amt_AuthorizationService RemoveUserAclEntry wsman message", AMT_AuthorizationService, `http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/RemoveUserAclEntry`, `<h:RemoveUserAclEntry_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Handle>1</h:Handle></h:RemoveUserAclEntry_INPUT>`, func() string {
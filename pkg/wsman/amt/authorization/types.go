@@ -32,10 +32,22 @@ type (
 		EnumerateResponse common.EnumerateResponse
 		PullResponse      PullResponse
 		SetAdminResponse  SetAdminAclEntryEx_OUTPUT
+
+		AddUserResponse    AddUserAclEntryEx_OUTPUT
+		UpdateUserResponse UpdateUserAclEntryEx_OUTPUT
 	}
 	SetAdminAclEntryEx_OUTPUT struct {
 		ReturnValue ReturnValue `xml:"ReturnValue"`
 	}
+	AddUserAclEntryEx_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AddUserAclEntryEx_OUTPUT"`
+		Handle      int         `xml:"Handle"` // Handle of the new ACL entry.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	UpdateUserAclEntryEx_OUTPUT struct {
+		XMLName     xml.Name    `xml:"UpdateUserAclEntryEx_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
 	AuthorizationOccurrence struct {
 		XMLName                 xml.Name       `xml:"AMT_AuthorizationService"`
 		AllowHttpQopAuthOnly    int            `xml:"AllowHttpQopAuthOnly"`    // Indicates whether using the http "quality of protection" (qop) directive with value auth is allowed
@@ -113,21 +125,32 @@ type (
 	AddUserAclEntry struct {
 		XMLName          xml.Name         `xml:"h:AddUserAclEntryEx_INPUT"`
 		H                string           `xml:"xmlns:h,attr"`
-		Handle           int              `xml:"h:Handle,omitempty"`              // Contains a creation handle.
-		DigestUsername   string           `xml:"h:DigestUsername"`                // Username for access control. Contains 7-bit ASCII characters. String length is limited to 16 characters. Username cannot be an empty string.
-		DigestPassword   string           `xml:"h:DigestPassword"`                // An MD5 Hash of these parameters concatenated together (Username + ":" + DigestRealm + ":" + Password). The DigestRealm is a field in AMT_GeneralSettings
-		AccessPermission AccessPermission `xml:"h:AccessPermission"`              // Indicates whether the User is allowed to access Intel® AMT from the Network or Local Interfaces. Note: this definition is restricted by the Default Interface Access Permissions of each Realm.
-		Realms           []RealmValues    `xml:"h:Realms>h:RealmValue,omitempty"` // Array of interface names the ACL entry is allowed to access.
-		KerberosUserSid  string           `xml:"h:KerberosUserSid"`               // Descriptor for user (SID) which is authenticated using the Kerberos Authentication. Byte array, specifying the Security Identifier (SID) according to the Kerberos specification. Current requirements imply that SID should be not smaller than 1 byte length and no longer than 28 bytes. SID length should also be a multiplicand of 4.
+		Handle           int              `xml:"h:Handle,omitempty"`          // Contains a creation handle.
+		DigestUsername   string           `xml:"h:DigestUsername,omitempty"`  // Username for access control. Contains 7-bit ASCII characters. String length is limited to 16 characters. Username cannot be an empty string.
+		DigestPassword   string           `xml:"h:DigestPassword,omitempty"`  // An MD5 Hash of these parameters concatenated together (Username + ":" + DigestRealm + ":" + Password). The DigestRealm is a field in AMT_GeneralSettings
+		KerberosUserSid  string           `xml:"h:KerberosUserSid,omitempty"` // Descriptor for user (SID) which is authenticated using the Kerberos Authentication. Byte array, specifying the Security Identifier (SID) according to the Kerberos specification. Current requirements imply that SID should be not smaller than 1 byte length and no longer than 28 bytes. SID length should also be a multiplicand of 4.
+		AccessPermission AccessPermission `xml:"h:AccessPermission"`          // Indicates whether the User is allowed to access Intel® AMT from the Network or Local Interfaces. Note: this definition is restricted by the Default Interface Access Permissions of each Realm.
+		Realms           []RealmValues    `xml:"h:Realms"`                    // Array of interface names the ACL entry is allowed to access.
 	}
 	UpdateUserAclEntry struct {
-		XMLName          xml.Name         `xml:"h:UpdateUserAclEntry_INPUT"`
+		XMLName          xml.Name         `xml:"h:UpdateUserAclEntryEx_INPUT"`
 		H                string           `xml:"xmlns:h,attr"`
-		Handle           int              `xml:"h:Handle,omitempty"`              // Contains a creation handle.
-		DigestUsername   string           `xml:"h:DigestUsername"`                // Username for access control. Contains 7-bit ASCII characters. String length is limited to 16 characters. Username cannot be an empty string.
-		DigestPassword   string           `xml:"h:DigestPassword"`                // An MD5 Hash of these parameters concatenated together (Username + ":" + DigestRealm + ":" + Password). The DigestRealm is a field in AMT_GeneralSettings
-		AccessPermission AccessPermission `xml:"h:AccessPermission"`              // Indicates whether the User is allowed to access Intel® AMT from the Network or Local Interfaces. Note: this definition is restricted by the Default Interface Access Permissions of each Realm.
-		Realms           []RealmValues    `xml:"h:Realms>h:RealmValue,omitempty"` // Array of interface names the ACL entry is allowed to access.
-		KerberosUserSid  string           `xml:"h:KerberosUserSid"`               // Descriptor for user (SID) which is authenticated using the Kerberos Authentication. Byte array, specifying the Security Identifier (SID) according to the Kerberos specification. Current requirements imply that SID should be not smaller than 1 byte length and no longer than 28 bytes. SID length should also be a multiplicand of 4.
+		Handle           int              `xml:"h:Handle"`                    // Specifies the ACL entry to update.
+		DigestUsername   string           `xml:"h:DigestUsername,omitempty"`  // Username for access control. Contains 7-bit ASCII characters. String length is limited to 16 characters. Username cannot be an empty string.
+		DigestPassword   string           `xml:"h:DigestPassword,omitempty"`  // An MD5 Hash of these parameters concatenated together (Username + ":" + DigestRealm + ":" + Password). The DigestRealm is a field in AMT_GeneralSettings
+		KerberosUserSid  string           `xml:"h:KerberosUserSid,omitempty"` // Descriptor for user (SID) which is authenticated using the Kerberos Authentication. Byte array, specifying the Security Identifier (SID) according to the Kerberos specification. Current requirements imply that SID should be not smaller than 1 byte length and no longer than 28 bytes. SID length should also be a multiplicand of 4.
+		AccessPermission AccessPermission `xml:"h:AccessPermission"`          // Indicates whether the User is allowed to access Intel® AMT from the Network or Local Interfaces. Note: this definition is restricted by the Default Interface Access Permissions of each Realm.
+		Realms           []RealmValues    `xml:"h:Realms"`                    // Array of interface names the ACL entry is allowed to access.
+	}
+
+	// UserAclEntry describes the user of an AddUserAclEntryEx or UpdateUserAclEntryEx call.
+	// Set DigestUsername, DigestPassword and DigestRealm for a digest user, or KerberosUserSid for a Kerberos user.
+	UserAclEntry struct {
+		DigestUsername   string           // Username of a digest user. Contains 7-bit ASCII characters and is limited to 16 characters.
+		DigestPassword   string           // Password of a digest user in plain text. It is hashed with DigestUsername and DigestRealm before it is sent. May be left empty in UpdateUserAclEntryEx to keep the current password.
+		DigestRealm      string           // Digest realm of the device, the DigestRealm field of AMT_GeneralSettings.
+		KerberosUserSid  string           // SID of a Kerberos user in string form, such as S-1-5-21-1004336348-1177238915-682003330-512.
+		AccessPermission AccessPermission // Indicates whether the user is allowed to access Intel® AMT from the local interface, the network interface or both.
+		Realms           []RealmValues    // Realms the user is allowed to access. amt.RealmValues converts the amt.Realms constants.
 	}
 )
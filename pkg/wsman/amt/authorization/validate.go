@@ -0,0 +1,43 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package authorization
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+
+const (
+	maxDigestUsernameLength = 16
+	maxKerberosUserSidBytes = 28
+)
+
+// Validate checks the entry against the constraints of AMT_AuthorizationService.AddUserAclEntryEx and UpdateUserAclEntryEx.
+func (entry UserAclEntry) Validate() error {
+	validation := common.NewValidation(AMTAuthorizationService + ".UserAclEntry")
+
+	digest := entry.DigestUsername != ""
+	kerberos := entry.KerberosUserSid != ""
+
+	validation.Check(digest || kerberos, "DigestUsername", "or KerberosUserSid is required")
+	validation.Check(!digest || !kerberos, "KerberosUserSid", "must not be set for a digest user")
+	validation.MaxLength("DigestUsername", entry.DigestUsername, maxDigestUsernameLength)
+	validation.ASCII("DigestUsername", entry.DigestUsername)
+	validation.Check(entry.DigestPassword == "" || digest, "DigestPassword", "applies only to a digest user")
+	validation.Check(entry.DigestPassword == "" || entry.DigestRealm != "", "DigestRealm", "is required to hash DigestPassword")
+
+	if kerberos {
+		length, err := sidLength(entry.KerberosUserSid)
+		validation.Check(err == nil, "KerberosUserSid", "must be a SID string such as S-1-5-21-...")
+		validation.Check(length <= maxKerberosUserSidBytes, "KerberosUserSid", "must not exceed 28 bytes")
+	}
+
+	common.ValueMap(validation, "AccessPermission", entry.AccessPermission, accessPermissionToString)
+	validation.Required("Realms", len(entry.Realms) > 0)
+
+	for _, realm := range entry.Realms {
+		validation.Check(realm > RealmValuesReservedRealm0 && realm <= RealmValuesLocalSystemRealm, "Realms", "contains an unsupported realm")
+	}
+
+	return validation.Err()
+}
@@ -0,0 +1,74 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserAclEntry_Validate(t *testing.T) {
+	const sid = "S-1-5-21-1004336348-1177238915-682003330-512"
+
+	tests := []struct {
+		name     string
+		modify   func(entry *UserAclEntry)
+		expected string
+	}{
+		{"digest user", func(entry *UserAclEntry) {}, ""},
+		{"digest user keeping the password", func(entry *UserAclEntry) { entry.DigestPassword = "" }, ""},
+		{"kerberos user", func(entry *UserAclEntry) {
+			entry.DigestUsername = ""
+			entry.DigestPassword = ""
+			entry.KerberosUserSid = sid
+		}, ""},
+		{"no user", func(entry *UserAclEntry) {
+			entry.DigestUsername = ""
+			entry.DigestPassword = ""
+		}, "DigestUsername or KerberosUserSid is required"},
+		{"digest and kerberos user", func(entry *UserAclEntry) { entry.KerberosUserSid = sid }, "KerberosUserSid must not be set for a digest user"},
+		{"DigestUsername too long", func(entry *UserAclEntry) { entry.DigestUsername = "username12345678" + "9" }, "DigestUsername must not exceed 16 characters"},
+		{"DigestUsername not ASCII", func(entry *UserAclEntry) { entry.DigestUsername = "bénédicte" }, "DigestUsername must contain only printable ASCII characters"},
+		{"DigestPassword without DigestRealm", func(entry *UserAclEntry) { entry.DigestRealm = "" }, "DigestRealm is required to hash DigestPassword"},
+		{"malformed KerberosUserSid", func(entry *UserAclEntry) {
+			entry.DigestUsername = ""
+			entry.DigestPassword = ""
+			entry.KerberosUserSid = "S-1-5-domain"
+		}, "KerberosUserSid must be a SID string such as S-1-5-21-..."},
+		{"KerberosUserSid too long", func(entry *UserAclEntry) {
+			entry.DigestUsername = ""
+			entry.DigestPassword = ""
+			entry.KerberosUserSid = sid + "-1"
+		}, "KerberosUserSid must not exceed 28 bytes"},
+		{"unknown AccessPermission", func(entry *UserAclEntry) { entry.AccessPermission = 3 }, "AccessPermission has unsupported value 3"},
+		{"no Realms", func(entry *UserAclEntry) { entry.Realms = nil }, "Realms is required"},
+		{"invalid realm", func(entry *UserAclEntry) { entry.Realms = []RealmValues{RealmValuesInvalidRealm} }, "Realms contains an unsupported realm"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			entry := UserAclEntry{
+				DigestUsername:   "test",
+				DigestPassword:   "P@ssw0rd",
+				DigestRealm:      "Digest:A3829B3827DE4D33D4449B366831B8C7",
+				AccessPermission: AccessPermissionLocalAndNetworkAccess,
+				Realms:           []RealmValues{RealmValuesRedirectionRealm},
+			}
+			test.modify(&entry)
+
+			err := entry.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: AMT_AuthorizationService.UserAclEntry."+test.expected)
+		})
+	}
+}
@@ -8,9 +8,11 @@ package boot
 import (
 	"encoding/xml"
 	"fmt"
+	"strings"
 
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
 )
 
 // Instantiates a new Boot Setting Data service.
@@ -91,12 +93,21 @@ func (settingData SettingData) Pull(enumerationContext string) (response Respons
 
 // Put will change properties of the selected instance.
 func (settingData SettingData) Put(bootSettingData BootSettingDataRequest) (response Response, err error) {
+	err = settingData.base.Validate(bootSettingData)
+	if err != nil {
+		return response, err
+	}
+
+	var biosLastStatus strings.Builder
+	for _, status := range bootSettingData.BIOSLastStatus {
+		fmt.Fprintf(&biosLastStatus, "<h:BIOSLastStatus>%d</h:BIOSLastStatus>", status)
+	}
+
 	header := settingData.base.WSManMessageCreator.CreateHeader(message.BaseActionsPut, AMTBootSettingData, nil, "", "")
 	body := fmt.Sprintf(
-		`<Body><h:AMT_BootSettingData xmlns:h="%sAMT_BootSettingData"><h:BIOSLastStatus>%d</h:BIOSLastStatus><h:BIOSLastStatus>%d</h:BIOSLastStatus><h:BIOSPause>%t</h:BIOSPause><h:BIOSSetup>%t</h:BIOSSetup><h:BootMediaIndex>%d</h:BootMediaIndex><h:BootguardStatus>%d</h:BootguardStatus><h:ConfigurationDataReset>%t</h:ConfigurationDataReset><h:ElementName>%s</h:ElementName><h:EnforceSecureBoot>%t</h:EnforceSecureBoot><h:FirmwareVerbosity>%d</h:FirmwareVerbosity><h:ForcedProgressEvents>%t</h:ForcedProgressEvents><h:IDERBootDevice>%d</h:IDERBootDevice><h:InstanceID>%s</h:InstanceID><h:LockKeyboard>%t</h:LockKeyboard><h:LockPowerButton>%t</h:LockPowerButton><h:LockResetButton>%t</h:LockResetButton><h:LockSleepButton>%t</h:LockSleepButton><h:OptionsCleared>%t</h:OptionsCleared><h:OwningEntity>%s</h:OwningEntity><h:PlatformErase>%t</h:PlatformErase><h:RPEEnabled>%t</h:RPEEnabled><h:RSEPassword>%s</h:RSEPassword><h:ReflashBIOS>%t</h:ReflashBIOS><h:SecureBootControlEnabled>%t</h:SecureBootControlEnabled><h:SecureErase>%t</h:SecureErase><h:UEFIHTTPSBootEnabled>%t</h:UEFIHTTPSBootEnabled><h:UEFILocalPBABootEnabled>%t</h:UEFILocalPBABootEnabled><h:UefiBootNumberOfParams>%d</h:UefiBootNumberOfParams><h:UseIDER>%t</h:UseIDER><h:UseSOL>%t</h:UseSOL><h:UseSafeMode>%t</h:UseSafeMode><h:UserPasswordBypass>%t</h:UserPasswordBypass><h:WinREBootEnabled>%t</h:WinREBootEnabled></h:AMT_BootSettingData></Body>`,
+		`<Body><h:AMT_BootSettingData xmlns:h="%sAMT_BootSettingData">%s<h:BIOSPause>%t</h:BIOSPause><h:BIOSSetup>%t</h:BIOSSetup><h:BootMediaIndex>%d</h:BootMediaIndex><h:BootguardStatus>%d</h:BootguardStatus><h:ConfigurationDataReset>%t</h:ConfigurationDataReset><h:ElementName>%s</h:ElementName><h:EnforceSecureBoot>%t</h:EnforceSecureBoot><h:FirmwareVerbosity>%d</h:FirmwareVerbosity><h:ForcedProgressEvents>%t</h:ForcedProgressEvents><h:IDERBootDevice>%d</h:IDERBootDevice><h:InstanceID>%s</h:InstanceID><h:LockKeyboard>%t</h:LockKeyboard><h:LockPowerButton>%t</h:LockPowerButton><h:LockResetButton>%t</h:LockResetButton><h:LockSleepButton>%t</h:LockSleepButton><h:OptionsCleared>%t</h:OptionsCleared><h:OwningEntity>%s</h:OwningEntity><h:PlatformErase>%t</h:PlatformErase><h:RPEEnabled>%t</h:RPEEnabled><h:RSEPassword>%s</h:RSEPassword><h:ReflashBIOS>%t</h:ReflashBIOS><h:SecureBootControlEnabled>%t</h:SecureBootControlEnabled><h:SecureErase>%t</h:SecureErase><h:UEFIHTTPSBootEnabled>%t</h:UEFIHTTPSBootEnabled><h:UEFILocalPBABootEnabled>%t</h:UEFILocalPBABootEnabled><h:UefiBootNumberOfParams>%d</h:UefiBootNumberOfParams><h:UseIDER>%t</h:UseIDER><h:UseSOL>%t</h:UseSOL><h:UseSafeMode>%t</h:UseSafeMode><h:UserPasswordBypass>%t</h:UserPasswordBypass><h:WinREBootEnabled>%t</h:WinREBootEnabled></h:AMT_BootSettingData></Body>`,
 		settingData.base.WSManMessageCreator.ResourceURIBase,
-		bootSettingData.BIOSLastStatus[0],
-		bootSettingData.BIOSLastStatus[1],
+		biosLastStatus.String(),
 		bootSettingData.BIOSPause,
 		bootSettingData.BIOSSetup,
 		bootSettingData.BootMediaIndex,
@@ -149,3 +160,49 @@ func (settingData SettingData) Put(bootSettingData BootSettingDataRequest) (resp
 
 	return response, err
 }
+
+// GetFragment retrieves a single property of the instance, such as "BootMediaIndex", using WS-Management fragment transfer.
+func (settingData SettingData) GetFragment(property string) (response common.FragmentResponse, err error) {
+	response = common.FragmentResponse{
+		Message: &client.Message{
+			XMLInput: settingData.base.GetFragment(property, nil),
+		},
+	}
+
+	// send the message to AMT
+	err = settingData.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
+
+// PutFragment changes a single property of the instance without resending the other properties, avoiding a read-modify-write race with other consoles.
+func (settingData SettingData) PutFragment(property string, value interface{}) (response common.FragmentResponse, err error) {
+	response = common.FragmentResponse{
+		Message: &client.Message{
+			XMLInput: settingData.base.PutFragment(property, value, nil),
+		},
+	}
+
+	// send the message to AMT
+	err = settingData.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
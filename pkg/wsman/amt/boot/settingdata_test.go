@@ -418,3 +418,54 @@ func TestNegativeAMT_BootSettingData(t *testing.T) {
 		}
 	})
 }
+
+func TestAMT_BootSettingDataFragments(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/boot/settingdata",
+	}
+	elementUnderTest := NewBootSettingDataWithClient(wsmanMessageCreator, &client)
+	fragmentHeader := `<w:FragmentTransfer xmlns:s="http://www.w3.org/2003/05/soap-envelope" s:mustUnderstand="true">BootMediaIndex</w:FragmentTransfer>`
+
+	tests := []struct {
+		name         string
+		action       string
+		body         string
+		responseFunc func() (common.FragmentResponse, error)
+	}{
+		{
+			"should create a valid AMT_BootSettingData fragment Get wsman message",
+			wsmantesting.Get,
+			"",
+			func() (common.FragmentResponse, error) {
+				client.CurrentMessage = "GetFragment"
+
+				return elementUnderTest.GetFragment("BootMediaIndex")
+			},
+		},
+		{
+			"should create a valid AMT_BootSettingData fragment Put wsman message",
+			wsmantesting.Put,
+			`<w:XmlFragment><h:BootMediaIndex xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_BootSettingData">1</h:BootMediaIndex></w:XmlFragment>`,
+			func() (common.FragmentResponse, error) {
+				client.CurrentMessage = "PutFragment"
+
+				return elementUnderTest.PutFragment("BootMediaIndex", 1)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, AMTBootSettingData, test.action, fragmentHeader, test.body)
+			messageID++
+			response, err := test.responseFunc()
+			assert.NoError(t, err)
+			assert.Equal(t, expectedXMLInput, response.XMLInput)
+			assert.Equal(t, "1", response.Body.XMLFragment.Value())
+			assert.Equal(t, []string{"1"}, response.Body.XMLFragment.Values())
+		})
+	}
+}
@@ -0,0 +1,23 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package boot
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+
+// Validate checks the request against the constraints of AMT_BootSettingData.
+func (request BootSettingDataRequest) Validate() error {
+	validation := common.NewValidation(AMTBootSettingData)
+
+	validation.Range("BootMediaIndex", request.BootMediaIndex, 0, 65535)
+	common.ValueMap(validation, "FirmwareVerbosity", request.FirmwareVerbosity, firmwareVerbosityToString)
+	common.ValueMap(validation, "IDERBootDevice", request.IDERBootDevice, iderBootDeviceToString)
+	validation.Check(request.IDERBootDevice == FloppyBoot || request.UseIDER, "IDERBootDevice", "applies only when UseIDER is set")
+	validation.MaxLength("RSEPassword", request.RSEPassword, 32)
+	validation.ASCII("RSEPassword", request.RSEPassword)
+	validation.Check(request.RSEPassword == "" || request.SecureErase, "RSEPassword", "applies only when SecureErase is set")
+
+	return validation.Err()
+}
@@ -0,0 +1,136 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package boot
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestBootSettingDataRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *BootSettingDataRequest)
+		expected string
+	}{
+		{"valid request", func(request *BootSettingDataRequest) {}, ""},
+		{"negative BootMediaIndex", func(request *BootSettingDataRequest) { request.BootMediaIndex = -1 }, "BootMediaIndex must be between 0 and 65535, got -1"},
+		{"unknown FirmwareVerbosity", func(request *BootSettingDataRequest) { request.FirmwareVerbosity = 4 }, "FirmwareVerbosity has unsupported value 4"},
+		{"unknown IDERBootDevice", func(request *BootSettingDataRequest) {
+			request.UseIDER = true
+			request.IDERBootDevice = 2
+		}, "IDERBootDevice has unsupported value 2"},
+		{"IDERBootDevice without UseIDER", func(request *BootSettingDataRequest) { request.IDERBootDevice = CDBoot }, "IDERBootDevice applies only when UseIDER is set"},
+		{"RSEPassword too long", func(request *BootSettingDataRequest) {
+			request.SecureErase = true
+			request.RSEPassword = strings.Repeat("a", 33)
+		}, "RSEPassword must not exceed 32 characters"},
+		{"RSEPassword not ASCII", func(request *BootSettingDataRequest) {
+			request.SecureErase = true
+			request.RSEPassword = "pässword"
+		}, "RSEPassword must contain only printable ASCII characters"},
+		{"RSEPassword without SecureErase", func(request *BootSettingDataRequest) { request.RSEPassword = "password" }, "RSEPassword applies only when SecureErase is set"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := boot_settings
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: AMT_BootSettingData."+test.expected)
+		})
+	}
+}
+
+func TestBootSettingDataPutValidation(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{PackageUnderTest: "amt/boot/settingdata", CurrentMessage: wsmantesting.CurrentMessagePut}
+	elementUnderTest := NewBootSettingDataWithClient(wsmanMessageCreator, &client)
+	request := boot_settings
+	request.FirmwareVerbosity = 4
+
+	t.Run("is skipped unless requested by the message creator", func(t *testing.T) {
+		_, err := elementUnderTest.Put(request)
+		assert.NoError(t, err)
+		messageID++
+	})
+
+	t.Run("rejects an invalid request before it is sent", func(t *testing.T) {
+		wsmanMessageCreator.ValidateRequests = true
+		response, err := elementUnderTest.Put(request)
+		assert.True(t, errors.Is(err, common.ErrInvalidRequest))
+		assert.Nil(t, response.Message)
+		assert.Equal(t, messageID, wsmanMessageCreator.MessageID)
+	})
+}
+
+func TestBootSettingDataGetPutRoundTrip(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	wsmanMessageCreator.ValidateRequests = true
+	client := wsmantesting.MockClient{PackageUnderTest: "amt/boot/settingdata", CurrentMessage: wsmantesting.CurrentMessageGet}
+	elementUnderTest := NewBootSettingDataWithClient(wsmanMessageCreator, &client)
+
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+
+	current := response.Body.BootSettingDataGetResponse
+	request := BootSettingDataRequest{
+		BIOSLastStatus:           current.BIOSLastStatus,
+		BIOSPause:                current.BIOSPause,
+		BIOSSetup:                current.BIOSSetup,
+		BootMediaIndex:           current.BootMediaIndex,
+		BootguardStatus:          current.BootguardStatus,
+		ConfigurationDataReset:   current.ConfigurationDataReset,
+		ElementName:              current.ElementName,
+		EnforceSecureBoot:        current.EnforceSecureBoot,
+		FirmwareVerbosity:        current.FirmwareVerbosity,
+		ForcedProgressEvents:     current.ForcedProgressEvents,
+		IDERBootDevice:           current.IDERBootDevice,
+		InstanceID:               current.InstanceID,
+		LockKeyboard:             current.LockKeyboard,
+		LockPowerButton:          current.LockPowerButton,
+		LockResetButton:          current.LockResetButton,
+		LockSleepButton:          current.LockSleepButton,
+		OptionsCleared:           current.OptionsCleared,
+		OwningEntity:             current.OwningEntity,
+		PlatformErase:            current.PlatformErase,
+		RPEEnabled:               current.RPEEnabled,
+		RSEPassword:              current.RSEPassword,
+		ReflashBIOS:              current.ReflashBIOS,
+		SecureBootControlEnabled: current.SecureBootControlEnabled,
+		SecureErase:              current.SecureErase,
+		UEFIHTTPSBootEnabled:     current.UEFIHTTPSBootEnabled,
+		UEFILocalPBABootEnabled:  current.UEFILocalPBABootEnabled,
+		UefiBootNumberOfParams:   current.UefiBootNumberOfParams,
+		UseIDER:                  current.UseIDER,
+		UseSOL:                   current.UseSOL,
+		UseSafeMode:              current.UseSafeMode,
+		UserPasswordBypass:       current.UserPasswordBypass,
+		WinREBootEnabled:         current.WinREBootEnabled,
+	}
+	request.BIOSSetup = true
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePut
+	_, err = elementUnderTest.Put(request)
+	assert.NoError(t, err)
+}
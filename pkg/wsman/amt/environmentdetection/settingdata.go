@@ -86,6 +86,11 @@ func (sd SettingData) Pull(enumerationContext string) (response Response, err er
 
 // Put will change properties of the selected instance.
 func (sd SettingData) Put(environmentDetectionSettingData EnvironmentDetectionSettingDataRequest) (response Response, err error) {
+	err = sd.base.Validate(environmentDetectionSettingData)
+	if err != nil {
+		return response, err
+	}
+
 	environmentDetectionSettingData.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTEnvironmentDetectionSettingData)
 	selector := []message.Selector{{
 		Name:  "InstanceID",
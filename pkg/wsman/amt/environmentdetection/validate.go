@@ -0,0 +1,32 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package environmentdetection
+
+import (
+	"net"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+// Validate checks the request against the constraints of AMT_EnvironmentDetectionSettingData.
+func (request EnvironmentDetectionSettingDataRequest) Validate() error {
+	validation := common.NewValidation(AMTEnvironmentDetectionSettingData)
+
+	validation.Required("ElementName", request.ElementName != "")
+	validation.Required("InstanceID", request.InstanceID != "")
+	common.ValueMap(validation, "DetectionAlgorithm", request.DetectionAlgorithm, detectionAlgorithmToString)
+
+	for _, detectionString := range request.DetectionStrings {
+		validation.Check(detectionString != "", "DetectionStrings", "must not contain empty strings")
+	}
+
+	for _, prefix := range request.DetectionIPv6LocalPrefixes {
+		ip, _, err := net.ParseCIDR(prefix)
+		validation.Check(err == nil && ip.To4() == nil, "DetectionIPv6LocalPrefixes", "must contain IPv6 prefixes in the XXXX:XXXX:XXXX:XXXX/Y format")
+	}
+
+	return validation.Err()
+}
@@ -0,0 +1,51 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package environmentdetection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentDetectionSettingDataRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *EnvironmentDetectionSettingDataRequest)
+		expected string
+	}{
+		{"valid request", func(request *EnvironmentDetectionSettingDataRequest) {}, ""},
+		{"missing ElementName", func(request *EnvironmentDetectionSettingDataRequest) { request.ElementName = "" }, "ElementName is required"},
+		{"unknown DetectionAlgorithm", func(request *EnvironmentDetectionSettingDataRequest) { request.DetectionAlgorithm = 2 }, "DetectionAlgorithm has unsupported value 2"},
+		{"empty DetectionStrings", func(request *EnvironmentDetectionSettingDataRequest) { request.DetectionStrings = []string{""} }, "DetectionStrings must not contain empty strings"},
+		{"IPv4 prefix", func(request *EnvironmentDetectionSettingDataRequest) {
+			request.DetectionIPv6LocalPrefixes = []string{"192.168.0.0/16"}
+		}, "DetectionIPv6LocalPrefixes must contain IPv6 prefixes in the XXXX:XXXX:XXXX:XXXX/Y format"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := EnvironmentDetectionSettingDataRequest{
+				ElementName:                "Intel(r) AMT Environment Detection Settings",
+				InstanceID:                 "Intel(r) AMT Environment Detection Settings",
+				DetectionAlgorithm:         LocalDomains,
+				DetectionStrings:           []string{"example.com"},
+				DetectionIPv6LocalPrefixes: []string{"fd00:1234::/64"},
+			}
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: AMT_EnvironmentDetectionSettingData."+test.expected)
+		})
+	}
+}
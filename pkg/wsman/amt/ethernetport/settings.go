@@ -92,6 +92,11 @@ func (s Settings) Pull(enumerationContext string) (response Response, err error)
 
 // Put will change properties of the selected instance.
 func (s Settings) Put(instanceID string, ethernetPortSettings SettingsRequest) (response Response, err error) {
+	err = s.base.Validate(ethernetPortSettings)
+	if err != nil {
+		return response, err
+	}
+
 	ethernetPortSettings.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTEthernetPortSettings)
 	selector := []message.Selector{{
 		Name:  "InstanceID",
@@ -0,0 +1,59 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package ethernetport
+
+import (
+	"net"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+const maxVLANTag = 4094
+
+// Validate checks the request against the constraints of AMT_EthernetPortSettings.
+func (request SettingsRequest) Validate() error {
+	validation := common.NewValidation(AMTEthernetPortSettings)
+
+	validation.Range("VLANTag", request.VLANTag, 0, maxVLANTag)
+
+	for _, linkPolicy := range request.LinkPolicy {
+		common.ValueMap(validation, "LinkPolicy", linkPolicy, linkPolicyToString)
+	}
+
+	if request.LinkPreference != 0 {
+		common.ValueMap(validation, "LinkPreference", request.LinkPreference, linkPreferenceToString)
+	}
+
+	if request.ConsoleTcpMaxRetransmissions != 0 {
+		validation.Range("ConsoleTcpMaxRetransmissions", int(request.ConsoleTcpMaxRetransmissions), int(ConsoleTCPMaxRetransmissions5), int(ConsoleTCPMaxRetransmissions7))
+	}
+
+	if !request.DHCPEnabled {
+		validation.Required("IPAddress", request.IPAddress != "")
+		validation.Required("SubnetMask", request.SubnetMask != "")
+	}
+
+	validation.Check(!request.SharedStaticIp || !request.DHCPEnabled, "SharedStaticIp", "applies only when DHCPEnabled is not set")
+
+	addresses := []struct {
+		property string
+		value    string
+	}{
+		{"IPAddress", request.IPAddress},
+		{"SubnetMask", request.SubnetMask},
+		{"DefaultGateway", request.DefaultGateway},
+		{"PrimaryDNS", request.PrimaryDNS},
+		{"SecondaryDNS", request.SecondaryDNS},
+	}
+
+	for _, address := range addresses {
+		if address.value != "" {
+			validation.Check(net.ParseIP(address.value).To4() != nil, address.property, "must be an IPv4 address")
+		}
+	}
+
+	return validation.Err()
+}
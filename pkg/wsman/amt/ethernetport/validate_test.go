@@ -0,0 +1,63 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package ethernetport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettingsRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *SettingsRequest)
+		expected string
+	}{
+		{"valid request", func(request *SettingsRequest) {}, ""},
+		{"static addressing", func(request *SettingsRequest) {
+			request.DHCPEnabled = false
+			request.SharedStaticIp = true
+			request.IPAddress = "192.168.1.10"
+			request.SubnetMask = "255.255.255.0"
+			request.DefaultGateway = "192.168.1.1"
+			request.PrimaryDNS = "192.168.1.1"
+		}, ""},
+		{"invalid VLANTag", func(request *SettingsRequest) { request.VLANTag = 4095 }, "VLANTag must be between 0 and 4094, got 4095"},
+		{"unknown LinkPolicy", func(request *SettingsRequest) { request.LinkPolicy = []LinkPolicy{LinkPolicyS0AC, 2} }, "LinkPolicy has unsupported value 2"},
+		{"unknown LinkPreference", func(request *SettingsRequest) { request.LinkPreference = 3 }, "LinkPreference has unsupported value 3"},
+		{"invalid ConsoleTcpMaxRetransmissions", func(request *SettingsRequest) { request.ConsoleTcpMaxRetransmissions = 8 }, "ConsoleTcpMaxRetransmissions must be between 5 and 7, got 8"},
+		{"static addressing without IPAddress", func(request *SettingsRequest) {
+			request.DHCPEnabled = false
+			request.SubnetMask = "255.255.255.0"
+		}, "IPAddress is required"},
+		{"SharedStaticIp with DHCP", func(request *SettingsRequest) { request.SharedStaticIp = true }, "SharedStaticIp applies only when DHCPEnabled is not set"},
+		{"invalid DefaultGateway", func(request *SettingsRequest) { request.DefaultGateway = "gateway" }, "DefaultGateway must be an IPv4 address"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := SettingsRequest{
+				ElementName:   "Intel(r) AMT Ethernet Port Settings",
+				InstanceID:    "Intel(r) AMT Ethernet Port Settings 0",
+				DHCPEnabled:   true,
+				IpSyncEnabled: true,
+				LinkPolicy:    []LinkPolicy{LinkPolicyS0AC, LinkPolicySxAC},
+			}
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: AMT_EthernetPortSettings."+test.expected)
+		})
+	}
+}
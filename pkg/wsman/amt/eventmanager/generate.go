@@ -0,0 +1,8 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package eventmanager
+
+//go:generate go run github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/generator/cmd/wsmangen -mof AMT_EventManagerService.mof -class AMT_EventManagerService -schema amt -package eventmanager -service Service -file service -fixtures amt/eventmanager/service -year 2024
@@ -8,23 +8,29 @@ package amt
 
 import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/agentpresence"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/assettable"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/environmentdetection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/eventmanager"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ieee8021x"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/managementpresence"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/mps"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/provisioningcertificatehash"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publicprivate"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/remoteaccess"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/thirdpartystorage"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/timesynchronization"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/userinitiatedconnection"
@@ -34,35 +40,53 @@ import (
 
 // Messages contains the supported AMT classes.
 type Messages struct {
-	wsmanMessageCreator             *message.WSManMessageCreator
-	AlarmClockService               alarmclock.Service
-	AuditLog                        auditlog.Service
-	AuthorizationService            authorization.Service
-	BootCapabilities                boot.Capabilities
-	BootSettingData                 boot.SettingData
-	EnvironmentDetectionSettingData environmentdetection.SettingData
-	EthernetPortSettings            ethernetport.Settings
-	GeneralSettings                 general.Settings
-	IEEE8021xCredentialContext      ieee8021x.CredentialContext
-	IEEE8021xProfile                ieee8021x.Profile
-	KerberosSettingData             kerberos.SettingData
-	ManagementPresenceRemoteSAP     managementpresence.RemoteSAP
-	MessageLog                      messagelog.Service
-	MPSUsernamePassword             mps.UsernamePassword
-	PublicKeyCertificate            publickey.Certificate
-	PublicKeyManagementService      publickey.ManagementService
-	PublicPrivateKeyPair            publicprivate.KeyPair
-	RedirectionService              redirection.Service
-	RemoteAccessPolicyAppliesToMPS  remoteaccess.PolicyAppliesToMPS
-	RemoteAccessPolicyRule          remoteaccess.PolicyRule
-	RemoteAccessService             remoteaccess.Service
-	SetupAndConfigurationService    setupandconfiguration.Service
-	TimeSynchronizationService      timesynchronization.Service
-	TLSCredentialContext            tls.CredentialContext
-	TLSProtocolEndpointCollection   tls.ProtocolEndpointCollection
-	TLSSettingData                  tls.SettingData
-	UserInitiatedConnectionService  userinitiatedconnection.Service
-	WiFiPortConfigurationService    wifiportconfiguration.Service
+	wsmanMessageCreator                        *message.WSManMessageCreator
+	ActiveFilterStatistics                     systemdefense.ActiveFilterStatistics
+	AgentPresenceCapabilities                  agentpresence.Capabilities
+	AgentPresenceService                       agentpresence.Service
+	AgentPresenceWatchdog                      agentpresence.Watchdog
+	AgentPresenceWatchdogAction                agentpresence.WatchdogAction
+	AlarmClockService                          alarmclock.Service
+	AssetTable                                 assettable.Table
+	AssetTableService                          assettable.Service
+	AuditLog                                   auditlog.Service
+	AuthorizationService                       authorization.Service
+	BootCapabilities                           boot.Capabilities
+	BootSettingData                            boot.SettingData
+	EnvironmentDetectionSettingData            environmentdetection.SettingData
+	EthernetPortSettings                       ethernetport.Settings
+	EventManagerService                        eventmanager.Service
+	GeneralSettings                            general.Settings
+	GeneralSystemDefenseCapabilities           systemdefense.Capabilities
+	Hdr8021Filter                              systemdefense.Hdr8021Filter
+	HostIsolation                              systemdefense.Isolation
+	IEEE8021xCredentialContext                 ieee8021x.CredentialContext
+	IEEE8021xProfile                           ieee8021x.Profile
+	IPHeadersFilter                            systemdefense.IPHeadersFilter
+	KerberosSettingData                        kerberos.SettingData
+	ManagementPresenceRemoteSAP                managementpresence.RemoteSAP
+	MessageLog                                 messagelog.Service
+	MPSUsernamePassword                        mps.UsernamePassword
+	NetworkFilter                              systemdefense.NetworkFilter
+	NetworkPortSystemDefensePolicy             systemdefense.NetworkPortPolicy
+	ProvisioningCertificateHash                provisioningcertificatehash.Hash
+	PublicKeyCertificate                       publickey.Certificate
+	PublicKeyManagementService                 publickey.ManagementService
+	PublicPrivateKeyPair                       publicprivate.KeyPair
+	RedirectionService                         redirection.Service
+	RemoteAccessPolicyAppliesToMPS             remoteaccess.PolicyAppliesToMPS
+	RemoteAccessPolicyRule                     remoteaccess.PolicyRule
+	RemoteAccessService                        remoteaccess.Service
+	SetupAndConfigurationService               setupandconfiguration.Service
+	SystemDefensePolicy                        systemdefense.Policy
+	ThirdPartyDataStorageAdministrationService thirdpartystorage.AdministrationService
+	ThirdPartyDataStorageService               thirdpartystorage.Service
+	TimeSynchronizationService                 timesynchronization.Service
+	TLSCredentialContext                       tls.CredentialContext
+	TLSProtocolEndpointCollection              tls.ProtocolEndpointCollection
+	TLSSettingData                             tls.SettingData
+	UserInitiatedConnectionService             userinitiatedconnection.Service
+	WiFiPortConfigurationService               wifiportconfiguration.Service
 }
 
 // NewMessages instantiates a new instance of amt Messages.
@@ -72,20 +96,35 @@ func NewMessages(client client.WSMan) Messages {
 	m := Messages{
 		wsmanMessageCreator: wsmanMessageCreator,
 	}
+	m.ActiveFilterStatistics = systemdefense.NewActiveFilterStatisticsWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceCapabilities = agentpresence.NewCapabilitiesWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceService = agentpresence.NewServiceWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceWatchdog = agentpresence.NewWatchdogWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceWatchdogAction = agentpresence.NewWatchdogActionWithClient(wsmanMessageCreator, client)
 	m.AlarmClockService = alarmclock.NewServiceWithClient(wsmanMessageCreator, client)
+	m.AssetTable = assettable.NewTableWithClient(wsmanMessageCreator, client)
+	m.AssetTableService = assettable.NewServiceWithClient(wsmanMessageCreator, client)
 	m.AuditLog = auditlog.NewAuditLogWithClient(wsmanMessageCreator, client)
 	m.AuthorizationService = authorization.NewServiceWithClient(wsmanMessageCreator, client)
 	m.BootCapabilities = boot.NewBootCapabilitiesWithClient(wsmanMessageCreator, client)
 	m.BootSettingData = boot.NewBootSettingDataWithClient(wsmanMessageCreator, client)
 	m.EnvironmentDetectionSettingData = environmentdetection.NewEnvironmentDetectionSettingDataWithClient(wsmanMessageCreator, client)
 	m.EthernetPortSettings = ethernetport.NewEthernetPortSettingsWithClient(wsmanMessageCreator, client)
+	m.EventManagerService = eventmanager.NewServiceWithClient(wsmanMessageCreator, client)
 	m.GeneralSettings = general.NewGeneralSettingsWithClient(wsmanMessageCreator, client)
+	m.GeneralSystemDefenseCapabilities = systemdefense.NewCapabilitiesWithClient(wsmanMessageCreator, client)
+	m.Hdr8021Filter = systemdefense.NewHdr8021FilterWithClient(wsmanMessageCreator, client)
+	m.HostIsolation = systemdefense.NewIsolationWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xCredentialContext = ieee8021x.NewIEEE8021xCredentialContextWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xProfile = ieee8021x.NewIEEE8021xProfileWithClient(wsmanMessageCreator, client)
+	m.IPHeadersFilter = systemdefense.NewIPHeadersFilterWithClient(wsmanMessageCreator, client)
 	m.KerberosSettingData = kerberos.NewKerberosSettingDataWithClient(wsmanMessageCreator, client)
 	m.ManagementPresenceRemoteSAP = managementpresence.NewManagementPresenceRemoteSAPWithClient(wsmanMessageCreator, client)
 	m.MessageLog = messagelog.NewMessageLogWithClient(wsmanMessageCreator, client)
 	m.MPSUsernamePassword = mps.NewMPSUsernamePasswordWithClient(wsmanMessageCreator, client)
+	m.NetworkFilter = systemdefense.NewNetworkFilterWithClient(wsmanMessageCreator, client)
+	m.NetworkPortSystemDefensePolicy = systemdefense.NewNetworkPortPolicyWithClient(wsmanMessageCreator, client)
+	m.ProvisioningCertificateHash = provisioningcertificatehash.NewProvisioningCertificateHashWithClient(wsmanMessageCreator, client)
 	m.PublicKeyCertificate = publickey.NewPublicKeyCertificateWithClient(wsmanMessageCreator, client)
 	m.PublicKeyManagementService = publickey.NewPublicKeyManagementServiceWithClient(wsmanMessageCreator, client)
 	m.PublicPrivateKeyPair = publicprivate.NewPublicPrivateKeyPairWithClient(wsmanMessageCreator, client)
@@ -94,6 +133,9 @@ func NewMessages(client client.WSMan) Messages {
 	m.RemoteAccessPolicyRule = remoteaccess.NewPolicyRuleWithClient(wsmanMessageCreator, client)
 	m.RemoteAccessService = remoteaccess.NewRemoteAccessServiceWithClient(wsmanMessageCreator, client)
 	m.SetupAndConfigurationService = setupandconfiguration.NewSetupAndConfigurationServiceWithClient(wsmanMessageCreator, client)
+	m.SystemDefensePolicy = systemdefense.NewPolicyWithClient(wsmanMessageCreator, client)
+	m.ThirdPartyDataStorageAdministrationService = thirdpartystorage.NewAdministrationServiceWithClient(wsmanMessageCreator, client)
+	m.ThirdPartyDataStorageService = thirdpartystorage.NewServiceWithClient(wsmanMessageCreator, client)
 	m.TimeSynchronizationService = timesynchronization.NewTimeSynchronizationServiceWithClient(wsmanMessageCreator, client)
 	m.TLSCredentialContext = tls.NewTLSCredentialContextWithClient(wsmanMessageCreator, client)
 	m.TLSProtocolEndpointCollection = tls.NewTLSProtocolEndpointCollectionWithClient(wsmanMessageCreator, client)
@@ -103,3 +145,12 @@ func NewMessages(client client.WSMan) Messages {
 
 	return m
 }
+
+// NewMessagesWithValidation instantiates a new instance of amt Messages whose services validate request types
+// against the constraints of their class before sending them, and fail with common.ErrInvalidRequest for an invalid one.
+func NewMessagesWithValidation(client client.WSMan) Messages {
+	m := NewMessages(client)
+	m.wsmanMessageCreator.ValidateRequests = true
+
+	return m
+}
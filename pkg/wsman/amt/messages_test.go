@@ -9,23 +9,29 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/agentpresence"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/assettable"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/environmentdetection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/eventmanager"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ieee8021x"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/managementpresence"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/mps"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/provisioningcertificatehash"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publickey"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publicprivate"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/remoteaccess"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/thirdpartystorage"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/timesynchronization"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/userinitiatedconnection"
@@ -45,6 +51,14 @@ func TestNewMessages(t *testing.T) {
 		t.Error("AlarmClockService is not initialized")
 	}
 
+	if reflect.DeepEqual(m.AssetTable, assettable.Table{}) {
+		t.Error("AssetTable is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AssetTableService, assettable.Service{}) {
+		t.Error("AssetTableService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.AuditLog, auditlog.Service{}) {
 		t.Error("AuditLog is not initialized")
 	}
@@ -69,6 +83,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("EthernetPortSettings is not initialized")
 	}
 
+	if reflect.DeepEqual(m.EventManagerService, eventmanager.Service{}) {
+		t.Error("EventManagerService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.GeneralSettings, general.Settings{}) {
 		t.Error("GeneralSettings is not initialized")
 	}
@@ -97,6 +115,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("MPSUsernamePassword is not initialized")
 	}
 
+	if reflect.DeepEqual(m.ProvisioningCertificateHash, provisioningcertificatehash.Hash{}) {
+		t.Error("ProvisioningCertificateHash is not initialized")
+	}
+
 	if reflect.DeepEqual(m.PublicKeyCertificate, publickey.Certificate{}) {
 		t.Error("PublicKeyCertificate is not initialized")
 	}
@@ -129,6 +151,14 @@ func TestNewMessages(t *testing.T) {
 		t.Error("SetupAndConfigurationService is not initialized")
 	}
 
+	if reflect.DeepEqual(m.ThirdPartyDataStorageAdministrationService, thirdpartystorage.AdministrationService{}) {
+		t.Error("ThirdPartyDataStorageAdministrationService is not initialized")
+	}
+
+	if reflect.DeepEqual(m.ThirdPartyDataStorageService, thirdpartystorage.Service{}) {
+		t.Error("ThirdPartyDataStorageService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.TimeSynchronizationService, timesynchronization.Service{}) {
 		t.Error("TimeSynchronizationService is not initialized")
 	}
@@ -152,4 +182,52 @@ func TestNewMessages(t *testing.T) {
 	if reflect.DeepEqual(m.WiFiPortConfigurationService, wifiportconfiguration.Service{}) {
 		t.Error("WiFiPortConfigurationService is not initialized")
 	}
+
+	if reflect.DeepEqual(m.ActiveFilterStatistics, systemdefense.ActiveFilterStatistics{}) {
+		t.Error("ActiveFilterStatistics is not initialized")
+	}
+
+	if reflect.DeepEqual(m.GeneralSystemDefenseCapabilities, systemdefense.Capabilities{}) {
+		t.Error("GeneralSystemDefenseCapabilities is not initialized")
+	}
+
+	if reflect.DeepEqual(m.Hdr8021Filter, systemdefense.Hdr8021Filter{}) {
+		t.Error("Hdr8021Filter is not initialized")
+	}
+
+	if reflect.DeepEqual(m.HostIsolation, systemdefense.Isolation{}) {
+		t.Error("HostIsolation is not initialized")
+	}
+
+	if reflect.DeepEqual(m.IPHeadersFilter, systemdefense.IPHeadersFilter{}) {
+		t.Error("IPHeadersFilter is not initialized")
+	}
+
+	if reflect.DeepEqual(m.NetworkFilter, systemdefense.NetworkFilter{}) {
+		t.Error("NetworkFilter is not initialized")
+	}
+
+	if reflect.DeepEqual(m.NetworkPortSystemDefensePolicy, systemdefense.NetworkPortPolicy{}) {
+		t.Error("NetworkPortSystemDefensePolicy is not initialized")
+	}
+
+	if reflect.DeepEqual(m.SystemDefensePolicy, systemdefense.Policy{}) {
+		t.Error("SystemDefensePolicy is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceCapabilities, agentpresence.Capabilities{}) {
+		t.Error("AgentPresenceCapabilities is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceService, agentpresence.Service{}) {
+		t.Error("AgentPresenceService is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceWatchdog, agentpresence.Watchdog{}) {
+		t.Error("AgentPresenceWatchdog is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceWatchdogAction, agentpresence.WatchdogAction{}) {
+		t.Error("AgentPresenceWatchdogAction is not initialized")
+	}
 }
@@ -0,0 +1,140 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningcertificatehash
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoCertificate is returned by ParsePEM and ParseChain when the input holds no certificate.
+	ErrNoCertificate = errors.New("no certificate found")
+	// ErrHashNotFound is returned by Find when no certificate matches a hash of Intel® AMT.
+	ErrHashNotFound = errors.New("no certificate matches a provisioning certificate hash")
+	// ErrHashDisabled is returned by Find when a certificate matches a hash of Intel® AMT that is not enabled.
+	ErrHashDisabled = errors.New("provisioning certificate hash is disabled")
+)
+
+// ParsePEM parses the CERTIFICATE blocks of PEM encoded data, such as a root certificate or a chain ending in the root.
+func ParsePEM(data []byte) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	for {
+		var block *pem.Block
+
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, ErrNoCertificate
+	}
+
+	return certificates, nil
+}
+
+// ParseChain parses base64 encoded DER certificates, in the form passed to hostbasedsetup.Service.AddNextCertInChain.
+func ParseChain(chain []string) ([]*x509.Certificate, error) {
+	certificates := make([]*x509.Certificate, 0, len(chain))
+
+	for i, encoded := range chain {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d: %w", i, err)
+		}
+
+		certificate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d: %w", i, err)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, ErrNoCertificate
+	}
+
+	return certificates, nil
+}
+
+// Fingerprint returns the hash of the DER encoding of certificate with the algorithm of hashType, the way Intel® AMT stores it in HashData.
+// The second result is false for algorithms that are not supported, such as MD5.
+func Fingerprint(certificate *x509.Certificate, hashType HashType) ([]byte, bool) {
+	switch hashType {
+	case HashTypeSHA1:
+		sum := sha1.Sum(certificate.Raw)
+
+		return sum[:], true
+	case HashTypeSHA256:
+		sum := sha256.Sum256(certificate.Raw)
+
+		return sum[:], true
+	case HashTypeSHA384:
+		sum := sha512.Sum384(certificate.Raw)
+
+		return sum[:], true
+	default:
+		return nil, false
+	}
+}
+
+// Find returns the first hash of hashes that matches one of certificates, typically the items of a Pull.
+// The match of an enabled hash is preferred. If only disabled hashes match, the match is returned with ErrHashDisabled, so that it can be enabled with SetEnabled.
+// Hashes of unsupported algorithms and hashes with HashData that is not valid base64 are skipped.
+func Find(certificates []*x509.Certificate, hashes []HashResponse) (Match, error) {
+	var disabled *Match
+
+	for _, certificate := range certificates {
+		for _, hash := range hashes {
+			data, err := base64.StdEncoding.DecodeString(hash.HashData)
+			if err != nil {
+				continue
+			}
+
+			sum, ok := Fingerprint(certificate, hash.HashType)
+			if !ok || !bytes.Equal(sum, data) {
+				continue
+			}
+
+			match := Match{Certificate: certificate, Hash: hash}
+			if hash.Enabled {
+				return match, nil
+			}
+
+			if disabled == nil {
+				disabled = &match
+			}
+		}
+	}
+
+	if disabled != nil {
+		return *disabled, ErrHashDisabled
+	}
+
+	return Match{}, ErrHashNotFound
+}
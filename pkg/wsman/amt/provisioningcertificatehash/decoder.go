@@ -0,0 +1,35 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningcertificatehash
+
+const (
+	AMTProvisioningCertificateHash string = "AMT_ProvisioningCertificateHash"
+	ValueNotFound                  string = "Value not found in map"
+)
+
+const (
+	HashTypeMD5 HashType = iota
+	HashTypeSHA1
+	HashTypeSHA256
+	HashTypeSHA384
+)
+
+// hashTypeToString is a map of HashType values to their string representations.
+var hashTypeToString = map[HashType]string{
+	HashTypeMD5:    "MD5",
+	HashTypeSHA1:   "SHA1",
+	HashTypeSHA256: "SHA256",
+	HashTypeSHA384: "SHA384",
+}
+
+// String returns a human-readable string representation of the HashType enumeration.
+func (e HashType) String() string {
+	if s, ok := hashTypeToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
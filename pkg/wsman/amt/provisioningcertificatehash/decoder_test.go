@@ -0,0 +1,28 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningcertificatehash
+
+import "testing"
+
+func TestHashType_String(t *testing.T) {
+	tests := []struct {
+		state    HashType
+		expected string
+	}{
+		{HashTypeMD5, "MD5"},
+		{HashTypeSHA1, "SHA1"},
+		{HashTypeSHA256, "SHA256"},
+		{HashTypeSHA384, "SHA384"},
+		{HashType(9), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package provisioningcertificatehash facilitates communication with Intel® AMT devices to manage the hashes of the root certificates trusted for remote provisioning.
+//
+// Hash:
+// Represents the hash of a root certificate that Intel® AMT trusts for admin control mode provisioning. The hashes of the firmware image are default hashes, which can be disabled but not deleted; hashes of other roots can be added and deleted.
+package provisioningcertificatehash
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewProvisioningCertificateHashWithClient instantiates a new Hash.
+func NewProvisioningCertificateHashWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Hash {
+	return Hash{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTProvisioningCertificateHash, client),
+	}
+}
+
+// Get retrieves the representation of the hash with the given InstanceID.
+func (hash Hash) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "InstanceID",
+		Value: instanceID,
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: hash.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = hash.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (hash Hash) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: hash.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = hash.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (hash Hash) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: hash.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = hash.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create adds the hash of a root certificate. The InstanceID of the new hash is returned in the CreateResponse.
+func (hash Hash) Create(request HashRequest) (response Response, err error) {
+	request.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTProvisioningCertificateHash)
+	request.InstanceID = ""
+	response = Response{
+		Message: &client.Message{
+			XMLInput: hash.base.Create(request, nil),
+		},
+	}
+	// send the message to AMT
+	err = hash.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Put changes the hash with the given InstanceID. Only ElementName and Enabled can be changed.
+func (hash Hash) Put(instanceID string, request HashRequest) (response Response, err error) {
+	request.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTProvisioningCertificateHash)
+	request.InstanceID = instanceID
+	selector := []message.Selector{{
+		Name:  "InstanceID",
+		Value: instanceID,
+	}}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: hash.base.Put(request, true, selector),
+		},
+	}
+	// send the message to AMT
+	err = hash.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// SetEnabled enables or disables the hash with the given InstanceID, reading the hash first so that its other properties are sent back unchanged.
+func (hash Hash) SetEnabled(instanceID string, enabled bool) (response Response, err error) {
+	response, err = hash.Get(instanceID)
+	if err != nil {
+		return
+	}
+
+	current := response.Body.GetResponse
+
+	return hash.Put(instanceID, HashRequest{
+		ElementName: current.ElementName,
+		HashType:    current.HashType,
+		HashData:    current.HashData,
+		Enabled:     enabled,
+	})
+}
+
+// Delete removes the hash with the given InstanceID. Default hashes cannot be deleted.
+func (hash Hash) Delete(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: hash.base.Delete(selector),
+		},
+	}
+	// send the message to AMT
+	err = hash.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,365 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningcertificatehash
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	hashID       = "Intel(r) AMT: Certificate Hash 24"
+	hashSelector = "<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT: Certificate Hash 24</w:Selector></w:SelectorSet>"
+	rootSHA256   = "3DwsGF3Xc1nnAg7PP3LEsky2c5DS16D7UicM8Y9A0Ts="
+	createBody   = `<h:AMT_ProvisioningCertificateHash xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ProvisioningCertificateHash"><h:ElementName>Contoso Provisioning Root CA</h:ElementName><h:HashType>2</h:HashType><h:HashData>3DwsGF3Xc1nnAg7PP3LEsky2c5DS16D7UicM8Y9A0Ts=</h:HashData><h:Enabled>true</h:Enabled></h:AMT_ProvisioningCertificateHash>`
+	putBody      = `<h:AMT_ProvisioningCertificateHash xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ProvisioningCertificateHash"><h:InstanceID>Intel(r) AMT: Certificate Hash 24</h:InstanceID><h:ElementName>Contoso Provisioning Root CA</h:ElementName><h:HashType>2</h:HashType><h:HashData>3DwsGF3Xc1nnAg7PP3LEsky2c5DS16D7UicM8Y9A0Ts=</h:HashData><h:Enabled>true</h:Enabled></h:AMT_ProvisioningCertificateHash>`
+)
+
+var contosoRoot = HashResponse{
+	XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTProvisioningCertificateHash), Local: AMTProvisioningCertificateHash},
+	InstanceID:  hashID,
+	ElementName: "Contoso Provisioning Root CA",
+	HashType:    HashTypeSHA256,
+	HashData:    rootSHA256,
+	Enabled:     false,
+	IsDefault:   false,
+}
+
+var hashRequest = HashRequest{
+	ElementName: "Contoso Provisioning Root CA",
+	HashType:    HashTypeSHA256,
+	HashData:    rootSHA256,
+	Enabled:     true,
+}
+
+func defaultHash(index int, name string, hashType HashType, data string) HashResponse {
+	return HashResponse{
+		XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTProvisioningCertificateHash), Local: AMTProvisioningCertificateHash},
+		InstanceID:  fmt.Sprintf("Intel(r) AMT: Certificate Hash %d", index),
+		ElementName: name,
+		HashType:    hashType,
+		HashData:    data,
+		Enabled:     true,
+		IsDefault:   true,
+	}
+}
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: HashResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"Description\":\"\",\"HashType\":0,\"HashData\":\"\",\"Enabled\":false,\"IsDefault\":false},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"HashItems\":null},\"CreateResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"Selectors\":null}}}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: HashResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    description: \"\"\n    hashtype: 0\n    hashdata: \"\"\n    enabled: false\n    isdefault: false\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    hashitems: []\ncreateresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    address: \"\"\n    referenceparameters:\n        resourceuri: \"\"\n        selectorset:\n            selectors: []\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveAMT_ProvisioningCertificateHash(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/provisioningcertificatehash/hash",
+	}
+	elementUnderTest := NewProvisioningCertificateHashWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ProvisioningCertificateHash Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_ProvisioningCertificateHash Get wsman message",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Get,
+				hashSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(hashID)
+				},
+				Body{
+					XMLName:     xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: contosoRoot,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_ProvisioningCertificateHash Enumerate wsman message",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_ProvisioningCertificateHash Pull wsman message",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						HashItems: []HashResponse{
+							defaultHash(0, "VeriSign Class 3 Primary CA-G5", HashTypeSHA256, "7RLCgqGI0yWdJwmXxQc4aVhEgbruTFSZRzr1oUbSRLQ="),
+							defaultHash(1, "Go Daddy Class 2 CA", HashTypeSHA1, "WRhLqaBK4nmlNyMVWCx9y9krzHo="),
+							defaultHash(2, "Entrust.net CA (2048)", HashTypeSHA256, "tLrt+k9OKecicPs2GO+yhCIIH5upPK0n5WhOCvpvXPI="),
+							defaultHash(3, "DigiCert Global Root G3", HashTypeSHA384, "R2pI/Wn/92goEvmfbip1SvdFcr+G055wrYU7lRHgZU9IhgRSMQKRmQFoMFA9AK7X"),
+							contosoRoot,
+						},
+					},
+				},
+			},
+			// CREATES
+			{
+				"should create a valid AMT_ProvisioningCertificateHash Create wsman message",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Create,
+				"",
+				createBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(hashRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParametersResponse{
+							ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTProvisioningCertificateHash),
+							SelectorSet: SelectorSetResponse{
+								Selectors: []SelectorResponse{{Name: "InstanceID", Text: hashID}},
+							},
+						},
+					},
+				},
+			},
+			// PUTS
+			{
+				"should create a valid AMT_ProvisioningCertificateHash Put wsman message",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Put,
+				hashSelector,
+				putBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(hashID, hashRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: HashResponse{
+						XMLName:     contosoRoot.XMLName,
+						InstanceID:  hashID,
+						ElementName: "Contoso Provisioning Root CA",
+						HashType:    HashTypeSHA256,
+						HashData:    rootSHA256,
+						Enabled:     true,
+					},
+				},
+			},
+			// DELETE
+			{
+				"should create a valid AMT_ProvisioningCertificateHash Delete wsman message",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Delete,
+				hashSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(hashID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_ProvisioningCertificateHash(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/provisioningcertificatehash/hash",
+	}
+	elementUnderTest := NewProvisioningCertificateHashWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ProvisioningCertificateHash Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_ProvisioningCertificateHash Get wsman message fails",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Get,
+				hashSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(hashID)
+				},
+			},
+			{
+				"should handle error when AMT_ProvisioningCertificateHash Enumerate wsman message fails",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_ProvisioningCertificateHash Pull wsman message fails",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_ProvisioningCertificateHash Create wsman message fails",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Create,
+				"",
+				createBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(hashRequest)
+				},
+			},
+			{
+				"should handle error when AMT_ProvisioningCertificateHash Put wsman message fails",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Put,
+				hashSelector,
+				putBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put(hashID, hashRequest)
+				},
+			},
+			{
+				"should handle error when AMT_ProvisioningCertificateHash Delete wsman message fails",
+				AMTProvisioningCertificateHash,
+				wsmantesting.Delete,
+				hashSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(hashID)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
+
+func TestSetEnabled(t *testing.T) {
+	client := wsmantesting.RoutingClient{
+		Responses: map[string][]string{
+			"AMT_ProvisioningCertificateHash/Get": {"amt/provisioningcertificatehash/hash/get"},
+			"AMT_ProvisioningCertificateHash/Put": {"amt/provisioningcertificatehash/hash/put"},
+		},
+	}
+	elementUnderTest := NewProvisioningCertificateHashWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	response, err := elementUnderTest.SetEnabled(hashID, true)
+	assert.NoError(t, err)
+	assert.True(t, response.Body.GetResponse.Enabled)
+	assert.Equal(t, []string{"AMT_ProvisioningCertificateHash/Get", "AMT_ProvisioningCertificateHash/Put"}, client.Requests)
+	assert.True(t, strings.Contains(client.Messages[1], putBody))
+
+	client = wsmantesting.RoutingClient{}
+	_, err = elementUnderTest.SetEnabled(hashID, true)
+	assert.ErrorIs(t, err, wsmantesting.ErrNoFixture)
+	assert.Equal(t, []string{"AMT_ProvisioningCertificateHash/Get"}, client.Requests)
+}
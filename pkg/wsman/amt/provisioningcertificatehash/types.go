@@ -0,0 +1,95 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningcertificatehash
+
+import (
+	"crypto/x509"
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Hash struct {
+	base message.Base
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName           xml.Name `xml:"Body"`
+		GetResponse       HashResponse
+		EnumerateResponse common.EnumerateResponse
+		PullResponse      PullResponse
+		CreateResponse    CreateResponse
+	}
+	HashResponse struct {
+		XMLName     xml.Name `xml:"AMT_ProvisioningCertificateHash"`
+		InstanceID  string   `xml:"InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName string   `xml:"ElementName,omitempty"` // A user-friendly name for the object, typically the common name of the certificate.
+		Description string   `xml:"Description,omitempty"` // The Description property provides a textual description of the object.
+		HashType    HashType `xml:"HashType"`              // The algorithm used to compute the hash of the certificate.
+		HashData    string   `xml:"HashData,omitempty"`    // The hash of the DER encoded certificate, base64 encoded.
+		Enabled     bool     `xml:"Enabled"`               // Indicates whether the hash is trusted for provisioning.
+		IsDefault   bool     `xml:"IsDefault"`             // Indicates whether the hash is one of the hashes of the firmware image, which can be disabled but not deleted.
+	}
+	PullResponse struct {
+		XMLName   xml.Name       `xml:"PullResponse"`
+		HashItems []HashResponse `xml:"Items>AMT_ProvisioningCertificateHash"`
+	}
+	CreateResponse struct {
+		XMLName             xml.Name                    `xml:"ResourceCreated"`
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	ReferenceParametersResponse struct {
+		ResourceURI string              `xml:"ResourceURI,omitempty"`
+		SelectorSet SelectorSetResponse `xml:"SelectorSet,omitempty"`
+	}
+	SelectorSetResponse struct {
+		Selectors []SelectorResponse `xml:"Selector,omitempty"`
+	}
+	SelectorResponse struct {
+		Name string `xml:"Name,attr"`
+		Text string `xml:",chardata"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type HashRequest struct {
+	XMLName     xml.Name `xml:"h:AMT_ProvisioningCertificateHash"`
+	H           string   `xml:"xmlns:h,attr"`
+	InstanceID  string   `xml:"h:InstanceID,omitempty"`  // Set by Put, and assigned by Intel® AMT on Create.
+	ElementName string   `xml:"h:ElementName,omitempty"` // A user-friendly name for the hash, typically the common name of the certificate.
+	HashType    HashType `xml:"h:HashType"`              // The algorithm used to compute the hash of the certificate.
+	HashData    string   `xml:"h:HashData"`              // The hash of the DER encoded certificate, base64 encoded.
+	Enabled     bool     `xml:"h:Enabled"`               // Indicates whether the hash is trusted for provisioning.
+}
+
+// Match is a hash of the firmware that matches a certificate.
+type Match struct {
+	Certificate *x509.Certificate
+	Hash        HashResponse
+}
+
+// Property Types.
+type (
+	// HashType is the algorithm used to compute the hash of a certificate.
+	//
+	// ValueMap={0, 1, 2, 3}
+	//
+	// Values={MD5, SHA1, SHA256, SHA384}
+	HashType int
+)
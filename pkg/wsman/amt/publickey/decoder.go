@@ -199,6 +199,7 @@ const (
 	ReturnValueInvalidParameter        ReturnValue = 36
 	ReturnValueFlashWriteLimitExceeded ReturnValue = 38
 	ReturnValueDuplicate               ReturnValue = 2058
+	ReturnValuePKIGeneratingKeys       ReturnValue = 2061
 	ReturnValueInvalidKeyLength        ReturnValue = 2062
 	ReturnValueInvalidCert             ReturnValue = 2063
 	ReturnValueUnsupported             ReturnValue = 2066
@@ -214,6 +215,7 @@ var returnValueToString = map[ReturnValue]string{
 	ReturnValueInvalidParameter:        "InvalidParameter",
 	ReturnValueFlashWriteLimitExceeded: "FlashWriteLimitExceeded",
 	ReturnValueDuplicate:               "Duplicate",
+	ReturnValuePKIGeneratingKeys:       "PKIGeneratingKeys",
 	ReturnValueInvalidKeyLength:        "InvalidKeyLength",
 	ReturnValueInvalidCert:             "InvalidCertificate",
 	ReturnValueUnsupported:             "Unsupported",
@@ -132,6 +132,7 @@ func TestReturnValue_String(t *testing.T) {
 		{ReturnValueInvalidParameter, "InvalidParameter"},
 		{ReturnValueFlashWriteLimitExceeded, "FlashWriteLimitExceeded"},
 		{ReturnValueDuplicate, "Duplicate"},
+		{ReturnValuePKIGeneratingKeys, "PKIGeneratingKeys"},
 		{ReturnValueInvalidKeyLength, "InvalidKeyLength"},
 		{ReturnValueInvalidCert, "InvalidCertificate"},
 		{ReturnValueUnsupported, "Unsupported"},
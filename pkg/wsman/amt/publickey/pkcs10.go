@@ -0,0 +1,204 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package publickey
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publicprivate"
+)
+
+var (
+	// ErrUnsupportedSigningAlgorithm is returned for a SigningAlgorithm other than SHA1RSA and SHA256RSA.
+	ErrUnsupportedSigningAlgorithm = errors.New("unsupported signing algorithm")
+	// ErrPublicKeyMismatch is returned by ParseSignedCertificateRequest for a request that is not for the public key of the key pair.
+	ErrPublicKeyMismatch = errors.New("certificate request is not for the public key of the key pair")
+)
+
+var (
+	oidRSAEncryption          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidSHA1WithRSA            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 5}
+	oidSHA256WithRSA          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+	oidExtensionRequest       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 14}
+	oidSubjectAlternativeName = asn1.ObjectIdentifier{2, 5, 29, 17}
+)
+
+// CertificateRequestOptions holds the subject and subject alternative names of a certificate request.
+type CertificateRequestOptions struct {
+	Subject        pkix.Name
+	DNSNames       []string
+	EmailAddresses []string
+	IPAddresses    []net.IP
+}
+
+// The ASN.1 structures of a PKCS#10 certificate request, see RFC 2986.
+type (
+	certificateRequest struct {
+		Info               certificationRequestInfo
+		SignatureAlgorithm pkix.AlgorithmIdentifier
+		Signature          asn1.BitString
+	}
+	certificationRequestInfo struct {
+		Version    int
+		Subject    asn1.RawValue
+		PublicKey  subjectPublicKeyInfo
+		Attributes []extensionRequest `asn1:"tag:0"`
+	}
+	subjectPublicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	extensionRequest struct {
+		Type   asn1.ObjectIdentifier
+		Values [][]pkix.Extension `asn1:"set"`
+	}
+)
+
+// KeyPairPublicKey returns the RSA public key of a key pair, decoded from its DERKey.
+func KeyPairPublicKey(keyPair publicprivate.PublicPrivateKeyPair) (*rsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(keyPair.DERKey)
+	if err != nil {
+		return nil, fmt.Errorf("DERKey of %s: %w", keyPair.InstanceID, err)
+	}
+
+	publicKey, err := x509.ParsePKCS1PublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("DERKey of %s: %w", keyPair.InstanceID, err)
+	}
+
+	return publicKey, nil
+}
+
+// NewNullSignedCertificateRequest returns the nullSignedCertificateRequest argument of GeneratePKCS10RequestEx for the key pair:
+// the base64 DER of a PKCS#10 certificate request for the public key of the key pair, with a signature of zeros.
+// Intel® AMT replaces the signature with one made by the private key of the key pair, which never leaves the device.
+func NewNullSignedCertificateRequest(keyPair publicprivate.PublicPrivateKeyPair, options CertificateRequestOptions, signingAlgorithm SigningAlgorithm) (string, error) {
+	publicKey, err := KeyPairPublicKey(keyPair)
+	if err != nil {
+		return "", err
+	}
+
+	algorithm, err := signatureAlgorithm(signingAlgorithm)
+	if err != nil {
+		return "", err
+	}
+
+	subject, err := asn1.Marshal(options.Subject.ToRDNSequence())
+	if err != nil {
+		return "", err
+	}
+
+	info := certificationRequestInfo{
+		Subject: asn1.RawValue{FullBytes: subject},
+		PublicKey: subjectPublicKeyInfo{
+			Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidRSAEncryption, Parameters: asn1.NullRawValue},
+			PublicKey: bitString(x509.MarshalPKCS1PublicKey(publicKey)),
+		},
+	}
+
+	if len(options.DNSNames) > 0 || len(options.EmailAddresses) > 0 || len(options.IPAddresses) > 0 {
+		names, err := marshalSubjectAlternativeNames(options)
+		if err != nil {
+			return "", err
+		}
+
+		info.Attributes = []extensionRequest{{
+			Type:   oidExtensionRequest,
+			Values: [][]pkix.Extension{{{Id: oidSubjectAlternativeName, Value: names}}},
+		}}
+	}
+
+	der, err := asn1.Marshal(certificateRequest{
+		Info:               info,
+		SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: algorithm, Parameters: asn1.NullRawValue},
+		Signature:          bitString(make([]byte, publicKey.Size())),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(der), nil
+}
+
+// ParseSignedCertificateRequest decodes the SignedCertificateRequest returned by GeneratePKCS10RequestEx,
+// and checks that it is a request for the public key of the key pair with a valid signature.
+func ParseSignedCertificateRequest(signedCertificateRequest string, keyPair publicprivate.PublicPrivateKeyPair) (*x509.CertificateRequest, error) {
+	publicKey, err := KeyPairPublicKey(keyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := base64.StdEncoding.DecodeString(signedCertificateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("signed certificate request: %w", err)
+	}
+
+	request, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, fmt.Errorf("signed certificate request: %w", err)
+	}
+
+	if !publicKey.Equal(request.PublicKey) {
+		return nil, ErrPublicKeyMismatch
+	}
+
+	if err := request.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("signed certificate request: %w", err)
+	}
+
+	return request, nil
+}
+
+func signatureAlgorithm(signingAlgorithm SigningAlgorithm) (asn1.ObjectIdentifier, error) {
+	switch signingAlgorithm {
+	case SHA1RSA:
+		return oidSHA1WithRSA, nil
+	case SHA256RSA:
+		return oidSHA256WithRSA, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedSigningAlgorithm, signingAlgorithm)
+	}
+}
+
+// marshalSubjectAlternativeNames returns the DER of the GeneralNames of a subject alternative name extension, see RFC 5280.
+func marshalSubjectAlternativeNames(options CertificateRequestOptions) ([]byte, error) {
+	const (
+		tagEmailAddress = 1
+		tagDNSName      = 2
+		tagIPAddress    = 7
+	)
+
+	names := make([]asn1.RawValue, 0, len(options.DNSNames)+len(options.EmailAddresses)+len(options.IPAddresses))
+
+	for _, name := range options.DNSNames {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tagDNSName, Bytes: []byte(name)})
+	}
+
+	for _, email := range options.EmailAddresses {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tagEmailAddress, Bytes: []byte(email)})
+	}
+
+	for _, ip := range options.IPAddresses {
+		if ipv4 := ip.To4(); ipv4 != nil {
+			ip = ipv4
+		}
+
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tagIPAddress, Bytes: ip})
+	}
+
+	return asn1.Marshal(names)
+}
+
+func bitString(data []byte) asn1.BitString {
+	return asn1.BitString{Bytes: data, BitLength: len(data) * 8}
+}
@@ -0,0 +1,152 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package publickey
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/publicprivate"
+)
+
+func newTestKeyPair(t *testing.T) (publicprivate.PublicPrivateKeyPair, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return publicprivate.PublicPrivateKeyPair{
+		ElementName: "Intel(r) AMT Key",
+		InstanceID:  "Intel(r) AMT Key: Handle: 0",
+		DERKey:      base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PublicKey(&key.PublicKey)),
+	}, key
+}
+
+// signTestCertificateRequest returns a certificate request signed by key, as GeneratePKCS10RequestEx would.
+func signTestCertificateRequest(t *testing.T, key *rsa.PrivateKey) string {
+	t.Helper()
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "amt.example.com"},
+		DNSNames: []string{"amt.example.com"},
+	}, key)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func TestNewNullSignedCertificateRequest(t *testing.T) {
+	keyPair, key := newTestKeyPair(t)
+
+	tests := []struct {
+		name              string
+		options           CertificateRequestOptions
+		signingAlgorithm  SigningAlgorithm
+		expectedAlgorithm x509.SignatureAlgorithm
+	}{
+		{
+			"subject only",
+			CertificateRequestOptions{Subject: pkix.Name{CommonName: "amt.example.com", Organization: []string{"Example"}}},
+			SHA256RSA,
+			x509.SHA256WithRSA,
+		},
+		{
+			"subject alternative names",
+			CertificateRequestOptions{
+				Subject:        pkix.Name{CommonName: "amt.example.com"},
+				DNSNames:       []string{"amt.example.com", "amt"},
+				EmailAddresses: []string{"admin@example.com"},
+				IPAddresses:    []net.IP{net.ParseIP("192.168.0.10"), net.ParseIP("fe80::1")},
+			},
+			SHA256RSA,
+			x509.SHA256WithRSA,
+		},
+		{
+			"SHA1",
+			CertificateRequestOptions{Subject: pkix.Name{CommonName: "amt.example.com"}},
+			SHA1RSA,
+			x509.SHA1WithRSA,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nullSigned, err := NewNullSignedCertificateRequest(keyPair, test.options, test.signingAlgorithm)
+			require.NoError(t, err)
+
+			der, err := base64.StdEncoding.DecodeString(nullSigned)
+			require.NoError(t, err)
+
+			request, err := x509.ParseCertificateRequest(der)
+			require.NoError(t, err)
+			assert.Equal(t, test.options.Subject.String(), request.Subject.String())
+			assert.Equal(t, test.options.DNSNames, request.DNSNames)
+			assert.Equal(t, test.options.EmailAddresses, request.EmailAddresses)
+			assert.Len(t, request.IPAddresses, len(test.options.IPAddresses))
+
+			for i, ip := range test.options.IPAddresses {
+				assert.True(t, ip.Equal(request.IPAddresses[i]))
+			}
+
+			assert.True(t, key.PublicKey.Equal(request.PublicKey))
+			assert.Equal(t, test.expectedAlgorithm, request.SignatureAlgorithm)
+			assert.Equal(t, make([]byte, key.PublicKey.Size()), request.Signature)
+		})
+	}
+}
+
+func TestNewNullSignedCertificateRequestInvalid(t *testing.T) {
+	keyPair, _ := newTestKeyPair(t)
+	options := CertificateRequestOptions{Subject: pkix.Name{CommonName: "amt.example.com"}}
+
+	_, err := NewNullSignedCertificateRequest(keyPair, options, SigningAlgorithm(5))
+	assert.ErrorIs(t, err, ErrUnsupportedSigningAlgorithm)
+
+	keyPair.DERKey = "not base64"
+	_, err = NewNullSignedCertificateRequest(keyPair, options, SHA256RSA)
+	assert.Error(t, err)
+
+	keyPair.DERKey = base64.StdEncoding.EncodeToString([]byte("not a key"))
+	_, err = NewNullSignedCertificateRequest(keyPair, options, SHA256RSA)
+	assert.Error(t, err)
+}
+
+func TestParseSignedCertificateRequest(t *testing.T) {
+	keyPair, key := newTestKeyPair(t)
+
+	request, err := ParseSignedCertificateRequest(signTestCertificateRequest(t, key), keyPair)
+	require.NoError(t, err)
+	assert.Equal(t, "amt.example.com", request.Subject.CommonName)
+	assert.Equal(t, []string{"amt.example.com"}, request.DNSNames)
+}
+
+func TestParseSignedCertificateRequestInvalid(t *testing.T) {
+	keyPair, key := newTestKeyPair(t)
+	otherKeyPair, _ := newTestKeyPair(t)
+
+	nullSigned, err := NewNullSignedCertificateRequest(keyPair, CertificateRequestOptions{Subject: pkix.Name{CommonName: "amt.example.com"}}, SHA256RSA)
+	require.NoError(t, err)
+
+	_, err = ParseSignedCertificateRequest(signTestCertificateRequest(t, key), otherKeyPair)
+	assert.ErrorIs(t, err, ErrPublicKeyMismatch)
+
+	_, err = ParseSignedCertificateRequest(nullSigned, keyPair)
+	assert.ErrorIs(t, err, rsa.ErrVerification)
+
+	_, err = ParseSignedCertificateRequest("test?", keyPair)
+	assert.Error(t, err)
+
+	_, err = ParseSignedCertificateRequest(base64.StdEncoding.EncodeToString([]byte("not a request")), keyPair)
+	assert.Error(t, err)
+}
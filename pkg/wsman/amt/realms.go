@@ -0,0 +1,19 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package amt
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
+
+// RealmValues converts realms to the authorization.RealmValues of a user ACL entry.
+// Both enumerations use the realm numbers of the Intel® AMT SDK, so every Realms constant maps to the RealmValues of the same number.
+func RealmValues(realms ...Realms) []authorization.RealmValues {
+	values := make([]authorization.RealmValues, len(realms))
+	for i, realm := range realms {
+		values[i] = authorization.RealmValues(realm)
+	}
+
+	return values
+}
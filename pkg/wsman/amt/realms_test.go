@@ -0,0 +1,55 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package amt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
+)
+
+func TestRealmValues(t *testing.T) {
+	tests := []struct {
+		realm    Realms
+		expected authorization.RealmValues
+	}{
+		{REDIRECTION, authorization.RealmValuesRedirectionRealm},
+		{ADMINISTRATION, authorization.RealmValuesPTAdministrationRealm},
+		{HARDWARE_ASSET, authorization.RealmValuesHardwareAssetRealm},
+		{REMOTE_CONTROL, authorization.RealmValuesRemoteControlRealm},
+		{STORAGE, authorization.RealmValuesStorageRealm},
+		{EVENT_MANAGER, authorization.RealmValuesEventManagerRealm},
+		{STORAGE_ADMIN, authorization.RealmValuesStorageAdminRealm},
+		{AGENT_PRESENCE_LOCAL, authorization.RealmValuesAgentPresenceLocalRealm},
+		{AGENT_PRESENCE_REMOTE, authorization.RealmValuesAgentPresenceRemoteRealm},
+		{CIRCUIT_BREAKER, authorization.RealmValuesCircuitBreakerRealm},
+		{NETWORK_TIME, authorization.RealmValuesNetworkTimeRealm},
+		{GENERAL_INFO, authorization.RealmValuesGeneralInfoRealm},
+		{ENDPOINT_ACCESS_CONTROL, authorization.RealmValuesEndpointAccessControlRealm},
+		{ENDPOINT_ACCESS_CONTROL_ADMIN, authorization.RealmValuesEndpointAccessControlAdminRealm},
+		{EVENT_LOG_READER, authorization.RealmValuesEventLogReaderRealm},
+		{AUDIT_LOG, authorization.RealmValuesAuditLogRealm},
+		{USER_ACCESS_CONTROL, authorization.RealmValuesACLRealm},
+		{LOCAL_APPS, authorization.RealmValuesLocalSystemRealm},
+	}
+
+	realms := make([]Realms, 0, len(tests))
+	expected := make([]authorization.RealmValues, 0, len(tests))
+
+	for _, test := range tests {
+		realms = append(realms, test.realm)
+		expected = append(expected, test.expected)
+	}
+
+	if values := RealmValues(realms...); !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v, but got %v", expected, values)
+	}
+
+	if values := RealmValues(); len(values) != 0 {
+		t.Errorf("Expected no realm values, but got %v", values)
+	}
+}
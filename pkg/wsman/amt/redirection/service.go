@@ -100,6 +100,11 @@ func (service Service) Pull(enumerationContext string) (response Response, err e
 //
 // - ListenerEnabled.
 func (service Service) Put(redirectionService RedirectionRequest) (response Response, err error) {
+	err = service.base.Validate(redirectionService)
+	if err != nil {
+		return
+	}
+
 	redirectionService.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTRedirectionService)
 	response = Response{
 		Message: &client.Message{
@@ -0,0 +1,21 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package redirection
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+
+// Validate checks the request against the constraints of AMT_RedirectionService.
+func (request RedirectionRequest) Validate() error {
+	validation := common.NewValidation(AMTRedirectionService)
+
+	validation.Required("CreationClassName", request.CreationClassName != "")
+	validation.Required("Name", request.Name != "")
+	validation.Required("SystemCreationClassName", request.SystemCreationClassName != "")
+	validation.Required("SystemName", request.SystemName != "")
+	validation.Check(request.EnabledState >= IDERAndSOLAreDisabled && request.EnabledState <= IDERAndSOLAreEnabled, "EnabledState", "must be one of the IDER and SOL states (32768 to 32771)")
+
+	return validation.Err()
+}
@@ -0,0 +1,78 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package redirection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestRedirectionRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *RedirectionRequest)
+		expected string
+	}{
+		{"valid request", func(request *RedirectionRequest) {}, ""},
+		{"missing Name", func(request *RedirectionRequest) { request.Name = "" }, "Name is required"},
+		{"missing SystemName", func(request *RedirectionRequest) { request.SystemName = "" }, "SystemName is required"},
+		{"generic EnabledState", func(request *RedirectionRequest) { request.EnabledState = Enabled }, "EnabledState must be one of the IDER and SOL states (32768 to 32771)"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := RedirectionRequest{
+				CreationClassName:       AMTRedirectionService,
+				EnabledState:            IDERAndSOLAreEnabled,
+				ListenerEnabled:         true,
+				Name:                    "Intel(r) AMT Redirection Service",
+				SystemCreationClassName: "CIM_ComputerSystem",
+				SystemName:              "Intel(r) AMT",
+			}
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: AMT_RedirectionService."+test.expected)
+		})
+	}
+}
+
+func TestRedirectionGetPutRoundTrip(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	wsmanMessageCreator.ValidateRequests = true
+	client := wsmantesting.MockClient{PackageUnderTest: "amt/redirectionservice", CurrentMessage: wsmantesting.CurrentMessageGet}
+	elementUnderTest := NewRedirectionServiceWithClient(wsmanMessageCreator, &client)
+
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+
+	current := response.Body.GetAndPutResponse
+	request := RedirectionRequest{
+		CreationClassName:       current.CreationClassName,
+		ElementName:             current.ElementName,
+		EnabledState:            current.EnabledState,
+		ListenerEnabled:         current.ListenerEnabled,
+		Name:                    current.Name,
+		SystemCreationClassName: current.SystemCreationClassName,
+		SystemName:              current.SystemName,
+	}
+	request.EnabledState = IDERIsEnabledAndSOLIsDisabled
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePut
+	_, err = elementUnderTest.Put(request)
+	assert.NoError(t, err)
+}
@@ -92,6 +92,11 @@ func (service Service) Pull(enumerationContext string) (response Response, err e
 // This credential may be an existing AMT_PublicKeyCertificate instance (if the created MPS is configured to use mutual authentication).
 // If the created MpServer is configured to use username password authentication, an AMT_MPSUsernamePassword instance is created and used as the associated credential.
 func (service Service) AddMPS(mpServer AddMpServerRequest) (response Response, err error) {
+	err = service.base.Validate(mpServer)
+	if err != nil {
+		return
+	}
+
 	mpServer.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTRemoteAccessService)
 
 	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTRemoteAccessService, AddMps), AMTRemoteAccessService, nil, "", "")
@@ -124,6 +129,11 @@ func (service Service) AddMPS(mpServer AddMpServerRequest) (response Response, e
 // Creates an AMT_RemoteAccessPolicyRule instance and associates it to a given list of AMT_ManagementPresenceRemoteSAP instances with AMT_PolicySetAppliesToElement association instances.
 // Returns an XML string representing the WS-Management message to be sent to the Intel® AMT subsystem.
 func (service Service) AddRemoteAccessPolicyRule(remoteAccessPolicyRule RemoteAccessPolicyRuleRequest, name string) (response Response, err error) {
+	err = service.base.Validate(remoteAccessPolicyRule)
+	if err != nil {
+		return response, err
+	}
+
 	selector := message.Selector{
 		Name:  "Name",
 		Value: name,
@@ -0,0 +1,85 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteaccess
+
+import (
+	"encoding/base64"
+	"net"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+const (
+	maxMPSUsernameLength = 16
+	maxMPSPasswordLength = 16
+	maxExtendedDataBytes = 32
+)
+
+// Validate checks the request against the constraints of AMT_RemoteAccessService.AddMpServer.
+func (request AddMpServerRequest) Validate() error {
+	validation := common.NewValidation(AMTRemoteAccessService + "." + AddMps)
+
+	validation.Required("AccessInfo", request.AccessInfo != "")
+	validation.Range("Port", request.Port, 1, 65535)
+
+	ip := net.ParseIP(request.AccessInfo)
+
+	switch request.InfoFormat {
+	case IPv4Address:
+		validation.Check(ip != nil && ip.To4() != nil, "AccessInfo", "must be an IPv4 address when InfoFormat is IPv4Address")
+		validation.Required("CN", request.CommonName != "")
+	case IPv6Address:
+		validation.Check(ip != nil && ip.To4() == nil, "AccessInfo", "must be an IPv6 address when InfoFormat is IPv6Address")
+		validation.Required("CN", request.CommonName != "")
+	case FQDN:
+		validation.Check(ip == nil, "AccessInfo", "must be a host name when InfoFormat is FQDN")
+	default:
+		validation.Fail("InfoFormat", "must be IPv4Address (3), IPv6Address (4) or FQDN (201)")
+	}
+
+	switch request.AuthMethod {
+	case MutualAuthentication:
+		validation.Required("Certificate", request.Certificate != "")
+	case UsernamePasswordAuthentication:
+		validation.Required("Username", request.Username != "")
+		validation.Required("Password", request.Password != "")
+	default:
+		validation.Fail("AuthMethod", "must be MutualAuthentication (1) or UsernamePasswordAuthentication (2)")
+	}
+
+	validation.MaxLength("Username", request.Username, maxMPSUsernameLength)
+	validation.Check(isAlphanumeric(request.Username), "Username", "must contain only alphanumeric characters")
+	validation.MaxLength("Password", request.Password, maxMPSPasswordLength)
+
+	return validation.Err()
+}
+
+// Validate checks the request against the constraints of AMT_RemoteAccessService.AddRemoteAccessPolicyRule.
+func (request RemoteAccessPolicyRuleRequest) Validate() error {
+	validation := common.NewValidation(AMTRemoteAccessService + "." + AddRemoteAccessPolicyRule)
+
+	common.ValueMap(validation, "Trigger", request.Trigger, triggerToString)
+	validation.Check(request.TunnelLifeTime >= 0, "TunnelLifeTime", "must not be negative")
+	validation.Check(request.Trigger != TriggerPeriodic || request.ExtendedData != "", "ExtendedData", "is required for a periodic trigger")
+
+	if request.ExtendedData != "" {
+		data, err := base64.StdEncoding.DecodeString(request.ExtendedData)
+		validation.Check(err == nil, "ExtendedData", "must be base64 encoded")
+		validation.Check(len(data) <= maxExtendedDataBytes, "ExtendedData", "must not exceed 32 bytes")
+	}
+
+	return validation.Err()
+}
+
+func isAlphanumeric(value string) bool {
+	for _, character := range value {
+		if (character < '0' || character > '9') && (character < 'a' || character > 'z') && (character < 'A' || character > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
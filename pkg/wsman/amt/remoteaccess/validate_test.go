@@ -0,0 +1,99 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteaccess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddMpServerRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *AddMpServerRequest)
+		expected string
+	}{
+		{"valid request", func(request *AddMpServerRequest) {}, ""},
+		{"IPv4 address", func(request *AddMpServerRequest) {
+			request.InfoFormat = IPv4Address
+			request.AccessInfo = "192.168.1.10"
+		}, ""},
+		{"mutual authentication", func(request *AddMpServerRequest) {
+			request.AuthMethod = MutualAuthentication
+			request.Certificate = "Intel(r) AMT Certificate: Handle: 1"
+		}, ""},
+		{"missing AccessInfo", func(request *AddMpServerRequest) { request.AccessInfo = "" }, "AccessInfo is required"},
+		{"invalid Port", func(request *AddMpServerRequest) { request.Port = 65536 }, "Port must be between 1 and 65535, got 65536"},
+		{"IPv4 format with host name", func(request *AddMpServerRequest) { request.InfoFormat = IPv4Address }, "AccessInfo must be an IPv4 address when InfoFormat is IPv4Address"},
+		{"IPv6 format with IPv4 address", func(request *AddMpServerRequest) {
+			request.InfoFormat = IPv6Address
+			request.AccessInfo = "192.168.1.10"
+		}, "AccessInfo must be an IPv6 address when InfoFormat is IPv6Address"},
+		{"FQDN format with address", func(request *AddMpServerRequest) { request.AccessInfo = "192.168.1.10" }, "AccessInfo must be a host name when InfoFormat is FQDN"},
+		{"unknown InfoFormat", func(request *AddMpServerRequest) { request.InfoFormat = 1 }, "InfoFormat must be IPv4Address (3), IPv6Address (4) or FQDN (201)"},
+		{"unknown AuthMethod", func(request *AddMpServerRequest) { request.AuthMethod = 3 }, "AuthMethod must be MutualAuthentication (1) or UsernamePasswordAuthentication (2)"},
+		{"mutual authentication without certificate", func(request *AddMpServerRequest) { request.AuthMethod = MutualAuthentication }, "Certificate is required"},
+		{"missing Password", func(request *AddMpServerRequest) { request.Password = "" }, "Password is required"},
+		{"Username too long", func(request *AddMpServerRequest) { request.Username = "username12345678" + "9" }, "Username must not exceed 16 characters"},
+		{"Username not alphanumeric", func(request *AddMpServerRequest) { request.Username = "user_name" }, "Username must contain only alphanumeric characters"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := AddMpServerRequest{
+				AccessInfo: "mps.example.com",
+				InfoFormat: FQDN,
+				Port:       4433,
+				AuthMethod: UsernamePasswordAuthentication,
+				Username:   "admin",
+				Password:   "P@ssw0rd",
+				CommonName: "mps.example.com",
+			}
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: AMT_RemoteAccessService.AddMpServer."+test.expected)
+		})
+	}
+}
+
+func TestRemoteAccessPolicyRuleRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  RemoteAccessPolicyRuleRequest
+		expected string
+	}{
+		{"user initiated", RemoteAccessPolicyRuleRequest{Trigger: TriggerUserInitiated}, ""},
+		{"periodic", RemoteAccessPolicyRuleRequest{Trigger: TriggerPeriodic, ExtendedData: "AAAAAAAAABk="}, ""},
+		{"unknown Trigger", RemoteAccessPolicyRuleRequest{Trigger: 4}, "Trigger has unsupported value 4"},
+		{"negative TunnelLifeTime", RemoteAccessPolicyRuleRequest{Trigger: TriggerAlert, TunnelLifeTime: -1}, "TunnelLifeTime must not be negative"},
+		{"periodic without ExtendedData", RemoteAccessPolicyRuleRequest{Trigger: TriggerPeriodic}, "ExtendedData is required for a periodic trigger"},
+		{"ExtendedData not base64", RemoteAccessPolicyRuleRequest{Trigger: TriggerPeriodic, ExtendedData: "not base64!"}, "ExtendedData must be base64 encoded"},
+		{"ExtendedData too long", RemoteAccessPolicyRuleRequest{Trigger: TriggerPeriodic, ExtendedData: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}, "ExtendedData must not exceed 32 bytes"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: AMT_RemoteAccessService.AddRemoteAccessPolicyRule."+test.expected)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewActiveFilterStatisticsWithClient instantiates a new ActiveFilterStatistics.
+func NewActiveFilterStatisticsWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ActiveFilterStatistics {
+	return ActiveFilterStatistics{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTActiveFilterStatistics, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (statistics ActiveFilterStatistics) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "InstanceID",
+		Value: instanceID,
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: statistics.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = statistics.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+// The counters are only as recent as the last call to Policy.UpdateStatistics for the network interface.
+func (statistics ActiveFilterStatistics) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: statistics.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = statistics.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (statistics ActiveFilterStatistics) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: statistics.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = statistics.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
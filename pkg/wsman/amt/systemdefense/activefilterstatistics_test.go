@@ -0,0 +1,195 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var activeFilterStatistics = []ActiveFilterStatisticsResponse{
+	{
+		XMLName:              xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTActiveFilterStatistics), Local: AMTActiveFilterStatistics},
+		InstanceID:           "Intel(r) AMT:Filter Statistics 1",
+		ElementName:          "Intel(r) AMT:Filter Statistics",
+		ActivationCount:      12,
+		FilterCreationHandle: 1,
+		NetworkInterface:     "Intel(r) AMT Ethernet Port 0",
+	},
+	{
+		XMLName:              xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTActiveFilterStatistics), Local: AMTActiveFilterStatistics},
+		InstanceID:           "Intel(r) AMT:Filter Statistics 2",
+		ElementName:          "Intel(r) AMT:Filter Statistics",
+		ActivationCount:      7,
+		FilterCreationHandle: 2,
+		NetworkInterface:     "Intel(r) AMT Ethernet Port 0",
+	},
+}
+
+func TestPositiveAMT_ActiveFilterStatistics(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/activefilterstatistics",
+	}
+	elementUnderTest := NewActiveFilterStatisticsWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ActiveFilterStatistics Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_ActiveFilterStatistics Get wsman message",
+				AMTActiveFilterStatistics,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Filter Statistics 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get("Intel(r) AMT:Filter Statistics 1")
+				},
+				Body{
+					XMLName:                           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ActiveFilterStatisticsGetResponse: activeFilterStatistics[0],
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_ActiveFilterStatistics Enumerate wsman message",
+				AMTActiveFilterStatistics,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_ActiveFilterStatistics Pull wsman message",
+				AMTActiveFilterStatistics,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                     xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						ActiveFilterStatisticsItems: activeFilterStatistics,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_ActiveFilterStatistics(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/activefilterstatistics",
+	}
+	elementUnderTest := NewActiveFilterStatisticsWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ActiveFilterStatistics Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_ActiveFilterStatistics Get wsman message fails",
+				AMTActiveFilterStatistics,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Filter Statistics 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get("Intel(r) AMT:Filter Statistics 1")
+				},
+			},
+			{
+				"should handle error when AMT_ActiveFilterStatistics Enumerate wsman message fails",
+				AMTActiveFilterStatistics,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_ActiveFilterStatistics Pull wsman message fails",
+				AMTActiveFilterStatistics,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
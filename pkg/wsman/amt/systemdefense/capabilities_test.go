@@ -0,0 +1,206 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var capabilities = CapabilitiesResponse{
+	XMLName:                    xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTGeneralSystemDefenseCapabilities), Local: AMTGeneralSystemDefenseCapabilities},
+	InstanceID:                 "Intel(r) AMT:System Defense Capabilities",
+	ElementName:                "Intel(r) AMT:System Defense Capabilities",
+	GlobalMaxSupportedFilters:  32,
+	GlobalMaxSupportedPolicies: 16,
+	GlobalMaxSupportedCounters: 32,
+	GlobalAvailableFilters:     30,
+	GlobalAvailablePolicies:    15,
+	GlobalAvailableCounters:    32,
+}
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			CapabilitiesGetResponse: CapabilitiesResponse{
+				GlobalMaxSupportedFilters: 32,
+			},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ActiveFilterStatisticsGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"ActivationCount\":0,\"FilterCreationHandle\":0,\"NetworkInterface\":\"\"},\"CapabilitiesGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"GlobalMaxSupportedFilters\":32,\"GlobalMaxSupportedPolicies\":0,\"GlobalMaxSupportedCounters\":0,\"GlobalAvailableFilters\":0,\"GlobalAvailablePolicies\":0,\"GlobalAvailableCounters\":0},\"Hdr8021FilterGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"IsNegated\":false,\"ActionEventOnMatch\":false,\"FilterDirection\":0,\"FilterProfile\":0,\"FilterProfileData\":0,\"HdrSrcMACAddr8021\":null,\"HdrDestMACAddr8021\":null,\"HdrProtocolID8021\":0,\"HdrPriorityValue8021\":0,\"HdrVLANID8021\":0},\"IPHeadersFilterGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"IsNegated\":false,\"ActionEventOnMatch\":false,\"FilterDirection\":0,\"FilterProfile\":0,\"FilterProfileData\":0,\"HdrIPVersion\":0,\"HdrSrcAddress\":null,\"HdrSrcMask\":null,\"HdrDestAddress\":null,\"HdrDestMask\":null,\"HdrProtocolID\":0,\"HdrSrcPortStart\":0,\"HdrSrcPortEnd\":0,\"HdrDestPortStart\":0,\"HdrDestPortEnd\":0},\"NetworkFilterGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"IsNegated\":false,\"ActionEventOnMatch\":false,\"FilterDirection\":0,\"FilterProfile\":0,\"FilterProfileData\":0},\"PolicyGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"PolicyName\":\"\",\"PolicyPrecedence\":0,\"AntiSpoofingSupport\":0,\"FilterCreationHandles\":null,\"TxDefaultDrop\":false,\"TxDefaultMatchEvent\":false,\"TxDefaultCount\":false,\"RxDefaultDrop\":false,\"RxDefaultMatchEvent\":false,\"RxDefaultCount\":false},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ActiveFilterStatisticsItems\":null,\"CapabilitiesItems\":null,\"Hdr8021FilterItems\":null,\"IPHeadersFilterItems\":null,\"NetworkFilterItems\":null,\"NetworkPortPolicyItems\":null,\"PolicyItems\":null},\"CreateResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"Selectors\":null}}},\"GetTimeout_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Timeout\":0,\"ReturnValue\":0},\"SetTimeout_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"UpdateStatistics_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			CapabilitiesGetResponse: CapabilitiesResponse{
+				GlobalMaxSupportedFilters: 32,
+			},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nactivefilterstatisticsgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    activationcount: 0\n    filtercreationhandle: 0\n    networkinterface: \"\"\ncapabilitiesgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    globalmaxsupportedfilters: 32\n    globalmaxsupportedpolicies: 0\n    globalmaxsupportedcounters: 0\n    globalavailablefilters: 0\n    globalavailablepolicies: 0\n    globalavailablecounters: 0\nhdr8021filtergetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    isnegated: false\n    actioneventonmatch: false\n    filterdirection: 0\n    filterprofile: 0\n    filterprofiledata: 0\n    hdrsrcmacaddr8021: []\n    hdrdestmacaddr8021: []\n    hdrprotocolid8021: 0\n    hdrpriorityvalue8021: 0\n    hdrvlanid8021: 0\nipheadersfiltergetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    isnegated: false\n    actioneventonmatch: false\n    filterdirection: 0\n    filterprofile: 0\n    filterprofiledata: 0\n    hdripversion: 0\n    hdrsrcaddress: []\n    hdrsrcmask: []\n    hdrdestaddress: []\n    hdrdestmask: []\n    hdrprotocolid: 0\n    hdrsrcportstart: 0\n    hdrsrcportend: 0\n    hdrdestportstart: 0\n    hdrdestportend: 0\nnetworkfiltergetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    isnegated: false\n    actioneventonmatch: false\n    filterdirection: 0\n    filterprofile: 0\n    filterprofiledata: 0\npolicygetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    policyname: \"\"\n    policyprecedence: 0\n    antispoofingsupport: 0\n    filtercreationhandles: []\n    txdefaultdrop: false\n    txdefaultmatchevent: false\n    txdefaultcount: false\n    rxdefaultdrop: false\n    rxdefaultmatchevent: false\n    rxdefaultcount: false\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    activefilterstatisticsitems: []\n    capabilitiesitems: []\n    hdr8021filteritems: []\n    ipheadersfilteritems: []\n    networkfilteritems: []\n    networkportpolicyitems: []\n    policyitems: []\ncreateresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    address: \"\"\n    referenceparameters:\n        resourceuri: \"\"\n        selectorset:\n            selectors: []\ngettimeout_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    timeout: 0\n    returnvalue: 0\nsettimeout_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nupdatestatistics_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveAMT_GeneralSystemDefenseCapabilities(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/capabilities",
+	}
+	elementUnderTest := NewCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_GeneralSystemDefenseCapabilities Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_GeneralSystemDefenseCapabilities Get wsman message",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                 xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CapabilitiesGetResponse: capabilities,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_GeneralSystemDefenseCapabilities Enumerate wsman message",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_GeneralSystemDefenseCapabilities Pull wsman message",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:           xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						CapabilitiesItems: []CapabilitiesResponse{capabilities},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_GeneralSystemDefenseCapabilities(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/capabilities",
+	}
+	elementUnderTest := NewCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_GeneralSystemDefenseCapabilities Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_GeneralSystemDefenseCapabilities Get wsman message fails",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_GeneralSystemDefenseCapabilities Enumerate wsman message fails",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_GeneralSystemDefenseCapabilities Pull wsman message fails",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
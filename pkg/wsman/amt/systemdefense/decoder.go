@@ -0,0 +1,139 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+const (
+	AMTActiveFilterStatistics           string = "AMT_ActiveFilterStatistics"
+	AMTGeneralSystemDefenseCapabilities string = "AMT_GeneralSystemDefenseCapabilities"
+	AMTHdr8021Filter                    string = "AMT_Hdr8021Filter"
+	AMTIPHeadersFilter                  string = "AMT_IPHeadersFilter"
+	AMTNetworkFilter                    string = "AMT_NetworkFilter"
+	AMTNetworkPortSystemDefensePolicy   string = "AMT_NetworkPortSystemDefensePolicy"
+	AMTSystemDefensePolicy              string = "AMT_SystemDefensePolicy"
+	CIMEthernetPort                     string = "CIM_EthernetPort"
+	GetTimeout                          string = "GetTimeout"
+	SetTimeout                          string = "SetTimeout"
+	UpdateStatistics                    string = "UpdateStatistics"
+	ValueNotFound                       string = "Value not found in map"
+)
+
+const (
+	FilterDirectionTransmit FilterDirection = iota
+	FilterDirectionReceive
+)
+
+// filterDirectionToString is a map of FilterDirection values to their string representation.
+var filterDirectionToString = map[FilterDirection]string{
+	FilterDirectionTransmit: "Transmit",
+	FilterDirectionReceive:  "Receive",
+}
+
+// String returns the string representation of the FilterDirection value.
+func (f FilterDirection) String() string {
+	if value, exists := filterDirectionToString[f]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	FilterProfilePass FilterProfile = iota
+	FilterProfileDrop
+	FilterProfileRateLimit
+)
+
+// filterProfileToString is a map of FilterProfile values to their string representation.
+var filterProfileToString = map[FilterProfile]string{
+	FilterProfilePass:      "Pass",
+	FilterProfileDrop:      "Drop",
+	FilterProfileRateLimit: "RateLimit",
+}
+
+// String returns the string representation of the FilterProfile value.
+func (f FilterProfile) String() string {
+	if value, exists := filterProfileToString[f]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	IPVersion4 IPVersion = 4
+	IPVersion6 IPVersion = 6
+)
+
+// ipVersionToString is a map of IPVersion values to their string representation.
+var ipVersionToString = map[IPVersion]string{
+	IPVersion4: "IPv4",
+	IPVersion6: "IPv6",
+}
+
+// String returns the string representation of the IPVersion value.
+func (i IPVersion) String() string {
+	if value, exists := ipVersionToString[i]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ProtocolICMP   ProtocolID = 1
+	ProtocolTCP    ProtocolID = 6
+	ProtocolUDP    ProtocolID = 17
+	ProtocolICMPv6 ProtocolID = 58
+)
+
+// protocolIDToString is a map of ProtocolID values to their string representation.
+var protocolIDToString = map[ProtocolID]string{
+	ProtocolICMP:   "ICMP",
+	ProtocolTCP:    "TCP",
+	ProtocolUDP:    "UDP",
+	ProtocolICMPv6: "ICMPv6",
+}
+
+// String returns the string representation of the ProtocolID value.
+func (p ProtocolID) String() string {
+	if value, exists := protocolIDToString[p]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	PTStatusSuccess          ReturnValue = 0
+	PTStatusInternalError    ReturnValue = 1
+	PTStatusNotPermitted     ReturnValue = 16
+	PTStatusMaxLimitReached  ReturnValue = 23
+	PTStatusInvalidParameter ReturnValue = 36
+	PTStatusFlashWriteLimit  ReturnValue = 38
+	PTStatusInvalidHandle    ReturnValue = 2053
+	PTStatusDuplicate        ReturnValue = 2058
+)
+
+// returnValueToString is a map of ReturnValue values to their string representation.
+var returnValueToString = map[ReturnValue]string{
+	PTStatusSuccess:          "Success",
+	PTStatusInternalError:    "InternalError",
+	PTStatusNotPermitted:     "NotPermitted",
+	PTStatusMaxLimitReached:  "MaxLimitReached",
+	PTStatusInvalidParameter: "InvalidParameter",
+	PTStatusFlashWriteLimit:  "FlashWriteLimitExceeded",
+	PTStatusInvalidHandle:    "InvalidHandle",
+	PTStatusDuplicate:        "Duplicate",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
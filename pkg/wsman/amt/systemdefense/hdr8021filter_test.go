@@ -0,0 +1,304 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	hdr8021FilterName     = "Intel(r) AMT:802.1 Filter 5"
+	hdr8021FilterSelector = "<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT:802.1 Filter 5</w:Selector></w:SelectorSet>"
+	hdr8021FilterBody     = "<h:AMT_Hdr8021Filter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Hdr8021Filter\"><h:ElementName>Drop ARP</h:ElementName><h:IsNegated>false</h:IsNegated><h:ActionEventOnMatch>true</h:ActionEventOnMatch><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>1</h:FilterProfile><h:HdrProtocolID8021>2054</h:HdrProtocolID8021></h:AMT_Hdr8021Filter>"
+)
+
+var (
+	dropARPFilter = Hdr8021FilterResponse{
+		XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTHdr8021Filter), Local: AMTHdr8021Filter},
+		CreationClassName:       AMTHdr8021Filter,
+		Name:                    hdr8021FilterName,
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		ElementName:             "Drop ARP",
+		ActionEventOnMatch:      true,
+		FilterDirection:         FilterDirectionReceive,
+		FilterProfile:           FilterProfileDrop,
+		HdrProtocolID8021:       0x0806,
+	}
+	dropARPFilterRequest = Hdr8021FilterRequest{
+		ElementName:        "Drop ARP",
+		ActionEventOnMatch: true,
+		FilterDirection:    FilterDirectionReceive,
+		FilterProfile:      FilterProfileDrop,
+		HdrProtocolID8021:  0x0806,
+	}
+)
+
+func TestPositiveAMT_Hdr8021Filter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/hdr8021filter",
+	}
+	elementUnderTest := NewHdr8021FilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_Hdr8021Filter Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_Hdr8021Filter Get wsman message",
+				AMTHdr8021Filter,
+				wsmantesting.Get,
+				hdr8021FilterSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(hdr8021FilterName)
+				},
+				Body{
+					XMLName:                  xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					Hdr8021FilterGetResponse: dropARPFilter,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_Hdr8021Filter Enumerate wsman message",
+				AMTHdr8021Filter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_Hdr8021Filter Pull wsman message",
+				AMTHdr8021Filter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:            xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						Hdr8021FilterItems: []Hdr8021FilterResponse{dropARPFilter},
+					},
+				},
+			},
+			// CREATES
+			{
+				"should create a valid AMT_Hdr8021Filter Create wsman message",
+				AMTHdr8021Filter,
+				wsmantesting.Create,
+				"",
+				hdr8021FilterBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(dropARPFilterRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParametersResponse{
+							ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTHdr8021Filter),
+							SelectorSet: SelectorSetResponse{
+								Selectors: []SelectorResponse{
+									{Name: "CreationClassName", Text: AMTHdr8021Filter},
+									{Name: "Name", Text: hdr8021FilterName},
+									{Name: "SystemCreationClassName", Text: "CIM_ComputerSystem"},
+									{Name: "SystemName", Text: "Intel(r) AMT"},
+								},
+							},
+						},
+					},
+				},
+			},
+			// PUTS
+			{
+				"should create a valid AMT_Hdr8021Filter Put wsman message",
+				AMTHdr8021Filter,
+				wsmantesting.Put,
+				hdr8021FilterSelector,
+				hdr8021FilterBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(hdr8021FilterName, dropARPFilterRequest)
+				},
+				Body{
+					XMLName:                  xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					Hdr8021FilterGetResponse: dropARPFilter,
+				},
+			},
+			// DELETE
+			{
+				"should create a valid AMT_Hdr8021Filter Delete wsman message",
+				AMTHdr8021Filter,
+				wsmantesting.Delete,
+				hdr8021FilterSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(hdr8021FilterName)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_Hdr8021Filter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/hdr8021filter",
+	}
+	elementUnderTest := NewHdr8021FilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_Hdr8021Filter Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_Hdr8021Filter Get wsman message fails",
+				AMTHdr8021Filter,
+				wsmantesting.Get,
+				hdr8021FilterSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(hdr8021FilterName)
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Enumerate wsman message fails",
+				AMTHdr8021Filter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Pull wsman message fails",
+				AMTHdr8021Filter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Create wsman message fails",
+				AMTHdr8021Filter,
+				wsmantesting.Create,
+				"",
+				hdr8021FilterBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(dropARPFilterRequest)
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Put wsman message fails",
+				AMTHdr8021Filter,
+				wsmantesting.Put,
+				hdr8021FilterSelector,
+				hdr8021FilterBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put(hdr8021FilterName, dropARPFilterRequest)
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Delete wsman message fails",
+				AMTHdr8021Filter,
+				wsmantesting.Delete,
+				hdr8021FilterSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(hdr8021FilterName)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,158 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewIPHeadersFilterWithClient instantiates a new IPHeadersFilter.
+func NewIPHeadersFilterWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) IPHeadersFilter {
+	return IPHeadersFilter{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTIPHeadersFilter, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (filter IPHeadersFilter) Get(name string) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "Name",
+		Value: name,
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (filter IPHeadersFilter) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (filter IPHeadersFilter) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create adds a filter on the IP header of packets. The creation handle of the new filter, which a policy uses to refer to it, is the trailing number of the Name selector in the response.
+func (filter IPHeadersFilter) Create(ipHeadersFilter IPHeadersFilterRequest) (response Response, err error) {
+	ipHeadersFilter.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTIPHeadersFilter)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Create(ipHeadersFilter, nil),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Put will change properties of the selected instance.
+func (filter IPHeadersFilter) Put(name string, ipHeadersFilter IPHeadersFilterRequest) (response Response, err error) {
+	ipHeadersFilter.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTIPHeadersFilter)
+	selector := []message.Selector{{
+		Name:  "Name",
+		Value: name,
+	}}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Put(ipHeadersFilter, true, selector),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete removes a the specified instance.
+func (filter IPHeadersFilter) Delete(name string) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Delete(selector),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,319 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	ipFilterName     = "Intel(r) AMT:IP Filter 1"
+	ipFilterSelector = "<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT:IP Filter 1</w:Selector></w:SelectorSet>"
+	ipFilterBody     = "<h:AMT_IPHeadersFilter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_IPHeadersFilter\"><h:ElementName>Host Isolation</h:ElementName><h:IsNegated>false</h:IsNegated><h:ActionEventOnMatch>false</h:ActionEventOnMatch><h:FilterDirection>0</h:FilterDirection><h:FilterProfile>0</h:FilterProfile><h:HdrIPVersion>4</h:HdrIPVersion><h:HdrDestAddress>192</h:HdrDestAddress><h:HdrDestAddress>168</h:HdrDestAddress><h:HdrDestAddress>0</h:HdrDestAddress><h:HdrDestAddress>10</h:HdrDestAddress><h:HdrDestMask>255</h:HdrDestMask><h:HdrDestMask>255</h:HdrDestMask><h:HdrDestMask>255</h:HdrDestMask><h:HdrDestMask>255</h:HdrDestMask></h:AMT_IPHeadersFilter>"
+)
+
+var (
+	transmitFilter = IPHeadersFilterResponse{
+		XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTIPHeadersFilter), Local: AMTIPHeadersFilter},
+		CreationClassName:       AMTIPHeadersFilter,
+		Name:                    ipFilterName,
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		ElementName:             IsolationPolicyName,
+		FilterDirection:         FilterDirectionTransmit,
+		FilterProfile:           FilterProfilePass,
+		HdrIPVersion:            IPVersion4,
+		HdrDestAddress:          []int{192, 168, 0, 10},
+		HdrDestMask:             []int{255, 255, 255, 255},
+	}
+	receiveFilter = IPHeadersFilterResponse{
+		XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTIPHeadersFilter), Local: AMTIPHeadersFilter},
+		CreationClassName:       AMTIPHeadersFilter,
+		Name:                    "Intel(r) AMT:IP Filter 2",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		ElementName:             IsolationPolicyName,
+		FilterDirection:         FilterDirectionReceive,
+		FilterProfile:           FilterProfilePass,
+		HdrIPVersion:            IPVersion4,
+		HdrSrcAddress:           []int{192, 168, 0, 10},
+		HdrSrcMask:              []int{255, 255, 255, 255},
+	}
+	transmitFilterRequest = IPHeadersFilterRequest{
+		ElementName:     IsolationPolicyName,
+		FilterDirection: FilterDirectionTransmit,
+		FilterProfile:   FilterProfilePass,
+		HdrIPVersion:    IPVersion4,
+		HdrDestAddress:  []int{192, 168, 0, 10},
+		HdrDestMask:     []int{255, 255, 255, 255},
+	}
+)
+
+func TestPositiveAMT_IPHeadersFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/ipheadersfilter",
+	}
+	elementUnderTest := NewIPHeadersFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_IPHeadersFilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_IPHeadersFilter Get wsman message",
+				AMTIPHeadersFilter,
+				wsmantesting.Get,
+				ipFilterSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(ipFilterName)
+				},
+				Body{
+					XMLName:                    xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					IPHeadersFilterGetResponse: transmitFilter,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_IPHeadersFilter Enumerate wsman message",
+				AMTIPHeadersFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_IPHeadersFilter Pull wsman message",
+				AMTIPHeadersFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:              xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						IPHeadersFilterItems: []IPHeadersFilterResponse{transmitFilter, receiveFilter},
+					},
+				},
+			},
+			// CREATES
+			{
+				"should create a valid AMT_IPHeadersFilter Create wsman message",
+				AMTIPHeadersFilter,
+				wsmantesting.Create,
+				"",
+				ipFilterBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(transmitFilterRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParametersResponse{
+							ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTIPHeadersFilter),
+							SelectorSet: SelectorSetResponse{
+								Selectors: []SelectorResponse{
+									{Name: "CreationClassName", Text: AMTIPHeadersFilter},
+									{Name: "Name", Text: ipFilterName},
+									{Name: "SystemCreationClassName", Text: "CIM_ComputerSystem"},
+									{Name: "SystemName", Text: "Intel(r) AMT"},
+								},
+							},
+						},
+					},
+				},
+			},
+			// PUTS
+			{
+				"should create a valid AMT_IPHeadersFilter Put wsman message",
+				AMTIPHeadersFilter,
+				wsmantesting.Put,
+				ipFilterSelector,
+				ipFilterBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(ipFilterName, transmitFilterRequest)
+				},
+				Body{
+					XMLName:                    xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					IPHeadersFilterGetResponse: transmitFilter,
+				},
+			},
+			// DELETE
+			{
+				"should create a valid AMT_IPHeadersFilter Delete wsman message",
+				AMTIPHeadersFilter,
+				wsmantesting.Delete,
+				ipFilterSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(ipFilterName)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_IPHeadersFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/ipheadersfilter",
+	}
+	elementUnderTest := NewIPHeadersFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_IPHeadersFilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_IPHeadersFilter Get wsman message fails",
+				AMTIPHeadersFilter,
+				wsmantesting.Get,
+				ipFilterSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(ipFilterName)
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Enumerate wsman message fails",
+				AMTIPHeadersFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Pull wsman message fails",
+				AMTIPHeadersFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Create wsman message fails",
+				AMTIPHeadersFilter,
+				wsmantesting.Create,
+				"",
+				ipFilterBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(transmitFilterRequest)
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Put wsman message fails",
+				AMTIPHeadersFilter,
+				wsmantesting.Put,
+				ipFilterSelector,
+				ipFilterBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put(ipFilterName, transmitFilterRequest)
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Delete wsman message fails",
+				AMTIPHeadersFilter,
+				wsmantesting.Delete,
+				ipFilterSelector,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(ipFilterName)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
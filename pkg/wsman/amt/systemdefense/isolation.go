@@ -0,0 +1,313 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+const (
+	// IsolationPolicyName is the PolicyName of the policies created by Isolation.Isolate, which is how Isolation.Restore finds them.
+	IsolationPolicyName = "Host Isolation"
+	// IsolationPolicyPrecedence is the precedence of the isolation policy, chosen to win over the policies created by other tools.
+	IsolationPolicyPrecedence = 1000
+)
+
+var (
+	// ErrNoCreationHandle is returned when the response to the creation of a filter or policy holds no reference to the new instance.
+	ErrNoCreationHandle = errors.New("response holds no reference to the created instance")
+	// ErrInvalidAddress is returned for an allowed address that is neither an IPv4 nor an IPv6 address.
+	ErrInvalidAddress = errors.New("invalid IP address")
+)
+
+// Isolation cuts the host off the network through System Defense, leaving only the traffic with the given peers.
+//
+// System Defense filters only the traffic of the host operating system, so the management traffic of Intel® AMT
+// itself, such as WS-Man, KVM and CIRA, keeps flowing while the host is isolated.
+type Isolation struct {
+	Policy            Policy
+	IPHeadersFilter   IPHeadersFilter
+	NetworkFilter     NetworkFilter
+	NetworkPortPolicy NetworkPortPolicy
+}
+
+// NewIsolationWithClient instantiates a new Isolation.
+func NewIsolationWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Isolation {
+	return Isolation{
+		Policy:            NewPolicyWithClient(wsmanMessageCreator, client),
+		IPHeadersFilter:   NewIPHeadersFilterWithClient(wsmanMessageCreator, client),
+		NetworkFilter:     NewNetworkFilterWithClient(wsmanMessageCreator, client),
+		NetworkPortPolicy: NewNetworkPortPolicyWithClient(wsmanMessageCreator, client),
+	}
+}
+
+// Isolate drops all the host traffic on the CIM_EthernetPort with the given DeviceID, except the traffic to and from the allowed addresses.
+// It creates a pass filter in each direction for every allowed address, a policy that drops everything else, and activates the policy on the port.
+// When a step fails, the instances created by the previous steps are deleted again on a best-effort basis.
+// It returns the InstanceID of the new policy.
+func (isolation Isolation) Isolate(ethernetPortDeviceID string, allowed ...net.IP) (policyInstanceID string, err error) {
+	var (
+		filterNames []string
+		createdID   string
+	)
+
+	defer func() {
+		if err != nil {
+			isolation.rollback(createdID, filterNames)
+		}
+	}()
+
+	handles := []int{}
+
+	for _, address := range allowed {
+		requests, requestErr := passFilters(address)
+		if requestErr != nil {
+			return "", requestErr
+		}
+
+		for _, request := range requests {
+			response, createErr := isolation.IPHeadersFilter.Create(request)
+			if createErr != nil {
+				return "", createErr
+			}
+
+			name := response.Body.CreateResponse.ReferenceParameters.SelectorSet.value("Name")
+
+			handle, handleErr := CreationHandle(name)
+			if handleErr != nil {
+				return "", handleErr
+			}
+
+			filterNames = append(filterNames, name)
+			handles = append(handles, handle)
+		}
+	}
+
+	response, err := isolation.Policy.Create(PolicyRequest{
+		ElementName:           IsolationPolicyName,
+		PolicyName:            IsolationPolicyName,
+		PolicyPrecedence:      IsolationPolicyPrecedence,
+		FilterCreationHandles: handles,
+		TxDefaultDrop:         true,
+		RxDefaultDrop:         true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	createdID = response.Body.CreateResponse.ReferenceParameters.SelectorSet.value("InstanceID")
+	if createdID == "" {
+		return "", ErrNoCreationHandle
+	}
+
+	_, err = isolation.NetworkPortPolicy.Create(ethernetPortDeviceID, createdID)
+	if err != nil {
+		return "", err
+	}
+
+	return createdID, nil
+}
+
+// Restore undoes Isolate. It deactivates and deletes every policy named IsolationPolicyName, along with the filters of those policies.
+func (isolation Isolation) Restore() error {
+	policies, err := isolation.pullPolicies()
+	if err != nil {
+		return err
+	}
+
+	portPolicies, err := isolation.pullPortPolicies()
+	if err != nil {
+		return err
+	}
+
+	filterNames, err := isolation.pullFilterNames()
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if policy.PolicyName != IsolationPolicyName {
+			continue
+		}
+
+		for _, portPolicy := range portPolicies {
+			if portPolicy.PolicySet.ReferenceParameters.SelectorSet.value("InstanceID") != policy.InstanceID {
+				continue
+			}
+
+			_, err = isolation.NetworkPortPolicy.Delete(portPolicy.ManagedElement.ReferenceParameters.SelectorSet.value("DeviceID"), policy.InstanceID)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = isolation.Policy.Delete(policy.InstanceID)
+		if err != nil {
+			return err
+		}
+
+		for _, handle := range policy.FilterCreationHandles {
+			name, ok := filterNames[handle]
+			if !ok {
+				continue
+			}
+
+			_, err = isolation.NetworkFilter.Delete(name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreationHandle returns the creation handle of the filter with the given Name, which is the trailing number of the name.
+func CreationHandle(name string) (int, error) {
+	index := strings.LastIndexFunc(name, func(r rune) bool { return r < '0' || r > '9' })
+	if index == len(name)-1 {
+		return 0, ErrNoCreationHandle
+	}
+
+	return strconv.Atoi(name[index+1:])
+}
+
+// rollback deletes the policy and filters created by a failed Isolate.
+func (isolation Isolation) rollback(policyInstanceID string, filterNames []string) {
+	if policyInstanceID != "" {
+		_, _ = isolation.Policy.Delete(policyInstanceID)
+	}
+
+	for _, name := range filterNames {
+		_, _ = isolation.NetworkFilter.Delete(name)
+	}
+}
+
+func (isolation Isolation) pullPolicies() ([]PolicyResponse, error) {
+	response, err := isolation.Policy.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err = isolation.Policy.Pull(response.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Body.PullResponse.PolicyItems, nil
+}
+
+func (isolation Isolation) pullPortPolicies() ([]NetworkPortPolicyResponse, error) {
+	response, err := isolation.NetworkPortPolicy.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err = isolation.NetworkPortPolicy.Pull(response.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Body.PullResponse.NetworkPortPolicyItems, nil
+}
+
+// pullFilterNames returns the Name of every filter by creation handle.
+func (isolation Isolation) pullFilterNames() (map[int]string, error) {
+	response, err := isolation.NetworkFilter.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err = isolation.NetworkFilter.Pull(response.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, filter := range response.Body.PullResponse.IPHeadersFilterItems {
+		names = append(names, filter.Name)
+	}
+
+	for _, filter := range response.Body.PullResponse.Hdr8021FilterItems {
+		names = append(names, filter.Name)
+	}
+
+	for _, filter := range response.Body.PullResponse.NetworkFilterItems {
+		names = append(names, filter.Name)
+	}
+
+	filterNames := map[int]string{}
+
+	for _, name := range names {
+		handle, err := CreationHandle(name)
+		if err != nil {
+			continue
+		}
+
+		filterNames[handle] = name
+	}
+
+	return filterNames, nil
+}
+
+// passFilters returns the filters that let the traffic to and from address through.
+func passFilters(address net.IP) ([]IPHeadersFilterRequest, error) {
+	version := IPVersion4
+	bytes := address.To4()
+
+	if bytes == nil {
+		version = IPVersion6
+		bytes = address.To16()
+	}
+
+	if bytes == nil {
+		return nil, ErrInvalidAddress
+	}
+
+	addressBytes := make([]int, len(bytes))
+	maskBytes := make([]int, len(bytes))
+
+	for i, b := range bytes {
+		addressBytes[i] = int(b)
+		maskBytes[i] = 0xff
+	}
+
+	return []IPHeadersFilterRequest{
+		{
+			ElementName:     IsolationPolicyName,
+			FilterDirection: FilterDirectionTransmit,
+			FilterProfile:   FilterProfilePass,
+			HdrIPVersion:    version,
+			HdrDestAddress:  addressBytes,
+			HdrDestMask:     maskBytes,
+		},
+		{
+			ElementName:     IsolationPolicyName,
+			FilterDirection: FilterDirectionReceive,
+			FilterProfile:   FilterProfilePass,
+			HdrIPVersion:    version,
+			HdrSrcAddress:   addressBytes,
+			HdrSrcMask:      maskBytes,
+		},
+	}, nil
+}
+
+// value returns the text of the selector with the given name, or an empty string.
+func (s SelectorSetResponse) value(name string) string {
+	for _, selector := range s.Selectors {
+		if selector.Name == name {
+			return selector.Text
+		}
+	}
+
+	return ""
+}
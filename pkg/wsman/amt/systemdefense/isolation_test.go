@@ -0,0 +1,186 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var errPortPolicy = errors.New("port policy failed")
+
+func TestIsolate(t *testing.T) {
+	client := wsmantesting.RoutingClient{
+		Responses: map[string][]string{
+			"AMT_IPHeadersFilter/Create":                {"amt/systemdefense/ipheadersfilter/create", "amt/systemdefense/ipheadersfilter/createreceive"},
+			"AMT_SystemDefensePolicy/Create":            {"amt/systemdefense/policy/create"},
+			"AMT_NetworkPortSystemDefensePolicy/Create": {"amt/systemdefense/networkportpolicy/create"},
+		},
+	}
+	isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	policyID, err := isolation.Isolate(ethernetPortDeviceID, net.ParseIP("192.168.0.10"))
+	assert.NoError(t, err)
+	assert.Equal(t, policyInstanceID, policyID)
+	assert.Equal(t, []string{
+		"AMT_IPHeadersFilter/Create",
+		"AMT_IPHeadersFilter/Create",
+		"AMT_SystemDefensePolicy/Create",
+		"AMT_NetworkPortSystemDefensePolicy/Create",
+	}, client.Requests)
+	assert.Contains(t, client.Messages[0], "<h:FilterDirection>0</h:FilterDirection>")
+	assert.Contains(t, client.Messages[0], "<h:HdrDestAddress>192</h:HdrDestAddress><h:HdrDestAddress>168</h:HdrDestAddress><h:HdrDestAddress>0</h:HdrDestAddress><h:HdrDestAddress>10</h:HdrDestAddress>")
+	assert.Contains(t, client.Messages[1], "<h:FilterDirection>1</h:FilterDirection>")
+	assert.Contains(t, client.Messages[1], "<h:HdrSrcAddress>192</h:HdrSrcAddress>")
+	assert.Contains(t, client.Messages[2], "<h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:TxDefaultDrop>true</h:TxDefaultDrop>")
+	assert.Contains(t, client.Messages[2], "<h:RxDefaultDrop>true</h:RxDefaultDrop>")
+	assert.Contains(t, client.Messages[3], "<w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 0</w:Selector>")
+}
+
+func TestIsolateIPv6(t *testing.T) {
+	client := wsmantesting.RoutingClient{
+		Responses: map[string][]string{
+			"AMT_IPHeadersFilter/Create":                {"amt/systemdefense/ipheadersfilter/create", "amt/systemdefense/ipheadersfilter/createreceive"},
+			"AMT_SystemDefensePolicy/Create":            {"amt/systemdefense/policy/create"},
+			"AMT_NetworkPortSystemDefensePolicy/Create": {"amt/systemdefense/networkportpolicy/create"},
+		},
+	}
+	isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	_, err := isolation.Isolate(ethernetPortDeviceID, net.ParseIP("fe80::1"))
+	assert.NoError(t, err)
+	assert.Contains(t, client.Messages[0], "<h:HdrIPVersion>6</h:HdrIPVersion>")
+	assert.Equal(t, 16, strings.Count(client.Messages[0], "<h:HdrDestMask>255</h:HdrDestMask>"))
+}
+
+func TestIsolateWithoutAllowedAddresses(t *testing.T) {
+	client := wsmantesting.RoutingClient{
+		Responses: map[string][]string{
+			"AMT_SystemDefensePolicy/Create":            {"amt/systemdefense/policy/create"},
+			"AMT_NetworkPortSystemDefensePolicy/Create": {"amt/systemdefense/networkportpolicy/create"},
+		},
+	}
+	isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	policyID, err := isolation.Isolate(ethernetPortDeviceID)
+	assert.NoError(t, err)
+	assert.Equal(t, policyInstanceID, policyID)
+	assert.NotContains(t, client.Messages[0], "FilterCreationHandles")
+}
+
+func TestIsolateRollsBack(t *testing.T) {
+	client := wsmantesting.RoutingClient{
+		Responses: map[string][]string{
+			"AMT_IPHeadersFilter/Create":     {"amt/systemdefense/ipheadersfilter/create", "amt/systemdefense/ipheadersfilter/createreceive"},
+			"AMT_SystemDefensePolicy/Create": {"amt/systemdefense/policy/create"},
+			"AMT_SystemDefensePolicy/Delete": {"amt/systemdefense/policy/delete"},
+			"AMT_NetworkFilter/Delete":       {"amt/systemdefense/networkfilter/delete"},
+		},
+		Failures: map[string]error{
+			"AMT_NetworkPortSystemDefensePolicy/Create": errPortPolicy,
+		},
+	}
+	isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	policyID, err := isolation.Isolate(ethernetPortDeviceID, net.ParseIP("192.168.0.10"))
+	assert.ErrorIs(t, err, errPortPolicy)
+	assert.Empty(t, policyID)
+	assert.Equal(t, []string{
+		"AMT_IPHeadersFilter/Create",
+		"AMT_IPHeadersFilter/Create",
+		"AMT_SystemDefensePolicy/Create",
+		"AMT_NetworkPortSystemDefensePolicy/Create",
+		"AMT_SystemDefensePolicy/Delete",
+		"AMT_NetworkFilter/Delete",
+		"AMT_NetworkFilter/Delete",
+	}, client.Requests)
+	assert.Contains(t, client.Messages[5], "<w:Selector Name=\"Name\">Intel(r) AMT:IP Filter 1</w:Selector>")
+	assert.Contains(t, client.Messages[6], "<w:Selector Name=\"Name\">Intel(r) AMT:IP Filter 2</w:Selector>")
+}
+
+func TestIsolateInvalidAddress(t *testing.T) {
+	client := wsmantesting.RoutingClient{}
+	isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	_, err := isolation.Isolate(ethernetPortDeviceID, net.IP{1, 2})
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+	assert.Empty(t, client.Requests)
+}
+
+func TestRestore(t *testing.T) {
+	client := wsmantesting.RoutingClient{
+		Responses: map[string][]string{
+			"AMT_SystemDefensePolicy/Enumerate":            {"amt/systemdefense/policy/enumerate"},
+			"AMT_SystemDefensePolicy/Pull":                 {"amt/systemdefense/policy/pull"},
+			"AMT_SystemDefensePolicy/Delete":               {"amt/systemdefense/policy/delete"},
+			"AMT_NetworkPortSystemDefensePolicy/Enumerate": {"amt/systemdefense/networkportpolicy/enumerate"},
+			"AMT_NetworkPortSystemDefensePolicy/Pull":      {"amt/systemdefense/networkportpolicy/pull"},
+			"AMT_NetworkPortSystemDefensePolicy/Delete":    {"amt/systemdefense/networkportpolicy/delete"},
+			"AMT_NetworkFilter/Enumerate":                  {"amt/systemdefense/networkfilter/enumerate"},
+			"AMT_NetworkFilter/Pull":                       {"amt/systemdefense/networkfilter/pull"},
+			"AMT_NetworkFilter/Delete":                     {"amt/systemdefense/networkfilter/delete"},
+		},
+	}
+	isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	err := isolation.Restore()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"AMT_SystemDefensePolicy/Enumerate",
+		"AMT_SystemDefensePolicy/Pull",
+		"AMT_NetworkPortSystemDefensePolicy/Enumerate",
+		"AMT_NetworkPortSystemDefensePolicy/Pull",
+		"AMT_NetworkFilter/Enumerate",
+		"AMT_NetworkFilter/Pull",
+		"AMT_NetworkPortSystemDefensePolicy/Delete",
+		"AMT_SystemDefensePolicy/Delete",
+		"AMT_NetworkFilter/Delete",
+		"AMT_NetworkFilter/Delete",
+	}, client.Requests)
+	assert.Contains(t, client.Messages[6], networkPortPolicySelectors)
+	assert.Contains(t, client.Messages[7], "<w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 0</w:Selector>")
+	assert.Contains(t, client.Messages[8], "<w:Selector Name=\"Name\">Intel(r) AMT:IP Filter 1</w:Selector>")
+	assert.Contains(t, client.Messages[9], "<w:Selector Name=\"Name\">Intel(r) AMT:IP Filter 2</w:Selector>")
+}
+
+func TestRestoreFails(t *testing.T) {
+	client := wsmantesting.RoutingClient{
+		Failures: map[string]error{
+			"AMT_SystemDefensePolicy/Enumerate": errPortPolicy,
+		},
+	}
+	isolation := NewIsolationWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	err := isolation.Restore()
+	assert.ErrorIs(t, err, errPortPolicy)
+}
+
+func TestCreationHandle(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected int
+		err      error
+	}{
+		{"Intel(r) AMT:IP Filter 1", 1, nil},
+		{"Intel(r) AMT:802.1 Filter 12", 12, nil},
+		{"42", 42, nil},
+		{"Intel(r) AMT:IP Filter", 0, ErrNoCreationHandle},
+		{"", 0, ErrNoCreationHandle},
+	}
+
+	for _, test := range tests {
+		handle, err := CreationHandle(test.name)
+		assert.Equal(t, test.expected, handle)
+		assert.Equal(t, test.err, err)
+	}
+}
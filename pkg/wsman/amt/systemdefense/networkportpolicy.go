@@ -0,0 +1,148 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewNetworkPortPolicyWithClient instantiates a new NetworkPortPolicy.
+func NewNetworkPortPolicyWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) NetworkPortPolicy {
+	return NetworkPortPolicy{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTNetworkPortSystemDefensePolicy, client),
+	}
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (portPolicy NetworkPortPolicy) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: portPolicy.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = portPolicy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (portPolicy NetworkPortPolicy) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: portPolicy.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = portPolicy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create activates the AMT_SystemDefensePolicy with the given InstanceID on the CIM_EthernetPort with the given DeviceID.
+func (portPolicy NetworkPortPolicy) Create(ethernetPortDeviceID, policyInstanceID string) (response Response, err error) {
+	request := NetworkPortPolicyRequest{
+		H:              fmt.Sprintf("%s%s", message.AMTSchema, AMTNetworkPortSystemDefensePolicy),
+		ManagedElement: ethernetPortReference(ethernetPortDeviceID),
+		PolicySet:      policyReference(policyInstanceID),
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: portPolicy.base.Create(request, nil),
+		},
+	}
+	// send the message to AMT
+	err = portPolicy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete deactivates the AMT_SystemDefensePolicy with the given InstanceID on the CIM_EthernetPort with the given DeviceID.
+func (portPolicy NetworkPortPolicy) Delete(ethernetPortDeviceID, policyInstanceID string) (response Response, err error) {
+	selectors := []message.Selector{
+		{
+			Name:  "ManagedElement",
+			Value: selectorReference(message.CIMSchema+CIMEthernetPort, "DeviceID", ethernetPortDeviceID),
+		},
+		{
+			Name:  "PolicySet",
+			Value: selectorReference(message.AMTSchema+AMTSystemDefensePolicy, "InstanceID", policyInstanceID),
+		},
+	}
+	header := portPolicy.base.WSManMessageCreator.CreateHeader(message.BaseActionsDelete, AMTNetworkPortSystemDefensePolicy, selectors, "", "")
+	response = Response{
+		Message: &client.Message{
+			XMLInput: portPolicy.base.WSManMessageCreator.CreateXML(header, message.DeleteBody),
+		},
+	}
+	// send the message to AMT
+	err = portPolicy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// ethernetPortReference returns a reference to the CIM_EthernetPort with the given DeviceID, such as "Intel(r) AMT Ethernet Port 0".
+func ethernetPortReference(deviceID string) EndpointReference {
+	return EndpointReference{
+		Address: "/wsman",
+		ReferenceParameters: ReferenceParameters{
+			ResourceURI: message.CIMSchema + CIMEthernetPort,
+			SelectorSet: SelectorSet{Selectors: []Selector{{Name: "DeviceID", Text: deviceID}}},
+		},
+	}
+}
+
+// policyReference returns a reference to the AMT_SystemDefensePolicy with the given InstanceID.
+func policyReference(instanceID string) EndpointReference {
+	return EndpointReference{
+		Address: "/wsman",
+		ReferenceParameters: ReferenceParameters{
+			ResourceURI: message.AMTSchema + AMTSystemDefensePolicy,
+			SelectorSet: SelectorSet{Selectors: []Selector{{Name: "InstanceID", Text: instanceID}}},
+		},
+	}
+}
+
+// selectorReference returns a reference to the instance of resourceURI with the given key, in the form used as the value of a header selector.
+func selectorReference(resourceURI, key, value string) string {
+	return fmt.Sprintf(`<a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%s</w:ResourceURI><w:SelectorSet><w:Selector Name=%q>%s</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference>`, resourceURI, key, value)
+}
@@ -0,0 +1,242 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	ethernetPortDeviceID       = "Intel(r) AMT Ethernet Port 0"
+	networkPortPolicyBody      = `<h:AMT_NetworkPortSystemDefensePolicy xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_NetworkPortSystemDefensePolicy"><h:ManagedElement><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</w:ResourceURI><w:SelectorSet><w:Selector Name="DeviceID">Intel(r) AMT Ethernet Port 0</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ManagedElement><h:PolicySet><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:Handle: 0</w:Selector></w:SelectorSet></a:ReferenceParameters></h:PolicySet></h:AMT_NetworkPortSystemDefensePolicy>`
+	networkPortPolicySelectors = `<w:SelectorSet><w:Selector Name="ManagedElement"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</w:ResourceURI><w:SelectorSet><w:Selector Name="DeviceID">Intel(r) AMT Ethernet Port 0</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector><w:Selector Name="PolicySet"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:Handle: 0</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector></w:SelectorSet>`
+)
+
+func endpointReference(resourceURI, name, value string) *EndpointReferenceResponse {
+	return &EndpointReferenceResponse{
+		Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+		ReferenceParameters: ReferenceParametersResponse{
+			ResourceURI: resourceURI,
+			SelectorSet: SelectorSetResponse{
+				Selectors: []SelectorResponse{{Name: name, Text: value}},
+			},
+		},
+	}
+}
+
+func TestPositiveAMT_NetworkPortSystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkportpolicy",
+	}
+	elementUnderTest := NewNetworkPortPolicyWithClient(wsmanMessageCreator, &client)
+	ethernetPort := endpointReference(fmt.Sprintf("%s%s", message.CIMSchema, CIMEthernetPort), "DeviceID", ethernetPortDeviceID)
+	policy := endpointReference(fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy), "InstanceID", policyInstanceID)
+
+	t.Run("amt_NetworkPortSystemDefensePolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// ENUMERATES
+			{
+				"should create a valid AMT_NetworkPortSystemDefensePolicy Enumerate wsman message",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_NetworkPortSystemDefensePolicy Pull wsman message",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						NetworkPortPolicyItems: []NetworkPortPolicyResponse{
+							{
+								XMLName:        xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTNetworkPortSystemDefensePolicy), Local: AMTNetworkPortSystemDefensePolicy},
+								ManagedElement: *ethernetPort,
+								PolicySet:      *policy,
+							},
+						},
+					},
+				},
+			},
+			// CREATES
+			{
+				"should create a valid AMT_NetworkPortSystemDefensePolicy Create wsman message",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Create,
+				"",
+				networkPortPolicyBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(ethernetPortDeviceID, policyInstanceID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParametersResponse{
+							ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTNetworkPortSystemDefensePolicy),
+							SelectorSet: SelectorSetResponse{
+								Selectors: []SelectorResponse{
+									{Name: "ManagedElement", EndpointReference: ethernetPort},
+									{Name: "PolicySet", EndpointReference: policy},
+								},
+							},
+						},
+					},
+				},
+			},
+			// DELETE
+			{
+				"should create a valid AMT_NetworkPortSystemDefensePolicy Delete wsman message",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Delete,
+				networkPortPolicySelectors,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(ethernetPortDeviceID, policyInstanceID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_NetworkPortSystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkportpolicy",
+	}
+	elementUnderTest := NewNetworkPortPolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_NetworkPortSystemDefensePolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_NetworkPortSystemDefensePolicy Enumerate wsman message fails",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_NetworkPortSystemDefensePolicy Pull wsman message fails",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_NetworkPortSystemDefensePolicy Create wsman message fails",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Create,
+				"",
+				networkPortPolicyBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(ethernetPortDeviceID, policyInstanceID)
+				},
+			},
+			{
+				"should handle error when AMT_NetworkPortSystemDefensePolicy Delete wsman message fails",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Delete,
+				networkPortPolicySelectors,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(ethernetPortDeviceID, policyInstanceID)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,250 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package systemdefense facilitates communication with Intel® AMT devices to filter the network traffic of the host with System Defense.
+//
+// Filters:
+// AMT_Hdr8021Filter and AMT_IPHeadersFilter match packets on their 802.1 or IP headers, and pass, drop or rate limit them.
+// AMT_NetworkFilter is the base class of both and lists every filter.
+//
+// Policy:
+// AMT_SystemDefensePolicy groups filters with the action for the packets that match none of them.
+// A policy is enforced once AMT_NetworkPortSystemDefensePolicy associates it with a network interface.
+//
+// Capabilities and Statistics:
+// AMT_GeneralSystemDefenseCapabilities reports how many filters, policies and counters can still be created,
+// and AMT_ActiveFilterStatistics counts the packets that matched each filter of an active policy.
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewPolicyWithClient instantiates a new Policy.
+func NewPolicyWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Policy {
+	return Policy{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTSystemDefensePolicy, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (policy Policy) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "InstanceID",
+		Value: instanceID,
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (policy Policy) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (policy Policy) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create adds a policy made of the filters listed in FilterCreationHandles. The policy has no effect until it is activated on a network interface with NetworkPortPolicy.Create.
+func (policy Policy) Create(systemDefensePolicy PolicyRequest) (response Response, err error) {
+	systemDefensePolicy.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Create(systemDefensePolicy, nil),
+		},
+	}
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Put will change properties of the selected instance.
+func (policy Policy) Put(instanceID string, systemDefensePolicy PolicyRequest) (response Response, err error) {
+	systemDefensePolicy.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy)
+	selector := []message.Selector{{
+		Name:  "InstanceID",
+		Value: instanceID,
+	}}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Put(systemDefensePolicy, true, selector),
+		},
+	}
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete removes a the specified instance.
+func (policy Policy) Delete(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Delete(selector),
+		},
+	}
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetTimeout returns the time, in seconds, after which Intel® AMT deactivates the policies that were activated by a heuristics or agent presence event.
+func (policy Policy) GetTimeout() (response Response, err error) {
+	header := policy.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSystemDefensePolicy, GetTimeout), AMTSystemDefensePolicy, nil, "", "")
+	body := policy.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetTimeout), AMTSystemDefensePolicy, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// SetTimeout sets the time, in seconds, after which Intel® AMT deactivates the policies that were activated by a heuristics or agent presence event.
+func (policy Policy) SetTimeout(timeout int) (response Response, err error) {
+	header := policy.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSystemDefensePolicy, SetTimeout), AMTSystemDefensePolicy, nil, "", "")
+	body := policy.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetTimeout), AMTSystemDefensePolicy, &SetTimeout_INPUT{Timeout: timeout})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdateStatistics refreshes the AMT_ActiveFilterStatistics instances of the CIM_EthernetPort with the given DeviceID, resetting the counters when resetOnRead is set.
+func (policy Policy) UpdateStatistics(ethernetPortDeviceID string, resetOnRead bool) (response Response, err error) {
+	header := policy.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTSystemDefensePolicy, UpdateStatistics), AMTSystemDefensePolicy, nil, "", "")
+	body := policy.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(UpdateStatistics), AMTSystemDefensePolicy, &UpdateStatistics_INPUT{
+		NetworkInterface: ethernetPortReference(ethernetPortDeviceID),
+		ResetOnRead:      resetOnRead,
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,394 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	policyInstanceID = "Intel(r) AMT:Handle: 0"
+	policyBody       = `<h:AMT_SystemDefensePolicy xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy"><h:ElementName>Host Isolation</h:ElementName><h:PolicyName>Host Isolation</h:PolicyName><h:PolicyPrecedence>1000</h:PolicyPrecedence><h:AntiSpoofingSupport>0</h:AntiSpoofingSupport><h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:TxDefaultDrop>true</h:TxDefaultDrop><h:TxDefaultMatchEvent>false</h:TxDefaultMatchEvent><h:TxDefaultCount>false</h:TxDefaultCount><h:RxDefaultDrop>true</h:RxDefaultDrop><h:RxDefaultMatchEvent>false</h:RxDefaultMatchEvent><h:RxDefaultCount>false</h:RxDefaultCount></h:AMT_SystemDefensePolicy>`
+	policyPutBody    = `<h:AMT_SystemDefensePolicy xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy"><h:InstanceID>Intel(r) AMT:Handle: 0</h:InstanceID><h:ElementName>Host Isolation</h:ElementName><h:PolicyName>Host Isolation</h:PolicyName><h:PolicyPrecedence>1000</h:PolicyPrecedence><h:AntiSpoofingSupport>0</h:AntiSpoofingSupport><h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:TxDefaultDrop>true</h:TxDefaultDrop><h:TxDefaultMatchEvent>false</h:TxDefaultMatchEvent><h:TxDefaultCount>false</h:TxDefaultCount><h:RxDefaultDrop>true</h:RxDefaultDrop><h:RxDefaultMatchEvent>false</h:RxDefaultMatchEvent><h:RxDefaultCount>false</h:RxDefaultCount></h:AMT_SystemDefensePolicy>`
+)
+
+var isolationPolicy = PolicyResponse{
+	XMLName:               xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy), Local: AMTSystemDefensePolicy},
+	InstanceID:            policyInstanceID,
+	ElementName:           IsolationPolicyName,
+	PolicyName:            IsolationPolicyName,
+	PolicyPrecedence:      1000,
+	FilterCreationHandles: []int{1, 2},
+	TxDefaultDrop:         true,
+	RxDefaultDrop:         true,
+}
+
+func TestPositiveAMT_SystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/policy",
+	}
+	elementUnderTest := NewPolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_SystemDefensePolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_SystemDefensePolicy Get wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 0</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(policyInstanceID)
+				},
+				Body{
+					XMLName:           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PolicyGetResponse: isolationPolicy,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_SystemDefensePolicy Enumerate wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_SystemDefensePolicy Pull wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						PolicyItems: []PolicyResponse{
+							isolationPolicy,
+							{
+								XMLName:               xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy), Local: AMTSystemDefensePolicy},
+								InstanceID:            "Intel(r) AMT:Handle: 1",
+								ElementName:           "Drop ARP",
+								PolicyName:            "Drop ARP",
+								PolicyPrecedence:      50,
+								FilterCreationHandles: []int{5},
+							},
+						},
+					},
+				},
+			},
+			// CREATES
+			{
+				"should create a valid AMT_SystemDefensePolicy Create wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Create,
+				"",
+				policyBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(PolicyRequest{
+						ElementName:           IsolationPolicyName,
+						PolicyName:            IsolationPolicyName,
+						PolicyPrecedence:      IsolationPolicyPrecedence,
+						FilterCreationHandles: []int{1, 2},
+						TxDefaultDrop:         true,
+						RxDefaultDrop:         true,
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParametersResponse{
+							ResourceURI: fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy),
+							SelectorSet: SelectorSetResponse{
+								Selectors: []SelectorResponse{{Name: "InstanceID", Text: policyInstanceID}},
+							},
+						},
+					},
+				},
+			},
+			// PUTS
+			{
+				"should create a valid AMT_SystemDefensePolicy Put wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 0</w:Selector></w:SelectorSet>",
+				policyPutBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(policyInstanceID, PolicyRequest{
+						InstanceID:            policyInstanceID,
+						ElementName:           IsolationPolicyName,
+						PolicyName:            IsolationPolicyName,
+						PolicyPrecedence:      IsolationPolicyPrecedence,
+						FilterCreationHandles: []int{1, 2},
+						TxDefaultDrop:         true,
+						RxDefaultDrop:         true,
+					})
+				},
+				Body{
+					XMLName:           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PolicyGetResponse: isolationPolicy,
+				},
+			},
+			// DELETE
+			{
+				"should create a valid AMT_SystemDefensePolicy Delete wsman message",
+				AMTSystemDefensePolicy,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 0</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(policyInstanceID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+			// GET TIMEOUT
+			{
+				"should create a valid AMT_SystemDefensePolicy GetTimeout wsman message",
+				AMTSystemDefensePolicy,
+				methods.GenerateAction(AMTSystemDefensePolicy, GetTimeout),
+				"",
+				"<h:GetTimeout_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"></h:GetTimeout_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetTimeout
+
+					return elementUnderTest.GetTimeout()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetTimeout_OUTPUT: GetTimeout_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy), Local: "GetTimeout_OUTPUT"},
+						Timeout:     600,
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			// SET TIMEOUT
+			{
+				"should create a valid AMT_SystemDefensePolicy SetTimeout wsman message",
+				AMTSystemDefensePolicy,
+				methods.GenerateAction(AMTSystemDefensePolicy, SetTimeout),
+				"",
+				"<h:SetTimeout_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"><h:Timeout>600</h:Timeout></h:SetTimeout_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = SetTimeout
+
+					return elementUnderTest.SetTimeout(600)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetTimeout_OUTPUT: SetTimeout_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy), Local: "SetTimeout_OUTPUT"},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+			// UPDATE STATISTICS
+			{
+				"should create a valid AMT_SystemDefensePolicy UpdateStatistics wsman message",
+				AMTSystemDefensePolicy,
+				methods.GenerateAction(AMTSystemDefensePolicy, UpdateStatistics),
+				"",
+				"<h:UpdateStatistics_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"><h:NetworkInterface><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</w:ResourceURI><w:SelectorSet><w:Selector Name=\"DeviceID\">Intel(r) AMT Ethernet Port 0</w:Selector></w:SelectorSet></a:ReferenceParameters></h:NetworkInterface><h:ResetOnRead>true</h:ResetOnRead></h:UpdateStatistics_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = UpdateStatistics
+
+					return elementUnderTest.UpdateStatistics("Intel(r) AMT Ethernet Port 0", true)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					UpdateStatistics_OUTPUT: UpdateStatistics_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTSystemDefensePolicy), Local: "UpdateStatistics_OUTPUT"},
+						ReturnValue: PTStatusSuccess,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_SystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/policy",
+	}
+	elementUnderTest := NewPolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_SystemDefensePolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_SystemDefensePolicy Get wsman message fails",
+				AMTSystemDefensePolicy,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 0</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(policyInstanceID)
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Enumerate wsman message fails",
+				AMTSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Pull wsman message fails",
+				AMTSystemDefensePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Create wsman message fails",
+				AMTSystemDefensePolicy,
+				wsmantesting.Create,
+				"",
+				policyBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(PolicyRequest{
+						ElementName:           IsolationPolicyName,
+						PolicyName:            IsolationPolicyName,
+						PolicyPrecedence:      IsolationPolicyPrecedence,
+						FilterCreationHandles: []int{1, 2},
+						TxDefaultDrop:         true,
+						RxDefaultDrop:         true,
+					})
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Delete wsman message fails",
+				AMTSystemDefensePolicy,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 0</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(policyInstanceID)
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy GetTimeout wsman message fails",
+				AMTSystemDefensePolicy,
+				methods.GenerateAction(AMTSystemDefensePolicy, GetTimeout),
+				"",
+				"<h:GetTimeout_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"></h:GetTimeout_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetTimeout()
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy SetTimeout wsman message fails",
+				AMTSystemDefensePolicy,
+				methods.GenerateAction(AMTSystemDefensePolicy, SetTimeout),
+				"",
+				"<h:SetTimeout_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"><h:Timeout>600</h:Timeout></h:SetTimeout_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetTimeout(600)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,319 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type ActiveFilterStatistics struct {
+	base message.Base
+}
+
+type Capabilities struct {
+	base message.Base
+}
+
+type Hdr8021Filter struct {
+	base message.Base
+}
+
+type IPHeadersFilter struct {
+	base message.Base
+}
+
+type NetworkFilter struct {
+	base message.Base
+}
+
+type NetworkPortPolicy struct {
+	base message.Base
+}
+
+type Policy struct {
+	base message.Base
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                           xml.Name `xml:"Body"`
+		ActiveFilterStatisticsGetResponse ActiveFilterStatisticsResponse
+		CapabilitiesGetResponse           CapabilitiesResponse
+		Hdr8021FilterGetResponse          Hdr8021FilterResponse
+		IPHeadersFilterGetResponse        IPHeadersFilterResponse
+		NetworkFilterGetResponse          NetworkFilterResponse
+		PolicyGetResponse                 PolicyResponse
+		EnumerateResponse                 common.EnumerateResponse
+		PullResponse                      PullResponse
+		CreateResponse                    CreateResponse
+		GetTimeout_OUTPUT                 GetTimeout_OUTPUT       `xml:"GetTimeout_OUTPUT"`
+		SetTimeout_OUTPUT                 SetTimeout_OUTPUT       `xml:"SetTimeout_OUTPUT"`
+		UpdateStatistics_OUTPUT           UpdateStatistics_OUTPUT `xml:"UpdateStatistics_OUTPUT"`
+	}
+	ActiveFilterStatisticsResponse struct {
+		XMLName              xml.Name `xml:"AMT_ActiveFilterStatistics"`
+		InstanceID           string   `xml:"InstanceID,omitempty"`       // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName          string   `xml:"ElementName,omitempty"`      // A user-friendly name for the object.
+		ActivationCount      int      `xml:"ActivationCount"`            // The number of packets that matched the filter since the statistics were last reset.
+		FilterCreationHandle int      `xml:"FilterCreationHandle"`       // The creation handle of the filter the statistics belong to.
+		NetworkInterface     string   `xml:"NetworkInterface,omitempty"` // The DeviceID of the CIM_EthernetPort the statistics were collected on.
+	}
+	CapabilitiesResponse struct {
+		XMLName                    xml.Name `xml:"AMT_GeneralSystemDefenseCapabilities"`
+		InstanceID                 string   `xml:"InstanceID,omitempty"`       // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName                string   `xml:"ElementName,omitempty"`      // A user-friendly name for the object.
+		GlobalMaxSupportedFilters  int      `xml:"GlobalMaxSupportedFilters"`  // The maximum number of filters that can be created.
+		GlobalMaxSupportedPolicies int      `xml:"GlobalMaxSupportedPolicies"` // The maximum number of policies that can be created.
+		GlobalMaxSupportedCounters int      `xml:"GlobalMaxSupportedCounters"` // The maximum number of filters that can count matching packets.
+		GlobalAvailableFilters     int      `xml:"GlobalAvailableFilters"`     // The number of filters that can still be created.
+		GlobalAvailablePolicies    int      `xml:"GlobalAvailablePolicies"`    // The number of policies that can still be created.
+		GlobalAvailableCounters    int      `xml:"GlobalAvailableCounters"`    // The number of counting filters that can still be created.
+	}
+	Hdr8021FilterResponse struct {
+		XMLName                 xml.Name        `xml:"AMT_Hdr8021Filter"`
+		CreationClassName       string          `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string          `xml:"Name,omitempty"`                    // The Name property defines the label by which the filter is known. In Intel AMT it ends with the creation handle of the filter, which is how policies refer to it.
+		SystemCreationClassName string          `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string          `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		ElementName             string          `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		IsNegated               bool            `xml:"IsNegated"`                         // Indicates whether the filter matches packets that do not match its header fields.
+		ActionEventOnMatch      bool            `xml:"ActionEventOnMatch"`                // Indicates whether an event is generated when a packet matches the filter.
+		FilterDirection         FilterDirection `xml:"FilterDirection"`                   // The direction of the traffic the filter applies to.
+		FilterProfile           FilterProfile   `xml:"FilterProfile"`                     // The action taken on a matching packet.
+		FilterProfileData       int             `xml:"FilterProfileData"`                 // The rate limit, in packets per second, when FilterProfile is RateLimit.
+		HdrSrcMACAddr8021       []int           `xml:"HdrSrcMACAddr8021,omitempty"`       // The 48-bit source MAC address field, one byte per element.
+		HdrDestMACAddr8021      []int           `xml:"HdrDestMACAddr8021,omitempty"`      // The 48-bit destination MAC address field, one byte per element.
+		HdrProtocolID8021       int             `xml:"HdrProtocolID8021,omitempty"`       // The 16-bit Ethernet frame type (EtherType).
+		HdrPriorityValue8021    int             `xml:"HdrPriorityValue8021,omitempty"`    // The 802.1Q priority.
+		HdrVLANID8021           int             `xml:"HdrVLANID8021,omitempty"`           // The 802.1Q VLAN ID.
+	}
+	IPHeadersFilterResponse struct {
+		XMLName                 xml.Name        `xml:"AMT_IPHeadersFilter"`
+		CreationClassName       string          `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string          `xml:"Name,omitempty"`                    // The Name property defines the label by which the filter is known. In Intel AMT it ends with the creation handle of the filter, which is how policies refer to it.
+		SystemCreationClassName string          `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string          `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		ElementName             string          `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		IsNegated               bool            `xml:"IsNegated"`                         // Indicates whether the filter matches packets that do not match its header fields.
+		ActionEventOnMatch      bool            `xml:"ActionEventOnMatch"`                // Indicates whether an event is generated when a packet matches the filter.
+		FilterDirection         FilterDirection `xml:"FilterDirection"`                   // The direction of the traffic the filter applies to.
+		FilterProfile           FilterProfile   `xml:"FilterProfile"`                     // The action taken on a matching packet.
+		FilterProfileData       int             `xml:"FilterProfileData"`                 // The rate limit, in packets per second, when FilterProfile is RateLimit.
+		HdrIPVersion            IPVersion       `xml:"HdrIPVersion"`                      // The IP version of the address fields.
+		HdrSrcAddress           []int           `xml:"HdrSrcAddress,omitempty"`           // The source address, one byte per element.
+		HdrSrcMask              []int           `xml:"HdrSrcMask,omitempty"`              // The mask applied to the source address, one byte per element.
+		HdrDestAddress          []int           `xml:"HdrDestAddress,omitempty"`          // The destination address, one byte per element.
+		HdrDestMask             []int           `xml:"HdrDestMask,omitempty"`             // The mask applied to the destination address, one byte per element.
+		HdrProtocolID           ProtocolID      `xml:"HdrProtocolID,omitempty"`           // The IP protocol number, for example TCP (6) or UDP (17).
+		HdrSrcPortStart         int             `xml:"HdrSrcPortStart,omitempty"`         // The first source port of the range matched by the filter.
+		HdrSrcPortEnd           int             `xml:"HdrSrcPortEnd,omitempty"`           // The last source port of the range matched by the filter.
+		HdrDestPortStart        int             `xml:"HdrDestPortStart,omitempty"`        // The first destination port of the range matched by the filter.
+		HdrDestPortEnd          int             `xml:"HdrDestPortEnd,omitempty"`          // The last destination port of the range matched by the filter.
+	}
+	NetworkFilterResponse struct {
+		XMLName                 xml.Name        `xml:"AMT_NetworkFilter"`
+		CreationClassName       string          `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string          `xml:"Name,omitempty"`                    // The Name property defines the label by which the filter is known. In Intel AMT it ends with the creation handle of the filter, which is how policies refer to it.
+		SystemCreationClassName string          `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string          `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		ElementName             string          `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		IsNegated               bool            `xml:"IsNegated"`                         // Indicates whether the filter matches packets that do not match its header fields.
+		ActionEventOnMatch      bool            `xml:"ActionEventOnMatch"`                // Indicates whether an event is generated when a packet matches the filter.
+		FilterDirection         FilterDirection `xml:"FilterDirection"`                   // The direction of the traffic the filter applies to.
+		FilterProfile           FilterProfile   `xml:"FilterProfile"`                     // The action taken on a matching packet.
+		FilterProfileData       int             `xml:"FilterProfileData"`                 // The rate limit, in packets per second, when FilterProfile is RateLimit.
+	}
+	PolicyResponse struct {
+		XMLName               xml.Name `xml:"AMT_SystemDefensePolicy"`
+		InstanceID            string   `xml:"InstanceID,omitempty"`            // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName           string   `xml:"ElementName,omitempty"`           // A user-friendly name for the object.
+		PolicyName            string   `xml:"PolicyName,omitempty"`            // A user-friendly name of the policy.
+		PolicyPrecedence      int      `xml:"PolicyPrecedence"`                // The precedence of the policy. When several policies are active on a network interface, the one with the highest precedence is enforced.
+		AntiSpoofingSupport   int      `xml:"AntiSpoofingSupport"`             // Indicates whether the policy drops outgoing packets whose source address is not the address of the host.
+		FilterCreationHandles []int    `xml:"FilterCreationHandles,omitempty"` // The creation handles of the filters of the policy.
+		TxDefaultDrop         bool     `xml:"TxDefaultDrop"`                   // Indicates whether outgoing packets that match no filter are dropped.
+		TxDefaultMatchEvent   bool     `xml:"TxDefaultMatchEvent"`             // Indicates whether an event is generated for outgoing packets that match no filter.
+		TxDefaultCount        bool     `xml:"TxDefaultCount"`                  // Indicates whether outgoing packets that match no filter are counted.
+		RxDefaultDrop         bool     `xml:"RxDefaultDrop"`                   // Indicates whether incoming packets that match no filter are dropped.
+		RxDefaultMatchEvent   bool     `xml:"RxDefaultMatchEvent"`             // Indicates whether an event is generated for incoming packets that match no filter.
+		RxDefaultCount        bool     `xml:"RxDefaultCount"`                  // Indicates whether incoming packets that match no filter are counted.
+	}
+	NetworkPortPolicyResponse struct {
+		XMLName        xml.Name                  `xml:"AMT_NetworkPortSystemDefensePolicy"`
+		ManagedElement EndpointReferenceResponse `xml:"ManagedElement"` // The CIM_EthernetPort the policy is active on.
+		PolicySet      EndpointReferenceResponse `xml:"PolicySet"`      // The AMT_SystemDefensePolicy that is active on the port.
+	}
+	PullResponse struct {
+		XMLName                     xml.Name                         `xml:"PullResponse"`
+		ActiveFilterStatisticsItems []ActiveFilterStatisticsResponse `xml:"Items>AMT_ActiveFilterStatistics"`
+		CapabilitiesItems           []CapabilitiesResponse           `xml:"Items>AMT_GeneralSystemDefenseCapabilities"`
+		Hdr8021FilterItems          []Hdr8021FilterResponse          `xml:"Items>AMT_Hdr8021Filter"`
+		IPHeadersFilterItems        []IPHeadersFilterResponse        `xml:"Items>AMT_IPHeadersFilter"`
+		NetworkFilterItems          []NetworkFilterResponse          `xml:"Items>AMT_NetworkFilter"`
+		NetworkPortPolicyItems      []NetworkPortPolicyResponse      `xml:"Items>AMT_NetworkPortSystemDefensePolicy"`
+		PolicyItems                 []PolicyResponse                 `xml:"Items>AMT_SystemDefensePolicy"`
+	}
+	CreateResponse struct {
+		XMLName             xml.Name                    `xml:"ResourceCreated"`
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	EndpointReferenceResponse struct {
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	ReferenceParametersResponse struct {
+		ResourceURI string              `xml:"ResourceURI,omitempty"`
+		SelectorSet SelectorSetResponse `xml:"SelectorSet,omitempty"`
+	}
+	SelectorSetResponse struct {
+		Selectors []SelectorResponse `xml:"Selector,omitempty"`
+	}
+	SelectorResponse struct {
+		Name              string                     `xml:"Name,attr"`
+		Text              string                     `xml:",chardata"`
+		EndpointReference *EndpointReferenceResponse `xml:"EndpointReference,omitempty"`
+	}
+	GetTimeout_OUTPUT struct {
+		XMLName     xml.Name    `xml:"GetTimeout_OUTPUT"`
+		Timeout     int         `xml:"Timeout"` // The time, in seconds, after which Intel® AMT deactivates the policies that were activated by a heuristics or agent presence event.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	SetTimeout_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetTimeout_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	UpdateStatistics_OUTPUT struct {
+		XMLName     xml.Name    `xml:"UpdateStatistics_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	Hdr8021FilterRequest struct {
+		XMLName              xml.Name        `xml:"h:AMT_Hdr8021Filter"`
+		H                    string          `xml:"xmlns:h,attr"`
+		ElementName          string          `xml:"h:ElementName,omitempty"`          // A user-friendly name for the object.
+		IsNegated            bool            `xml:"h:IsNegated"`                      // Indicates whether the filter matches packets that do not match its header fields.
+		ActionEventOnMatch   bool            `xml:"h:ActionEventOnMatch"`             // Indicates whether an event is generated when a packet matches the filter.
+		FilterDirection      FilterDirection `xml:"h:FilterDirection"`                // The direction of the traffic the filter applies to.
+		FilterProfile        FilterProfile   `xml:"h:FilterProfile"`                  // The action taken on a matching packet.
+		FilterProfileData    int             `xml:"h:FilterProfileData,omitempty"`    // The rate limit, in packets per second, when FilterProfile is RateLimit.
+		HdrSrcMACAddr8021    []int           `xml:"h:HdrSrcMACAddr8021"`              // The 48-bit source MAC address field, one byte per element.
+		HdrDestMACAddr8021   []int           `xml:"h:HdrDestMACAddr8021"`             // The 48-bit destination MAC address field, one byte per element.
+		HdrProtocolID8021    int             `xml:"h:HdrProtocolID8021,omitempty"`    // The 16-bit Ethernet frame type (EtherType).
+		HdrPriorityValue8021 int             `xml:"h:HdrPriorityValue8021,omitempty"` // The 802.1Q priority.
+		HdrVLANID8021        int             `xml:"h:HdrVLANID8021,omitempty"`        // The 802.1Q VLAN ID.
+	}
+	IPHeadersFilterRequest struct {
+		XMLName            xml.Name        `xml:"h:AMT_IPHeadersFilter"`
+		H                  string          `xml:"xmlns:h,attr"`
+		ElementName        string          `xml:"h:ElementName,omitempty"`       // A user-friendly name for the object.
+		IsNegated          bool            `xml:"h:IsNegated"`                   // Indicates whether the filter matches packets that do not match its header fields.
+		ActionEventOnMatch bool            `xml:"h:ActionEventOnMatch"`          // Indicates whether an event is generated when a packet matches the filter.
+		FilterDirection    FilterDirection `xml:"h:FilterDirection"`             // The direction of the traffic the filter applies to.
+		FilterProfile      FilterProfile   `xml:"h:FilterProfile"`               // The action taken on a matching packet.
+		FilterProfileData  int             `xml:"h:FilterProfileData,omitempty"` // The rate limit, in packets per second, when FilterProfile is RateLimit.
+		HdrIPVersion       IPVersion       `xml:"h:HdrIPVersion"`                // The IP version of the address fields.
+		HdrSrcAddress      []int           `xml:"h:HdrSrcAddress"`               // The source address, one byte per element.
+		HdrSrcMask         []int           `xml:"h:HdrSrcMask"`                  // The mask applied to the source address, one byte per element.
+		HdrDestAddress     []int           `xml:"h:HdrDestAddress"`              // The destination address, one byte per element.
+		HdrDestMask        []int           `xml:"h:HdrDestMask"`                 // The mask applied to the destination address, one byte per element.
+		HdrProtocolID      ProtocolID      `xml:"h:HdrProtocolID,omitempty"`     // The IP protocol number, for example TCP (6) or UDP (17).
+		HdrSrcPortStart    int             `xml:"h:HdrSrcPortStart,omitempty"`   // The first source port of the range matched by the filter.
+		HdrSrcPortEnd      int             `xml:"h:HdrSrcPortEnd,omitempty"`     // The last source port of the range matched by the filter.
+		HdrDestPortStart   int             `xml:"h:HdrDestPortStart,omitempty"`  // The first destination port of the range matched by the filter.
+		HdrDestPortEnd     int             `xml:"h:HdrDestPortEnd,omitempty"`    // The last destination port of the range matched by the filter.
+	}
+	PolicyRequest struct {
+		XMLName               xml.Name `xml:"h:AMT_SystemDefensePolicy"`
+		H                     string   `xml:"xmlns:h,attr"`
+		InstanceID            string   `xml:"h:InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class. Leave empty when creating a policy.
+		ElementName           string   `xml:"h:ElementName,omitempty"` // A user-friendly name for the object.
+		PolicyName            string   `xml:"h:PolicyName"`            // A user-friendly name of the policy.
+		PolicyPrecedence      int      `xml:"h:PolicyPrecedence"`      // The precedence of the policy. When several policies are active on a network interface, the one with the highest precedence is enforced.
+		AntiSpoofingSupport   int      `xml:"h:AntiSpoofingSupport"`   // Indicates whether the policy drops outgoing packets whose source address is not the address of the host.
+		FilterCreationHandles []int    `xml:"h:FilterCreationHandles"` // The creation handles of the filters of the policy.
+		TxDefaultDrop         bool     `xml:"h:TxDefaultDrop"`         // Indicates whether outgoing packets that match no filter are dropped.
+		TxDefaultMatchEvent   bool     `xml:"h:TxDefaultMatchEvent"`   // Indicates whether an event is generated for outgoing packets that match no filter.
+		TxDefaultCount        bool     `xml:"h:TxDefaultCount"`        // Indicates whether outgoing packets that match no filter are counted.
+		RxDefaultDrop         bool     `xml:"h:RxDefaultDrop"`         // Indicates whether incoming packets that match no filter are dropped.
+		RxDefaultMatchEvent   bool     `xml:"h:RxDefaultMatchEvent"`   // Indicates whether an event is generated for incoming packets that match no filter.
+		RxDefaultCount        bool     `xml:"h:RxDefaultCount"`        // Indicates whether incoming packets that match no filter are counted.
+	}
+	NetworkPortPolicyRequest struct {
+		XMLName        xml.Name          `xml:"h:AMT_NetworkPortSystemDefensePolicy"`
+		H              string            `xml:"xmlns:h,attr"`
+		ManagedElement EndpointReference `xml:"h:ManagedElement"` // The CIM_EthernetPort to activate the policy on.
+		PolicySet      EndpointReference `xml:"h:PolicySet"`      // The AMT_SystemDefensePolicy to activate.
+	}
+	SetTimeout_INPUT struct {
+		XMLName xml.Name `xml:"h:SetTimeout_INPUT"`
+		H       string   `xml:"xmlns:h,attr"`
+		Timeout int      `xml:"h:Timeout"` // The time, in seconds, after which Intel® AMT deactivates the policies that were activated by a heuristics or agent presence event.
+	}
+	UpdateStatistics_INPUT struct {
+		XMLName          xml.Name          `xml:"h:UpdateStatistics_INPUT"`
+		H                string            `xml:"xmlns:h,attr"`
+		NetworkInterface EndpointReference `xml:"h:NetworkInterface"` // The CIM_EthernetPort to update the AMT_ActiveFilterStatistics instances of.
+		ResetOnRead      bool              `xml:"h:ResetOnRead"`      // Indicates whether the counters are reset after they are read.
+	}
+	EndpointReference struct {
+		Address             string              `xml:"a:Address"`
+		ReferenceParameters ReferenceParameters `xml:"a:ReferenceParameters"`
+	}
+	ReferenceParameters struct {
+		ResourceURI string      `xml:"w:ResourceURI"`
+		SelectorSet SelectorSet `xml:"w:SelectorSet"`
+	}
+	SelectorSet struct {
+		Selectors []Selector `xml:"w:Selector"`
+	}
+	Selector struct {
+		Name string `xml:"Name,attr"`
+		Text string `xml:",chardata"`
+	}
+)
+
+// Property Types.
+type (
+	// The direction of the traffic a filter applies to.
+	//
+	// ValueMap={0, 1}
+	//
+	// Values={Transmit, Receive}.
+	FilterDirection int
+	// The action taken on a packet that matches a filter.
+	//
+	// ValueMap={0, 1, 2}
+	//
+	// Values={Pass, Drop, Rate Limit}.
+	FilterProfile int
+	// The IP version of the address fields of an IP headers filter.
+	//
+	// ValueMap={4, 6}
+	//
+	// Values={IPv4, IPv6}.
+	IPVersion int
+	// The IP protocol number matched by an IP headers filter.
+	ProtocolID int
+	// ReturnValue is an integer enumeration that indicates the success or failure of an operation.
+	ReturnValue int
+)
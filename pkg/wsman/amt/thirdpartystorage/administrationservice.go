@@ -0,0 +1,143 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartystorage
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewAdministrationServiceWithClient instantiates a new AdministrationService.
+func NewAdministrationServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) AdministrationService {
+	return AdministrationService{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTThirdPartyDataStorageAdministrationService, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (service AdministrationService) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service AdministrationService) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service AdministrationService) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetGlobalStorageAttributes returns the size of the storage, how much of it is allocated, and the limits of the access control lists.
+func (service AdministrationService) GetGlobalStorageAttributes() (response Response, err error) {
+	return service.invoke(GetGlobalStorageAttributes, nil)
+}
+
+// AdminGetRegisteredApplications lists the handles of the applications registered with the storage.
+func (service AdministrationService) AdminGetRegisteredApplications() (response Response, err error) {
+	return service.invoke(AdminGetRegisteredApplications, nil)
+}
+
+// AdminGetApplicationAttributes returns the UUID, names and allocation of the application with the given handle.
+func (service AdministrationService) AdminGetApplicationAttributes(handle int) (response Response, err error) {
+	return service.invoke(AdminGetApplicationAttributes, &AdminGetApplicationAttributes_INPUT{Handle: handle})
+}
+
+// AdminRemoveApplication removes the application with the given handle along with all its blocks.
+func (service AdministrationService) AdminRemoveApplication(handle int) (response Response, err error) {
+	return service.invoke(AdminRemoveApplication, &AdminRemoveApplication_INPUT{Handle: handle})
+}
+
+// AddStorageFpaclEntry reserves totalAllocationSize bytes for the application with the given names in the factory partner allocation list.
+func (service AdministrationService) AddStorageFpaclEntry(applicationName, vendorName string, isPartner bool, totalAllocationSize int) (response Response, err error) {
+	return service.invoke(AddStorageFpaclEntry, &AddStorageFpaclEntry_INPUT{
+		ApplicationName:     applicationName,
+		VendorName:          vendorName,
+		IsPartner:           isPartner,
+		TotalAllocationSize: totalAllocationSize,
+	})
+}
+
+// RemoveStorageFpaclEntry removes the entry with the given handle from the factory partner allocation list.
+func (service AdministrationService) RemoveStorageFpaclEntry(handle int) (response Response, err error) {
+	return service.invoke(RemoveStorageFpaclEntry, &RemoveStorageFpaclEntry_INPUT{Handle: handle})
+}
+
+// invoke calls the method of the service.
+func (service AdministrationService) invoke(method string, input interface{}) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, method), AMTThirdPartyDataStorageAdministrationService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(method), AMTThirdPartyDataStorageAdministrationService, input)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
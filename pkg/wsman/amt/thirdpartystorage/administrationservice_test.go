@@ -0,0 +1,349 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartystorage
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	globalAttributesBody  = `<h:GetGlobalStorageAttributes_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService"></h:GetGlobalStorageAttributes_INPUT>`
+	registeredAppsBody    = `<h:AdminGetRegisteredApplications_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService"></h:AdminGetRegisteredApplications_INPUT>`
+	appAttributesBody     = `<h:AdminGetApplicationAttributes_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService"><h:Handle>1</h:Handle></h:AdminGetApplicationAttributes_INPUT>`
+	removeApplicationBody = `<h:AdminRemoveApplication_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService"><h:Handle>1</h:Handle></h:AdminRemoveApplication_INPUT>`
+	addFpaclEntryBody     = `<h:AddStorageFpaclEntry_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService"><h:ApplicationName>Inventory Agent</h:ApplicationName><h:VendorName>Contoso</h:VendorName><h:IsPartner>false</h:IsPartner><h:TotalAllocationSize>8192</h:TotalAllocationSize></h:AddStorageFpaclEntry_INPUT>`
+	removeFpaclEntryBody  = `<h:RemoveStorageFpaclEntry_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService"><h:Handle>1</h:Handle></h:RemoveStorageFpaclEntry_INPUT>`
+)
+
+var thirdPartyDataStorageAdministrationService = AdministrationServiceResponse{
+	XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService), Local: AMTThirdPartyDataStorageAdministrationService},
+	CreationClassName:       AMTThirdPartyDataStorageAdministrationService,
+	Name:                    "Intel(r) AMT Third Party Data Storage Administration Service",
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+	ElementName:             "Intel(r) AMT Third Party Data Storage Administration Service",
+	EnabledState:            5,
+}
+
+func administrationServiceOutput(local string) xml.Name {
+	return xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService), Local: local}
+}
+
+func TestPositiveAMT_ThirdPartyDataStorageAdministrationService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartystorage/administrationservice",
+	}
+	elementUnderTest := NewAdministrationServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ThirdPartyDataStorageAdministrationService Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService Get wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                          xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AdministrationServiceGetResponse: thirdPartyDataStorageAdministrationService,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService Enumerate wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService Pull wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						AdministrationServiceItems: []AdministrationServiceResponse{
+							thirdPartyDataStorageAdministrationService,
+						},
+					},
+				},
+			},
+			// GET GLOBAL STORAGE ATTRIBUTES
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService GetGlobalStorageAttributes wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, GetGlobalStorageAttributes),
+				"",
+				globalAttributesBody,
+				func() (Response, error) {
+					client.CurrentMessage = GetGlobalStorageAttributes
+
+					return elementUnderTest.GetGlobalStorageAttributes()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetGlobalStorageAttributes_OUTPUT: GetGlobalStorageAttributes_OUTPUT{
+						XMLName:                          administrationServiceOutput("GetGlobalStorageAttributes_OUTPUT"),
+						TotalStorage:                     196608,
+						TotalAllocatedStorage:            8192,
+						MaxPartnerStorage:                65536,
+						MaxNonPartnerTotalAllocationSize: 131072,
+						MaxFpaclEntries:                  16,
+						MaxEaclEntries:                   8,
+						ReturnValue:                      ReturnValueSuccess,
+					},
+				},
+			},
+			// ADMIN GET REGISTERED APPLICATIONS
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService AdminGetRegisteredApplications wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, AdminGetRegisteredApplications),
+				"",
+				registeredAppsBody,
+				func() (Response, error) {
+					client.CurrentMessage = AdminGetRegisteredApplications
+
+					return elementUnderTest.AdminGetRegisteredApplications()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AdminGetRegisteredApplications_OUTPUT: AdminGetRegisteredApplications_OUTPUT{
+						XMLName:            administrationServiceOutput("AdminGetRegisteredApplications_OUTPUT"),
+						ApplicationHandles: []int{1, 2},
+						ReturnValue:        ReturnValueSuccess,
+					},
+				},
+			},
+			// ADMIN GET APPLICATION ATTRIBUTES
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService AdminGetApplicationAttributes wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, AdminGetApplicationAttributes),
+				"",
+				appAttributesBody,
+				func() (Response, error) {
+					client.CurrentMessage = AdminGetApplicationAttributes
+
+					return elementUnderTest.AdminGetApplicationAttributes(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AdminGetApplicationAttributes_OUTPUT: AdminGetApplicationAttributes_OUTPUT{
+						XMLName:               administrationServiceOutput("AdminGetApplicationAttributes_OUTPUT"),
+						UUID:                  []int{18, 52, 86, 120, 154, 188, 222, 240, 1, 35, 69, 103, 137, 171, 205, 239},
+						VendorName:            vendorName,
+						ApplicationName:       applicationName,
+						EnterpriseName:        enterpriseName,
+						CurrentAllocationSize: 4096,
+						ActiveSession:         true,
+						Partner:               false,
+						ReturnValue:           ReturnValueSuccess,
+					},
+				},
+			},
+			// ADMIN REMOVE APPLICATION
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService AdminRemoveApplication wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, AdminRemoveApplication),
+				"",
+				removeApplicationBody,
+				func() (Response, error) {
+					client.CurrentMessage = AdminRemoveApplication
+
+					return elementUnderTest.AdminRemoveApplication(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AdminRemoveApplication_OUTPUT: AdminRemoveApplication_OUTPUT{
+						XMLName:     administrationServiceOutput("AdminRemoveApplication_OUTPUT"),
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// ADD STORAGE FPACL ENTRY
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService AddStorageFpaclEntry wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, AddStorageFpaclEntry),
+				"",
+				addFpaclEntryBody,
+				func() (Response, error) {
+					client.CurrentMessage = AddStorageFpaclEntry
+
+					return elementUnderTest.AddStorageFpaclEntry(applicationName, vendorName, false, 8192)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddStorageFpaclEntry_OUTPUT: AddStorageFpaclEntry_OUTPUT{
+						XMLName:     administrationServiceOutput("AddStorageFpaclEntry_OUTPUT"),
+						Handle:      1,
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// REMOVE STORAGE FPACL ENTRY
+			{
+				"should create a valid AMT_ThirdPartyDataStorageAdministrationService RemoveStorageFpaclEntry wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, RemoveStorageFpaclEntry),
+				"",
+				removeFpaclEntryBody,
+				func() (Response, error) {
+					client.CurrentMessage = RemoveStorageFpaclEntry
+
+					return elementUnderTest.RemoveStorageFpaclEntry(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RemoveStorageFpaclEntry_OUTPUT: RemoveStorageFpaclEntry_OUTPUT{
+						XMLName:     administrationServiceOutput("RemoveStorageFpaclEntry_OUTPUT"),
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_ThirdPartyDataStorageAdministrationService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartystorage/administrationservice",
+	}
+	elementUnderTest := NewAdministrationServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ThirdPartyDataStorageAdministrationService Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService Get wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService Enumerate wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService Pull wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService AddStorageFpaclEntry wsman message",
+				AMTThirdPartyDataStorageAdministrationService,
+				methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, AddStorageFpaclEntry),
+				"",
+				addFpaclEntryBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AddStorageFpaclEntry(applicationName, vendorName, false, 8192)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,179 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartystorage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	// ErrNegativeOffset is returned by Block for an offset before the start of the block.
+	ErrNegativeOffset = errors.New("negative offset")
+	// ErrOutOfRange is returned by Block.WriteAt for data that does not fit in the block.
+	ErrOutOfRange = errors.New("write beyond the end of the block")
+)
+
+// StatusError is returned by Block when Intel® AMT answers a storage method with a non-zero ReturnValue,
+// such as ReturnValueBlockLockedByOther when another application holds the lock of the block.
+type StatusError struct {
+	Method      string
+	ReturnValue ReturnValue
+}
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("%s failed with %s (%d)", e.Method, e.ReturnValue, int(e.ReturnValue))
+}
+
+// Block reads and writes an allocated block through io.ReaderAt and io.WriterAt.
+// Each access is split into ReadBlock or WriteBlock calls of at most the MTU of the service.
+type Block struct {
+	service       Service
+	sessionHandle int
+	blockHandle   int
+	size          int64
+	mtu           int
+}
+
+var (
+	_ io.ReaderAt = (*Block)(nil)
+	_ io.WriterAt = (*Block)(nil)
+)
+
+// NewBlock returns a Block over the block with the given handle, reading the size of the block and the MTU of the service from Intel® AMT.
+func NewBlock(service Service, sessionHandle, blockHandle int) (*Block, error) {
+	mtu, err := service.GetMTU()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = check(GetMTU, mtu.Body.GetMTU_OUTPUT.ReturnValue); err != nil {
+		return nil, err
+	}
+
+	attributes, err := service.GetBlockAttributes(sessionHandle, blockHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = check(GetBlockAttributes, attributes.Body.GetBlockAttributes_OUTPUT.ReturnValue); err != nil {
+		return nil, err
+	}
+
+	return &Block{
+		service:       service,
+		sessionHandle: sessionHandle,
+		blockHandle:   blockHandle,
+		size:          int64(attributes.Body.GetBlockAttributes_OUTPUT.BlockSize),
+		mtu:           mtu.Body.GetMTU_OUTPUT.Mtu,
+	}, nil
+}
+
+// Size returns the size of the block, in bytes.
+func (b *Block) Size() int64 {
+	return b.size
+}
+
+// ReadAt reads len(p) bytes from the block starting at off. It returns io.EOF when the block ends before p is filled.
+func (b *Block) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, ErrNegativeOffset
+	}
+
+	if off >= b.size {
+		return 0, io.EOF
+	}
+
+	want := len(p)
+	if remaining := b.size - off; int64(want) > remaining {
+		want = int(remaining)
+	}
+
+	for n < want {
+		count := b.chunk(want - n)
+
+		response, err := b.service.ReadBlock(b.sessionHandle, b.blockHandle, int(off)+n, count)
+		if err != nil {
+			return n, err
+		}
+
+		if err = check(ReadBlock, response.Body.ReadBlock_OUTPUT.ReturnValue); err != nil {
+			return n, err
+		}
+
+		data, err := response.Body.ReadBlock_OUTPUT.DecodeData()
+		if err != nil {
+			return n, err
+		}
+
+		if len(data) == 0 {
+			return n, io.ErrUnexpectedEOF
+		}
+
+		n += copy(p[n:want], data)
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+// WriteAt writes p to the block starting at off. Data past the end of the block is not written and ErrOutOfRange is returned.
+func (b *Block) WriteAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, ErrNegativeOffset
+	}
+
+	want := len(p)
+	if remaining := b.size - off; int64(want) > remaining {
+		want = 0
+		if remaining > 0 {
+			want = int(remaining)
+		}
+	}
+
+	for n < want {
+		count := b.chunk(want - n)
+
+		response, err := b.service.WriteBlock(b.sessionHandle, b.blockHandle, int(off)+n, p[n:n+count])
+		if err != nil {
+			return n, err
+		}
+
+		if err = check(WriteBlock, response.Body.WriteBlock_OUTPUT.ReturnValue); err != nil {
+			return n, err
+		}
+
+		n += count
+	}
+
+	if n < len(p) {
+		return n, ErrOutOfRange
+	}
+
+	return n, nil
+}
+
+// chunk returns the number of bytes of the next ReadBlock or WriteBlock call, out of the remaining bytes.
+func (b *Block) chunk(remaining int) int {
+	if b.mtu > 0 && remaining > b.mtu {
+		return b.mtu
+	}
+
+	return remaining
+}
+
+// check returns a StatusError for a non-zero ReturnValue of method.
+func check(method string, returnValue ReturnValue) error {
+	if returnValue != ReturnValueSuccess {
+		return StatusError{Method: method, ReturnValue: returnValue}
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartystorage
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var errTransport = errors.New("connection reset")
+
+func newTestBlock(t *testing.T, responses map[string][]string) (*Block, *wsmantesting.RoutingClient) {
+	t.Helper()
+
+	client := wsmantesting.RoutingClient{
+		Responses: map[string][]string{
+			"AMT_ThirdPartyDataStorageService/GetMTU":             {"amt/thirdpartystorage/service/getmtusmall"},
+			"AMT_ThirdPartyDataStorageService/GetBlockAttributes": {"amt/thirdpartystorage/service/getblockattributes"},
+		},
+	}
+
+	for key, fixtures := range responses {
+		client.Responses[key] = fixtures
+	}
+
+	service := NewServiceWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	block, err := NewBlock(service, sessionHandle, blockHandle)
+	assert.NoError(t, err)
+
+	return block, &client
+}
+
+func TestNewBlock(t *testing.T) {
+	block, client := newTestBlock(t, nil)
+
+	assert.Equal(t, int64(inventoryBlockSz), block.Size())
+	assert.Equal(t, []string{
+		"AMT_ThirdPartyDataStorageService/GetMTU",
+		"AMT_ThirdPartyDataStorageService/GetBlockAttributes",
+	}, client.Requests)
+}
+
+func TestNewBlockMissing(t *testing.T) {
+	client := wsmantesting.RoutingClient{
+		Responses: map[string][]string{
+			"AMT_ThirdPartyDataStorageService/GetMTU":             {"amt/thirdpartystorage/service/getmtu"},
+			"AMT_ThirdPartyDataStorageService/GetBlockAttributes": {"amt/thirdpartystorage/service/getblockattributesmissing"},
+		},
+	}
+	service := NewServiceWithClient(message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase), &client)
+
+	block, err := NewBlock(service, sessionHandle, blockHandle)
+	assert.Nil(t, block)
+	assert.Equal(t, StatusError{Method: GetBlockAttributes, ReturnValue: ReturnValueBlockDoesNotExist}, err)
+	assert.EqualError(t, err, "GetBlockAttributes failed with PT_STATUS_BLOCK_DOES_NOT_EXIST (13)")
+}
+
+func TestBlockReadAt(t *testing.T) {
+	block, client := newTestBlock(t, map[string][]string{
+		"AMT_ThirdPartyDataStorageService/ReadBlock": {
+			"amt/thirdpartystorage/service/readblock",
+			"amt/thirdpartystorage/service/readblock4",
+			"amt/thirdpartystorage/service/readblock8",
+		},
+	})
+
+	p := make([]byte, inventoryBlockSz)
+	n, err := block.ReadAt(p, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, inventoryBlockSz, n)
+	assert.Equal(t, "0123456789", string(p))
+	assert.Len(t, client.Messages, 5)
+	assert.Contains(t, client.Messages[2], "<h:ByteOffset>0</h:ByteOffset><h:ByteCount>4</h:ByteCount>")
+	assert.Contains(t, client.Messages[3], "<h:ByteOffset>4</h:ByteOffset><h:ByteCount>4</h:ByteCount>")
+	assert.Contains(t, client.Messages[4], "<h:ByteOffset>8</h:ByteOffset><h:ByteCount>2</h:ByteCount>")
+}
+
+func TestBlockReadAtEnd(t *testing.T) {
+	block, client := newTestBlock(t, map[string][]string{
+		"AMT_ThirdPartyDataStorageService/ReadBlock": {"amt/thirdpartystorage/service/readblock8"},
+	})
+
+	p := make([]byte, 4)
+	n, err := block.ReadAt(p, 8)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "89", string(p[:n]))
+
+	n, err = block.ReadAt(p, inventoryBlockSz)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 0, n)
+	assert.Len(t, client.Requests, 3)
+
+	_, err = block.ReadAt(p, -1)
+	assert.Equal(t, ErrNegativeOffset, err)
+}
+
+func TestBlockReadAtFailure(t *testing.T) {
+	block, _ := newTestBlock(t, nil)
+
+	_, err := block.ReadAt(make([]byte, 4), 0)
+	assert.ErrorIs(t, err, wsmantesting.ErrNoFixture)
+}
+
+func TestBlockWriteAt(t *testing.T) {
+	block, client := newTestBlock(t, map[string][]string{
+		"AMT_ThirdPartyDataStorageService/WriteBlock": {"amt/thirdpartystorage/service/writeblock"},
+	})
+
+	n, err := block.WriteAt([]byte("hello world"), 0)
+	assert.Equal(t, ErrOutOfRange, err)
+	assert.Equal(t, inventoryBlockSz, n)
+	assert.Len(t, client.Messages, 5)
+	assert.Contains(t, client.Messages[2], "<h:ByteOffset>0</h:ByteOffset><h:Data>aGVsbA==</h:Data>")
+	assert.Contains(t, client.Messages[3], "<h:ByteOffset>4</h:ByteOffset><h:Data>byB3bw==</h:Data>")
+	assert.Contains(t, client.Messages[4], "<h:ByteOffset>8</h:ByteOffset><h:Data>cmw=</h:Data>")
+
+	n, err = block.WriteAt([]byte("0123"), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+
+	n, err = block.WriteAt([]byte("0123"), 12)
+	assert.Equal(t, ErrOutOfRange, err)
+	assert.Equal(t, 0, n)
+
+	_, err = block.WriteAt([]byte("0123"), -1)
+	assert.Equal(t, ErrNegativeOffset, err)
+}
+
+func TestBlockWriteAtLocked(t *testing.T) {
+	block, _ := newTestBlock(t, map[string][]string{
+		"AMT_ThirdPartyDataStorageService/WriteBlock": {"amt/thirdpartystorage/service/writeblocklocked"},
+	})
+
+	n, err := block.WriteAt([]byte("0123"), 0)
+	assert.Equal(t, 0, n)
+
+	var statusError StatusError
+
+	assert.True(t, errors.As(err, &statusError))
+	assert.Equal(t, ReturnValueBlockLockedByOther, statusError.ReturnValue)
+	assert.EqualError(t, err, "WriteBlock failed with PT_STATUS_BLOCK_LOCKED_BY_OTHER (18)")
+}
+
+func TestBlockWriteAtFailure(t *testing.T) {
+	block, client := newTestBlock(t, nil)
+	client.Failures = map[string]error{"AMT_ThirdPartyDataStorageService/WriteBlock": errTransport}
+
+	_, err := block.WriteAt([]byte("0123"), 0)
+	assert.ErrorIs(t, err, errTransport)
+}
@@ -0,0 +1,56 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartystorage
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+
+const (
+	AMTThirdPartyDataStorageAdministrationService string = "AMT_ThirdPartyDataStorageAdministrationService"
+	AMTThirdPartyDataStorageService               string = "AMT_ThirdPartyDataStorageService"
+	AddStorageFpaclEntry                          string = "AddStorageFpaclEntry"
+	AdminGetApplicationAttributes                 string = "AdminGetApplicationAttributes"
+	AdminGetRegisteredApplications                string = "AdminGetRegisteredApplications"
+	AdminRemoveApplication                        string = "AdminRemoveApplication"
+	AllocateBlock                                 string = "AllocateBlock"
+	DeallocateBlock                               string = "DeallocateBlock"
+	GetAllocatedBlocks                            string = "GetAllocatedBlocks"
+	GetBlockAttributes                            string = "GetBlockAttributes"
+	GetCurrentApplicationHandles                  string = "GetCurrentApplicationHandles"
+	GetGlobalStorageAttributes                    string = "GetGlobalStorageAttributes"
+	GetMTU                                        string = "GetMTU"
+	LockBlock                                     string = "LockBlock"
+	ReadBlock                                     string = "ReadBlock"
+	RegisterApplication                           string = "RegisterApplication"
+	RemoveStorageFpaclEntry                       string = "RemoveStorageFpaclEntry"
+	UnlockBlock                                   string = "UnlockBlock"
+	UnregisterApplication                         string = "UnregisterApplication"
+	WriteBlock                                    string = "WriteBlock"
+)
+
+const (
+	ReturnValueSuccess                 ReturnValue = 0
+	ReturnValueInternalError           ReturnValue = 1
+	ReturnValueInvalidRegistrationData ReturnValue = 9
+	ReturnValueApplicationDoesNotExist ReturnValue = 10
+	ReturnValueNotEnoughStorage        ReturnValue = 11
+	ReturnValueInvalidName             ReturnValue = 12
+	ReturnValueBlockDoesNotExist       ReturnValue = 13
+	ReturnValueInvalidByteOffset       ReturnValue = 14
+	ReturnValueInvalidByteCount        ReturnValue = 15
+	ReturnValueNotPermitted            ReturnValue = 16
+	ReturnValueNotOwner                ReturnValue = 17
+	ReturnValueBlockLockedByOther      ReturnValue = 18
+	ReturnValueBlockNotLocked          ReturnValue = 19
+	ReturnValueMaxLimitReached         ReturnValue = 23
+	ReturnValueInvalidHandle           ReturnValue = 2053
+	ReturnValueStorageACLEntryInUse    ReturnValue = 2056
+	ReturnValueDuplicate               ReturnValue = 2058
+)
+
+// String returns the PT_STATUS name of the ReturnValue value.
+func (r ReturnValue) String() string {
+	return common.ConvertReturnValueToString(int(r))
+}
@@ -0,0 +1,30 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartystorage
+
+import "testing"
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{ReturnValueSuccess, "PT_STATUS_SUCCESS"},
+		{ReturnValueNotEnoughStorage, "PT_STATUS_NOT_ENOUGH_STORAGE"},
+		{ReturnValueBlockDoesNotExist, "PT_STATUS_BLOCK_DOES_NOT_EXIST"},
+		{ReturnValueNotOwner, "PT_STATUS_NOT_OWNER"},
+		{ReturnValueBlockLockedByOther, "PT_STATUS_BLOCK_LOCKED_BY_OTHER"},
+		{ReturnValueBlockNotLocked, "PT_STATUS_BLOCK_NOT_LOCKED"},
+		{ReturnValueStorageACLEntryInUse, "PT_STATUS_STORAGE_ACL_ENTRY_IN_USE"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package thirdpartystorage facilitates communication with Intel® AMT devices to keep application data in the non-volatile Third-Party Data Storage (3PDS).
+//
+// Service:
+// AMT_ThirdPartyDataStorageService is used by the applications themselves, with a user of the STORAGE realm.
+// An application registers to open a session, then allocates, reads, writes and locks the blocks it owns.
+// Block adapts a block to io.ReaderAt and io.WriterAt.
+//
+// AdministrationService:
+// AMT_ThirdPartyDataStorageAdministrationService is used with a user of the STORAGE_ADMIN realm to list and remove the registered applications
+// and to reserve storage for partner applications in the factory partner allocation list (FPACL).
+package thirdpartystorage
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewServiceWithClient instantiates a new Service.
+func NewServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTThirdPartyDataStorageService, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (service Service) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service Service) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service Service) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// RegisterApplication opens a session for the application with the given UUID and names, registering it on first use.
+// The SessionHandle of the response is the first argument of every other method of the service.
+func (service Service) RegisterApplication(callerUUID [16]byte, vendorName, applicationName, enterpriseName string) (response Response, err error) {
+	uuid := make([]int, len(callerUUID))
+	for i, b := range callerUUID {
+		uuid[i] = int(b)
+	}
+
+	return service.invoke(RegisterApplication, &RegisterApplication_INPUT{
+		CallerUUID:      uuid,
+		VendorName:      vendorName,
+		ApplicationName: applicationName,
+		EnterpriseName:  enterpriseName,
+	})
+}
+
+// UnregisterApplication closes the session. The blocks of the application are kept.
+func (service Service) UnregisterApplication(sessionHandle int) (response Response, err error) {
+	return service.invoke(UnregisterApplication, &UnregisterApplication_INPUT{SessionHandle: sessionHandle})
+}
+
+// GetMTU returns the largest number of bytes a single ReadBlock or WriteBlock transfers.
+func (service Service) GetMTU() (response Response, err error) {
+	return service.invoke(GetMTU, nil)
+}
+
+// GetCurrentApplicationHandles lists the handles of the applications registered with the storage.
+func (service Service) GetCurrentApplicationHandles(sessionHandle int) (response Response, err error) {
+	return service.invoke(GetCurrentApplicationHandles, &GetCurrentApplicationHandles_INPUT{SessionHandle: sessionHandle})
+}
+
+// GetAllocatedBlocks lists the handles of the blocks owned by the application with the given handle that are visible to the session.
+func (service Service) GetAllocatedBlocks(sessionHandle, blockOwnerApplication int) (response Response, err error) {
+	return service.invoke(GetAllocatedBlocks, &GetAllocatedBlocks_INPUT{SessionHandle: sessionHandle, BlockOwnerApplication: blockOwnerApplication})
+}
+
+// AllocateBlock allocates a block of bytesRequested bytes to the application of the session. A hidden block is not listed to the other applications.
+func (service Service) AllocateBlock(sessionHandle, bytesRequested int, blockHidden bool, blockName string) (response Response, err error) {
+	return service.invoke(AllocateBlock, &AllocateBlock_INPUT{
+		SessionHandle:  sessionHandle,
+		BytesRequested: bytesRequested,
+		BlockHidden:    blockHidden,
+		BlockName:      blockName,
+	})
+}
+
+// DeallocateBlock frees the block with the given handle.
+func (service Service) DeallocateBlock(sessionHandle, blockHandle int) (response Response, err error) {
+	return service.invoke(DeallocateBlock, &DeallocateBlock_INPUT{SessionHandle: sessionHandle, BlockHandle: blockHandle})
+}
+
+// GetBlockAttributes returns the size, name and visibility of the block with the given handle.
+func (service Service) GetBlockAttributes(sessionHandle, blockHandle int) (response Response, err error) {
+	return service.invoke(GetBlockAttributes, &GetBlockAttributes_INPUT{SessionHandle: sessionHandle, BlockHandle: blockHandle})
+}
+
+// ReadBlock reads byteCount bytes, at most the MTU, from the given offset of the block. Decode the Data of the response with DecodeData.
+func (service Service) ReadBlock(sessionHandle, blockHandle, byteOffset, byteCount int) (response Response, err error) {
+	return service.invoke(ReadBlock, &ReadBlock_INPUT{
+		SessionHandle: sessionHandle,
+		BlockHandle:   blockHandle,
+		ByteOffset:    byteOffset,
+		ByteCount:     byteCount,
+	})
+}
+
+// WriteBlock writes data, at most the MTU bytes, at the given offset of the block.
+func (service Service) WriteBlock(sessionHandle, blockHandle, byteOffset int, data []byte) (response Response, err error) {
+	return service.invoke(WriteBlock, &WriteBlock_INPUT{
+		SessionHandle: sessionHandle,
+		BlockHandle:   blockHandle,
+		ByteOffset:    byteOffset,
+		Data:          base64.StdEncoding.EncodeToString(data),
+	})
+}
+
+// LockBlock prevents the other applications from writing the block with the given handle until UnlockBlock is called.
+func (service Service) LockBlock(sessionHandle, blockHandle int) (response Response, err error) {
+	return service.invoke(LockBlock, &LockBlock_INPUT{SessionHandle: sessionHandle, BlockHandle: blockHandle})
+}
+
+// UnlockBlock releases the lock taken with LockBlock.
+func (service Service) UnlockBlock(sessionHandle, blockHandle int) (response Response, err error) {
+	return service.invoke(UnlockBlock, &UnlockBlock_INPUT{SessionHandle: sessionHandle, BlockHandle: blockHandle})
+}
+
+// DecodeData returns the bytes read by ReadBlock.
+func (output ReadBlock_OUTPUT) DecodeData() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(output.Data)
+}
+
+// invoke calls the method of the service.
+func (service Service) invoke(method string, input interface{}) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, method), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(method), AMTThirdPartyDataStorageService, input)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,537 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartystorage
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	registerBody     = `<h:RegisterApplication_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:CallerUUID>18</h:CallerUUID><h:CallerUUID>52</h:CallerUUID><h:CallerUUID>86</h:CallerUUID><h:CallerUUID>120</h:CallerUUID><h:CallerUUID>154</h:CallerUUID><h:CallerUUID>188</h:CallerUUID><h:CallerUUID>222</h:CallerUUID><h:CallerUUID>240</h:CallerUUID><h:CallerUUID>1</h:CallerUUID><h:CallerUUID>35</h:CallerUUID><h:CallerUUID>69</h:CallerUUID><h:CallerUUID>103</h:CallerUUID><h:CallerUUID>137</h:CallerUUID><h:CallerUUID>171</h:CallerUUID><h:CallerUUID>205</h:CallerUUID><h:CallerUUID>239</h:CallerUUID><h:VendorName>Contoso</h:VendorName><h:ApplicationName>Inventory Agent</h:ApplicationName><h:EnterpriseName>Contoso IT</h:EnterpriseName></h:RegisterApplication_INPUT>`
+	unregisterBody   = `<h:UnregisterApplication_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle></h:UnregisterApplication_INPUT>`
+	getMTUBody       = `<h:GetMTU_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"></h:GetMTU_INPUT>`
+	getHandlesBody   = `<h:GetCurrentApplicationHandles_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle></h:GetCurrentApplicationHandles_INPUT>`
+	getBlocksBody    = `<h:GetAllocatedBlocks_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle><h:BlockOwnerApplication>1</h:BlockOwnerApplication></h:GetAllocatedBlocks_INPUT>`
+	allocateBody     = `<h:AllocateBlock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle><h:BytesRequested>10</h:BytesRequested><h:BlockHidden>false</h:BlockHidden><h:BlockName>inventory</h:BlockName></h:AllocateBlock_INPUT>`
+	deallocateBody   = `<h:DeallocateBlock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:DeallocateBlock_INPUT>`
+	attributesBody   = `<h:GetBlockAttributes_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:GetBlockAttributes_INPUT>`
+	readBody         = `<h:ReadBlock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:ByteOffset>0</h:ByteOffset><h:ByteCount>4</h:ByteCount></h:ReadBlock_INPUT>`
+	writeBody        = `<h:WriteBlock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:ByteOffset>0</h:ByteOffset><h:Data>MDEyMw==</h:Data></h:WriteBlock_INPUT>`
+	lockBody         = `<h:LockBlock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:LockBlock_INPUT>`
+	unlockBody       = `<h:UnlockBlock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:UnlockBlock_INPUT>`
+	sessionHandle    = 1
+	blockHandle      = 3
+	applicationName  = "Inventory Agent"
+	vendorName       = "Contoso"
+	enterpriseName   = "Contoso IT"
+	inventoryBlock   = "inventory"
+	inventoryBlockSz = 10
+)
+
+var callerUUID = [16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+
+var thirdPartyDataStorageService = ServiceResponse{
+	XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTThirdPartyDataStorageService), Local: AMTThirdPartyDataStorageService},
+	CreationClassName:       AMTThirdPartyDataStorageService,
+	Name:                    "Intel(r) AMT Third Party Data Storage Service",
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+	ElementName:             "Intel(r) AMT Third Party Data Storage Service",
+	EnabledState:            5,
+}
+
+func serviceOutput(local string) xml.Name {
+	return xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTThirdPartyDataStorageService), Local: local}
+}
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			ServiceGetResponse: ServiceResponse{
+				EnabledState: 5,
+			},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ServiceGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"EnabledState\":5},\"AdministrationServiceGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"EnabledState\":0},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ServiceItems\":null,\"AdministrationServiceItems\":null},\"RegisterApplication_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"SessionHandle\":0,\"ReturnValue\":0},\"UnregisterApplication_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"GetMTU_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Mtu\":0,\"ReturnValue\":0},\"GetCurrentApplicationHandles_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ApplicationHandles\":null,\"ReturnValue\":0},\"GetAllocatedBlocks_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"BlockHandles\":null,\"ReturnValue\":0},\"AllocateBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"BlockHandle\":0,\"ReturnValue\":0},\"DeallocateBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"GetBlockAttributes_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"BlockSize\":0,\"BlockHidden\":false,\"BlockName\":\"\",\"ReturnValue\":0},\"ReadBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Data\":\"\",\"ReturnValue\":0},\"WriteBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"LockBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"UnlockBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"GetGlobalStorageAttributes_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"TotalStorage\":0,\"TotalAllocatedStorage\":0,\"MaxPartnerStorage\":0,\"MaxNonPartnerTotalAllocationSize\":0,\"MaxFpaclEntries\":0,\"MaxEaclEntries\":0,\"ReturnValue\":0},\"AdminGetRegisteredApplications_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ApplicationHandles\":null,\"ReturnValue\":0},\"AdminGetApplicationAttributes_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"UUID\":null,\"VendorName\":\"\",\"ApplicationName\":\"\",\"EnterpriseName\":\"\",\"CurrentAllocationSize\":0,\"ActiveSession\":false,\"Partner\":false,\"ReturnValue\":0},\"AdminRemoveApplication_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"AddStorageFpaclEntry_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Handle\":0,\"ReturnValue\":0},\"RemoveStorageFpaclEntry_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			ServiceGetResponse: ServiceResponse{
+				EnabledState: 5,
+			},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nservicegetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    enabledstate: 5\nadministrationservicegetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    enabledstate: 0\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    serviceitems: []\n    administrationserviceitems: []\nregisterapplication_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    sessionhandle: 0\n    returnvalue: 0\nunregisterapplication_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\ngetmtu_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    mtu: 0\n    returnvalue: 0\ngetcurrentapplicationhandles_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    applicationhandles: []\n    returnvalue: 0\ngetallocatedblocks_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    blockhandles: []\n    returnvalue: 0\nallocateblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    blockhandle: 0\n    returnvalue: 0\ndeallocateblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\ngetblockattributes_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    blocksize: 0\n    blockhidden: false\n    blockname: \"\"\n    returnvalue: 0\nreadblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    data: \"\"\n    returnvalue: 0\nwriteblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nlockblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nunlockblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\ngetglobalstorageattributes_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    totalstorage: 0\n    totalallocatedstorage: 0\n    maxpartnerstorage: 0\n    maxnonpartnertotalallocationsize: 0\n    maxfpaclentries: 0\n    maxeaclentries: 0\n    returnvalue: 0\nadmingetregisteredapplications_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    applicationhandles: []\n    returnvalue: 0\nadmingetapplicationattributes_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    uuid: []\n    vendorname: \"\"\n    applicationname: \"\"\n    enterprisename: \"\"\n    currentallocationsize: 0\n    activesession: false\n    partner: false\n    returnvalue: 0\nadminremoveapplication_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\naddstoragefpaclentry_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    handle: 0\n    returnvalue: 0\nremovestoragefpaclentry_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveAMT_ThirdPartyDataStorageService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartystorage/service",
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ThirdPartyDataStorageService Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService Get wsman message",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:            xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ServiceGetResponse: thirdPartyDataStorageService,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService Enumerate wsman message",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService Pull wsman message",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						ServiceItems: []ServiceResponse{
+							thirdPartyDataStorageService,
+						},
+					},
+				},
+			},
+			// REGISTER APPLICATION
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService RegisterApplication wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, RegisterApplication),
+				"",
+				registerBody,
+				func() (Response, error) {
+					client.CurrentMessage = RegisterApplication
+
+					return elementUnderTest.RegisterApplication(callerUUID, vendorName, applicationName, enterpriseName)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RegisterApplication_OUTPUT: RegisterApplication_OUTPUT{
+						XMLName:       serviceOutput("RegisterApplication_OUTPUT"),
+						SessionHandle: sessionHandle,
+						ReturnValue:   ReturnValueSuccess,
+					},
+				},
+			},
+			// UNREGISTER APPLICATION
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService UnregisterApplication wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, UnregisterApplication),
+				"",
+				unregisterBody,
+				func() (Response, error) {
+					client.CurrentMessage = UnregisterApplication
+
+					return elementUnderTest.UnregisterApplication(sessionHandle)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					UnregisterApplication_OUTPUT: UnregisterApplication_OUTPUT{
+						XMLName:     serviceOutput("UnregisterApplication_OUTPUT"),
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// GET MTU
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetMTU wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, GetMTU),
+				"",
+				getMTUBody,
+				func() (Response, error) {
+					client.CurrentMessage = GetMTU
+
+					return elementUnderTest.GetMTU()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetMTU_OUTPUT: GetMTU_OUTPUT{
+						XMLName:     serviceOutput("GetMTU_OUTPUT"),
+						Mtu:         4096,
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// GET CURRENT APPLICATION HANDLES
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetCurrentApplicationHandles wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, GetCurrentApplicationHandles),
+				"",
+				getHandlesBody,
+				func() (Response, error) {
+					client.CurrentMessage = GetCurrentApplicationHandles
+
+					return elementUnderTest.GetCurrentApplicationHandles(sessionHandle)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetCurrentApplicationHandles_OUTPUT: GetCurrentApplicationHandles_OUTPUT{
+						XMLName:            serviceOutput("GetCurrentApplicationHandles_OUTPUT"),
+						ApplicationHandles: []int{1, 2},
+						ReturnValue:        ReturnValueSuccess,
+					},
+				},
+			},
+			// GET ALLOCATED BLOCKS
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetAllocatedBlocks wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, GetAllocatedBlocks),
+				"",
+				getBlocksBody,
+				func() (Response, error) {
+					client.CurrentMessage = GetAllocatedBlocks
+
+					return elementUnderTest.GetAllocatedBlocks(sessionHandle, 1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetAllocatedBlocks_OUTPUT: GetAllocatedBlocks_OUTPUT{
+						XMLName:      serviceOutput("GetAllocatedBlocks_OUTPUT"),
+						BlockHandles: []int{3, 4},
+						ReturnValue:  ReturnValueSuccess,
+					},
+				},
+			},
+			// ALLOCATE BLOCK
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService AllocateBlock wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, AllocateBlock),
+				"",
+				allocateBody,
+				func() (Response, error) {
+					client.CurrentMessage = AllocateBlock
+
+					return elementUnderTest.AllocateBlock(sessionHandle, inventoryBlockSz, false, inventoryBlock)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AllocateBlock_OUTPUT: AllocateBlock_OUTPUT{
+						XMLName:     serviceOutput("AllocateBlock_OUTPUT"),
+						BlockHandle: blockHandle,
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// DEALLOCATE BLOCK
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService DeallocateBlock wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, DeallocateBlock),
+				"",
+				deallocateBody,
+				func() (Response, error) {
+					client.CurrentMessage = DeallocateBlock
+
+					return elementUnderTest.DeallocateBlock(sessionHandle, blockHandle)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					DeallocateBlock_OUTPUT: DeallocateBlock_OUTPUT{
+						XMLName:     serviceOutput("DeallocateBlock_OUTPUT"),
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// GET BLOCK ATTRIBUTES
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService GetBlockAttributes wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, GetBlockAttributes),
+				"",
+				attributesBody,
+				func() (Response, error) {
+					client.CurrentMessage = GetBlockAttributes
+
+					return elementUnderTest.GetBlockAttributes(sessionHandle, blockHandle)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetBlockAttributes_OUTPUT: GetBlockAttributes_OUTPUT{
+						XMLName:     serviceOutput("GetBlockAttributes_OUTPUT"),
+						BlockSize:   inventoryBlockSz,
+						BlockHidden: false,
+						BlockName:   inventoryBlock,
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// READ BLOCK
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService ReadBlock wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, ReadBlock),
+				"",
+				readBody,
+				func() (Response, error) {
+					client.CurrentMessage = ReadBlock
+
+					return elementUnderTest.ReadBlock(sessionHandle, blockHandle, 0, 4)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ReadBlock_OUTPUT: ReadBlock_OUTPUT{
+						XMLName:     serviceOutput("ReadBlock_OUTPUT"),
+						Data:        "MDEyMw==",
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// WRITE BLOCK
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService WriteBlock wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, WriteBlock),
+				"",
+				writeBody,
+				func() (Response, error) {
+					client.CurrentMessage = WriteBlock
+
+					return elementUnderTest.WriteBlock(sessionHandle, blockHandle, 0, []byte("0123"))
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					WriteBlock_OUTPUT: WriteBlock_OUTPUT{
+						XMLName:     serviceOutput("WriteBlock_OUTPUT"),
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// LOCK BLOCK
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService LockBlock wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, LockBlock),
+				"",
+				lockBody,
+				func() (Response, error) {
+					client.CurrentMessage = LockBlock
+
+					return elementUnderTest.LockBlock(sessionHandle, blockHandle)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					LockBlock_OUTPUT: LockBlock_OUTPUT{
+						XMLName:     serviceOutput("LockBlock_OUTPUT"),
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// UNLOCK BLOCK
+			{
+				"should create a valid AMT_ThirdPartyDataStorageService UnlockBlock wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, UnlockBlock),
+				"",
+				unlockBody,
+				func() (Response, error) {
+					client.CurrentMessage = UnlockBlock
+
+					return elementUnderTest.UnlockBlock(sessionHandle, blockHandle)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					UnlockBlock_OUTPUT: UnlockBlock_OUTPUT{
+						XMLName:     serviceOutput("UnlockBlock_OUTPUT"),
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_ThirdPartyDataStorageService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartystorage/service",
+	}
+	elementUnderTest := NewServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ThirdPartyDataStorageService Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService Get wsman message",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService Enumerate wsman message",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService Pull wsman message",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService RegisterApplication wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, RegisterApplication),
+				"",
+				registerBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.RegisterApplication(callerUUID, vendorName, applicationName, enterpriseName)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService ReadBlock wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, ReadBlock),
+				"",
+				readBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.ReadBlock(sessionHandle, blockHandle, 0, 4)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService WriteBlock wsman message",
+				AMTThirdPartyDataStorageService,
+				methods.GenerateAction(AMTThirdPartyDataStorageService, WriteBlock),
+				"",
+				writeBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.WriteBlock(sessionHandle, blockHandle, 0, []byte("0123"))
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
+
+func TestDecodeData(t *testing.T) {
+	data, err := ReadBlock_OUTPUT{Data: "MDEyMw=="}.DecodeData()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("0123"), data)
+
+	_, err = ReadBlock_OUTPUT{Data: "not base64"}.DecodeData()
+	assert.Error(t, err)
+}
@@ -0,0 +1,287 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartystorage
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Service struct {
+	base message.Base
+}
+
+type AdministrationService struct {
+	base message.Base
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                               xml.Name `xml:"Body"`
+		ServiceGetResponse                    ServiceResponse
+		AdministrationServiceGetResponse      AdministrationServiceResponse
+		EnumerateResponse                     common.EnumerateResponse
+		PullResponse                          PullResponse
+		RegisterApplication_OUTPUT            RegisterApplication_OUTPUT
+		UnregisterApplication_OUTPUT          UnregisterApplication_OUTPUT
+		GetMTU_OUTPUT                         GetMTU_OUTPUT
+		GetCurrentApplicationHandles_OUTPUT   GetCurrentApplicationHandles_OUTPUT
+		GetAllocatedBlocks_OUTPUT             GetAllocatedBlocks_OUTPUT
+		AllocateBlock_OUTPUT                  AllocateBlock_OUTPUT
+		DeallocateBlock_OUTPUT                DeallocateBlock_OUTPUT
+		GetBlockAttributes_OUTPUT             GetBlockAttributes_OUTPUT
+		ReadBlock_OUTPUT                      ReadBlock_OUTPUT
+		WriteBlock_OUTPUT                     WriteBlock_OUTPUT
+		LockBlock_OUTPUT                      LockBlock_OUTPUT
+		UnlockBlock_OUTPUT                    UnlockBlock_OUTPUT
+		GetGlobalStorageAttributes_OUTPUT     GetGlobalStorageAttributes_OUTPUT
+		AdminGetRegisteredApplications_OUTPUT AdminGetRegisteredApplications_OUTPUT
+		AdminGetApplicationAttributes_OUTPUT  AdminGetApplicationAttributes_OUTPUT
+		AdminRemoveApplication_OUTPUT         AdminRemoveApplication_OUTPUT
+		AddStorageFpaclEntry_OUTPUT           AddStorageFpaclEntry_OUTPUT
+		RemoveStorageFpaclEntry_OUTPUT        RemoveStorageFpaclEntry_OUTPUT
+	}
+	ServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_ThirdPartyDataStorageService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int      `xml:"EnabledState"`                      // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+	}
+	AdministrationServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_ThirdPartyDataStorageAdministrationService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int      `xml:"EnabledState"`                      // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+	}
+	PullResponse struct {
+		XMLName                    xml.Name                        `xml:"PullResponse"`
+		ServiceItems               []ServiceResponse               `xml:"Items>AMT_ThirdPartyDataStorageService"`
+		AdministrationServiceItems []AdministrationServiceResponse `xml:"Items>AMT_ThirdPartyDataStorageAdministrationService"`
+	}
+	RegisterApplication_OUTPUT struct {
+		XMLName       xml.Name    `xml:"RegisterApplication_OUTPUT"`
+		SessionHandle int         `xml:"SessionHandle"` // The handle of the session of the application, used by every other method of the service.
+		ReturnValue   ReturnValue `xml:"ReturnValue"`
+	}
+	UnregisterApplication_OUTPUT struct {
+		XMLName     xml.Name    `xml:"UnregisterApplication_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetMTU_OUTPUT struct {
+		XMLName     xml.Name    `xml:"GetMTU_OUTPUT"`
+		Mtu         int         `xml:"Mtu"` // The largest number of bytes read or written by a single ReadBlock or WriteBlock.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetCurrentApplicationHandles_OUTPUT struct {
+		XMLName            xml.Name    `xml:"GetCurrentApplicationHandles_OUTPUT"`
+		ApplicationHandles []int       `xml:"ApplicationHandles"` // The handles of the applications registered with the storage.
+		ReturnValue        ReturnValue `xml:"ReturnValue"`
+	}
+	GetAllocatedBlocks_OUTPUT struct {
+		XMLName      xml.Name    `xml:"GetAllocatedBlocks_OUTPUT"`
+		BlockHandles []int       `xml:"BlockHandles"` // The handles of the blocks owned by the application.
+		ReturnValue  ReturnValue `xml:"ReturnValue"`
+	}
+	AllocateBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AllocateBlock_OUTPUT"`
+		BlockHandle int         `xml:"BlockHandle"` // The handle of the new block.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	DeallocateBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"DeallocateBlock_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetBlockAttributes_OUTPUT struct {
+		XMLName     xml.Name    `xml:"GetBlockAttributes_OUTPUT"`
+		BlockSize   int         `xml:"BlockSize"`   // The size of the block, in bytes.
+		BlockHidden bool        `xml:"BlockHidden"` // Indicates whether the block is hidden from the other applications.
+		BlockName   string      `xml:"BlockName"`   // The name of the block.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	ReadBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"ReadBlock_OUTPUT"`
+		Data        string      `xml:"Data"` // The bytes read, base64 encoded.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	WriteBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"WriteBlock_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	LockBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"LockBlock_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	UnlockBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"UnlockBlock_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetGlobalStorageAttributes_OUTPUT struct {
+		XMLName                          xml.Name    `xml:"GetGlobalStorageAttributes_OUTPUT"`
+		TotalStorage                     int         `xml:"TotalStorage"`                     // The size of the storage, in bytes.
+		TotalAllocatedStorage            int         `xml:"TotalAllocatedStorage"`            // The number of bytes allocated to blocks.
+		MaxPartnerStorage                int         `xml:"MaxPartnerStorage"`                // The number of bytes reserved for partner applications.
+		MaxNonPartnerTotalAllocationSize int         `xml:"MaxNonPartnerTotalAllocationSize"` // The number of bytes that can be allocated to non-partner applications.
+		MaxFpaclEntries                  int         `xml:"MaxFpaclEntries"`                  // The largest number of entries in the factory partner allocation list.
+		MaxEaclEntries                   int         `xml:"MaxEaclEntries"`                   // The largest number of entries in the enterprise access control list.
+		ReturnValue                      ReturnValue `xml:"ReturnValue"`
+	}
+	AdminGetRegisteredApplications_OUTPUT struct {
+		XMLName            xml.Name    `xml:"AdminGetRegisteredApplications_OUTPUT"`
+		ApplicationHandles []int       `xml:"ApplicationHandles"` // The handles of the applications registered with the storage.
+		ReturnValue        ReturnValue `xml:"ReturnValue"`
+	}
+	AdminGetApplicationAttributes_OUTPUT struct {
+		XMLName               xml.Name    `xml:"AdminGetApplicationAttributes_OUTPUT"`
+		UUID                  []int       `xml:"UUID"`                  // The UUID of the application, one byte per element.
+		VendorName            string      `xml:"VendorName"`            // The name of the vendor of the application.
+		ApplicationName       string      `xml:"ApplicationName"`       // The name of the application.
+		EnterpriseName        string      `xml:"EnterpriseName"`        // The name of the enterprise the application belongs to.
+		CurrentAllocationSize int         `xml:"CurrentAllocationSize"` // The number of bytes allocated to the blocks of the application.
+		ActiveSession         bool        `xml:"ActiveSession"`         // Indicates whether the application has an open session.
+		Partner               bool        `xml:"Partner"`               // Indicates whether the application is listed in the factory partner allocation list.
+		ReturnValue           ReturnValue `xml:"ReturnValue"`
+	}
+	AdminRemoveApplication_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AdminRemoveApplication_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AddStorageFpaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AddStorageFpaclEntry_OUTPUT"`
+		Handle      int         `xml:"Handle"` // The handle of the new entry.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	RemoveStorageFpaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RemoveStorageFpaclEntry_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	RegisterApplication_INPUT struct {
+		XMLName         xml.Name `xml:"h:RegisterApplication_INPUT"`
+		H               string   `xml:"xmlns:h,attr"`
+		CallerUUID      []int    `xml:"h:CallerUUID"`      // The UUID of the application, one byte per element.
+		VendorName      string   `xml:"h:VendorName"`      // The name of the vendor of the application.
+		ApplicationName string   `xml:"h:ApplicationName"` // The name of the application.
+		EnterpriseName  string   `xml:"h:EnterpriseName"`  // The name of the enterprise the application belongs to.
+	}
+	UnregisterApplication_INPUT struct {
+		XMLName       xml.Name `xml:"h:UnregisterApplication_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+	}
+	GetCurrentApplicationHandles_INPUT struct {
+		XMLName       xml.Name `xml:"h:GetCurrentApplicationHandles_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+	}
+	GetAllocatedBlocks_INPUT struct {
+		XMLName               xml.Name `xml:"h:GetAllocatedBlocks_INPUT"`
+		H                     string   `xml:"xmlns:h,attr"`
+		SessionHandle         int      `xml:"h:SessionHandle"`
+		BlockOwnerApplication int      `xml:"h:BlockOwnerApplication"` // The handle of the application that owns the blocks.
+	}
+	AllocateBlock_INPUT struct {
+		XMLName        xml.Name `xml:"h:AllocateBlock_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SessionHandle  int      `xml:"h:SessionHandle"`
+		BytesRequested int      `xml:"h:BytesRequested"` // The size of the block, in bytes.
+		BlockHidden    bool     `xml:"h:BlockHidden"`    // Indicates whether the block is hidden from the other applications.
+		BlockName      string   `xml:"h:BlockName"`      // The name of the block.
+	}
+	DeallocateBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:DeallocateBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+	}
+	GetBlockAttributes_INPUT struct {
+		XMLName       xml.Name `xml:"h:GetBlockAttributes_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+	}
+	LockBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:LockBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+	}
+	UnlockBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:UnlockBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+	}
+	ReadBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:ReadBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+		ByteOffset    int      `xml:"h:ByteOffset"` // The offset of the first byte read.
+		ByteCount     int      `xml:"h:ByteCount"`  // The number of bytes read, at most the MTU.
+	}
+	WriteBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:WriteBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+		ByteOffset    int      `xml:"h:ByteOffset"` // The offset of the first byte written.
+		Data          string   `xml:"h:Data"`       // The bytes written, base64 encoded, at most the MTU.
+	}
+	AdminGetApplicationAttributes_INPUT struct {
+		XMLName xml.Name `xml:"h:AdminGetApplicationAttributes_INPUT"`
+		H       string   `xml:"xmlns:h,attr"`
+		Handle  int      `xml:"h:Handle"` // The handle of the application.
+	}
+	AdminRemoveApplication_INPUT struct {
+		XMLName xml.Name `xml:"h:AdminRemoveApplication_INPUT"`
+		H       string   `xml:"xmlns:h,attr"`
+		Handle  int      `xml:"h:Handle"` // The handle of the application.
+	}
+	AddStorageFpaclEntry_INPUT struct {
+		XMLName             xml.Name `xml:"h:AddStorageFpaclEntry_INPUT"`
+		H                   string   `xml:"xmlns:h,attr"`
+		ApplicationName     string   `xml:"h:ApplicationName"`     // The name of the application.
+		VendorName          string   `xml:"h:VendorName"`          // The name of the vendor of the application.
+		IsPartner           bool     `xml:"h:IsPartner"`           // Indicates whether the application is a partner, which allocates from the partner storage.
+		TotalAllocationSize int      `xml:"h:TotalAllocationSize"` // The number of bytes reserved for the application.
+	}
+	RemoveStorageFpaclEntry_INPUT struct {
+		XMLName xml.Name `xml:"h:RemoveStorageFpaclEntry_INPUT"`
+		H       string   `xml:"xmlns:h,attr"`
+		Handle  int      `xml:"h:Handle"` // The handle of the entry.
+	}
+)
+
+// Property Types.
+type (
+	// ReturnValue is the PT_STATUS completion status of a storage method.
+	//
+	// ValueMap={0, 1, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 23, 2053, 2056, 2058}
+	//
+	// Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_INVALID_REGISTRATION_DATA, PT_STATUS_APPLICATION_DOES_NOT_EXIST, PT_STATUS_NOT_ENOUGH_STORAGE, PT_STATUS_INVALID_NAME, PT_STATUS_BLOCK_DOES_NOT_EXIST, PT_STATUS_INVALID_BYTE_OFFSET, PT_STATUS_INVALID_BYTE_COUNT, PT_STATUS_NOT_PERMITTED, PT_STATUS_NOT_OWNER, PT_STATUS_BLOCK_LOCKED_BY_OTHER, PT_STATUS_BLOCK_NOT_LOCKED, PT_STATUS_MAX_LIMIT_REACHED, PT_STATUS_INVALID_HANDLE, PT_STATUS_STORAGE_ACL_ENTRY_IN_USE, PT_STATUS_DUPLICATE}
+	ReturnValue int
+)
@@ -98,6 +98,11 @@ func (settingData SettingData) Pull(enumerationContext string) (response Respons
 //
 // This method will not modify the flash ("Enabled" property) until setupandconfiguration.CommitChanges() is issued and performed successfully.
 func (settingData SettingData) Put(instanceID string, tlsSettingData SettingDataRequest) (response Response, err error) {
+	err = settingData.base.Validate(tlsSettingData)
+	if err != nil {
+		return
+	}
+
 	tlsSettingData.H = fmt.Sprintf("%s%s", message.AMTSchema, AMTTLSSettingData)
 	selector := []message.Selector{{
 		Name:  "InstanceID",
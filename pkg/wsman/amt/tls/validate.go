@@ -0,0 +1,28 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package tls
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+
+// localInstanceID is the AMT_TLSSettingData instance of the local interface.
+const localInstanceID = "Intel(r) AMT LMS TLS Settings"
+
+// Validate checks the request against the constraints of AMT_TLSSettingData.
+func (request SettingDataRequest) Validate() error {
+	validation := common.NewValidation(AMTTLSSettingData)
+
+	validation.Required("InstanceID", request.InstanceID != "")
+	validation.Check(request.InstanceID != localInstanceID || !request.AcceptNonSecureConnections, "AcceptNonSecureConnections", "is read-only for the local interface and must not be set")
+	validation.Check(!request.MutualAuthentication || request.Enabled, "MutualAuthentication", "applies only when Enabled is set")
+	validation.Check(len(request.TrustedCN) == 0 || request.MutualAuthentication, "TrustedCN", "applies only when MutualAuthentication is set")
+
+	for _, trustedCN := range request.TrustedCN {
+		validation.Check(trustedCN != "", "TrustedCN", "must not contain empty names")
+		validation.MaxLength("TrustedCN", trustedCN, 255)
+	}
+
+	return validation.Err()
+}
@@ -0,0 +1,97 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package tls
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestSettingDataRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *SettingDataRequest)
+		expected string
+	}{
+		{"valid request", func(request *SettingDataRequest) {}, ""},
+		{"mutual authentication with trusted names", func(request *SettingDataRequest) {
+			request.MutualAuthentication = true
+			request.TrustedCN = []string{"vpro.example.com"}
+		}, ""},
+		{"missing InstanceID", func(request *SettingDataRequest) { request.InstanceID = "" }, "InstanceID is required"},
+		{"MutualAuthentication without Enabled", func(request *SettingDataRequest) {
+			request.Enabled = false
+			request.MutualAuthentication = true
+		}, "MutualAuthentication applies only when Enabled is set"},
+		{"TrustedCN without MutualAuthentication", func(request *SettingDataRequest) { request.TrustedCN = []string{"vpro.example.com"} }, "TrustedCN applies only when MutualAuthentication is set"},
+		{"empty TrustedCN", func(request *SettingDataRequest) {
+			request.MutualAuthentication = true
+			request.TrustedCN = []string{""}
+		}, "TrustedCN must not contain empty names"},
+		{"TrustedCN too long", func(request *SettingDataRequest) {
+			request.MutualAuthentication = true
+			request.TrustedCN = []string{strings.Repeat("a", 256)}
+		}, "TrustedCN must not exceed 255 characters"},
+		{"AcceptNonSecureConnections on the network interface", func(request *SettingDataRequest) { request.AcceptNonSecureConnections = true }, ""},
+		{"AcceptNonSecureConnections on the local interface", func(request *SettingDataRequest) {
+			request.ElementName = localInstanceID
+			request.InstanceID = localInstanceID
+			request.AcceptNonSecureConnections = true
+		}, "AcceptNonSecureConnections is read-only for the local interface and must not be set"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := SettingDataRequest{
+				ElementName: "Intel(r) AMT 802.3 TLS Settings",
+				InstanceID:  "Intel(r) AMT 802.3 TLS Settings",
+				Enabled:     true,
+			}
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: AMT_TLSSettingData."+test.expected)
+		})
+	}
+}
+
+func TestSettingDataGetPutRoundTrip(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	wsmanMessageCreator.ValidateRequests = true
+	client := wsmantesting.MockClient{PackageUnderTest: "amt/tls/settingdata", CurrentMessage: wsmantesting.CurrentMessageGet}
+	elementUnderTest := NewTLSSettingDataWithClient(wsmanMessageCreator, &client)
+
+	response, err := elementUnderTest.Get("Intel(r) AMT 802.3 TLS Settings")
+	assert.NoError(t, err)
+
+	current := response.Body.SettingDataGetAndPutResponse
+	request := SettingDataRequest{
+		AcceptNonSecureConnections:    current.AcceptNonSecureConnections,
+		ElementName:                   current.ElementName,
+		Enabled:                       current.Enabled,
+		InstanceID:                    current.InstanceID,
+		MutualAuthentication:          current.MutualAuthentication,
+		NonSecureConnectionsSupported: true, // reported by newer firmware, not present in the fixture
+		TrustedCN:                     current.TrustedCN,
+	}
+	request.Enabled = true
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePut
+	_, err = elementUnderTest.Put(current.InstanceID, request)
+	assert.NoError(t, err)
+}
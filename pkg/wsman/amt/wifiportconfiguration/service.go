@@ -141,6 +141,11 @@ func (service Service) Put(wiFiPortConfigurationService WiFiPortConfigurationSer
 //
 // Values={Completed with No Error, Not Supported, Failed, Invalid Parameter, Invalid Reference, Method Reserved, Vendor Specific}.
 func (service Service) AddWiFiSettings(wifiEndpointSettings wifi.WiFiEndpointSettingsRequest, ieee8021xSettingsInput models.IEEE8021xSettings, wifiEndpoint, clientCredential, caCredential string) (response Response, err error) {
+	err = service.base.Validate(wifiEndpointSettings)
+	if err != nil {
+		return response, err
+	}
+
 	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTWiFiPortConfigurationService, AddWiFiSettings), AMTWiFiPortConfigurationService, nil, "", "")
 	input := AddWiFiSettings_INPUT{
 		WifiEndpoint: WiFiEndpoint{
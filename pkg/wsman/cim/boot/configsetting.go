@@ -119,8 +119,19 @@ func (configSetting ConfigSetting) Pull(enumerationContext string) (response Res
 //
 // 3) Intel AMT Release 7.0: Returns WSMAN Fault = “access denied” if user consent is required but IPS_OptInService.OptInState value is not 'Received' or 'In Session'. An exception to this rule is when the Source parameter is an empty array.
 func (configSetting ConfigSetting) ChangeBootOrder(source Source) (response Response, err error) {
-	header := configSetting.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(CIMBootConfigSetting, ChangeBootOrder), CIMBootConfigSetting, nil, "", "")
 	body := fmt.Sprintf(`<Body><h:ChangeBootOrder_INPUT xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting"><h:Source><Address xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing">http://schemas.xmlsoap.org/ws/2004/08/addressing</Address><ReferenceParameters xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootSourceSetting</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name="InstanceID">%s</Selector></SelectorSet></ReferenceParameters></h:Source></h:ChangeBootOrder_INPUT></Body>`, source)
+
+	return configSetting.changeBootOrder(body)
+}
+
+// ClearBootOrder calls ChangeBootOrder with an empty Source array, which removes the boot source set for the next boot.
+// Unlike other boot sources, it does not require user consent.
+func (configSetting ConfigSetting) ClearBootOrder() (response Response, err error) {
+	return configSetting.changeBootOrder(`<Body><h:ChangeBootOrder_INPUT xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting"></h:ChangeBootOrder_INPUT></Body>`)
+}
+
+func (configSetting ConfigSetting) changeBootOrder(body string) (response Response, err error) {
+	header := configSetting.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(CIMBootConfigSetting, ChangeBootOrder), CIMBootConfigSetting, nil, "", "")
 	response = Response{
 		Message: &client.Message{
 			XMLInput: configSetting.base.WSManMessageCreator.CreateXML(header, body),
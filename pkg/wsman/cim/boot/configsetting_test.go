@@ -137,6 +137,24 @@ func TestPositiveConfigSetting(t *testing.T) {
 					},
 				},
 			},
+			// Clear Boot Order
+			{
+				"should create and parse a valid cim_BootConfigSetting ChangeBootOrder call without a boot source",
+				CIMBootConfigSetting,
+				methods.GenerateAction(CIMBootConfigSetting, ChangeBootOrder),
+				"<h:ChangeBootOrder_INPUT xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting\"></h:ChangeBootOrder_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = "ChangeBootOrder"
+
+					return elementUnderTest.ClearBootOrder()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ChangeBootOrder_OUTPUT: ChangeBootOrder_OUTPUT{
+						ReturnValue: 0,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -250,6 +268,24 @@ func TestNegativeConfigSetting(t *testing.T) {
 					},
 				},
 			},
+			// Clear Boot Order
+			{
+				"should handle error when cim_BootConfigSetting ChangeBootOrder call without a boot source",
+				CIMBootConfigSetting,
+				methods.GenerateAction(CIMBootConfigSetting, ChangeBootOrder),
+				"<h:ChangeBootOrder_INPUT xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting\"></h:ChangeBootOrder_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.ClearBootOrder()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ChangeBootOrder_OUTPUT: ChangeBootOrder_OUTPUT{
+						ReturnValue: 0,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
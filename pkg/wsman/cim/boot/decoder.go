@@ -19,6 +19,7 @@ const (
 	HardDrive           Source = "Intel(r) AMT: Force Hard-drive Boot"
 	CD                  Source = "Intel(r) AMT: Force CD/DVD Boot"
 	PXE                 Source = "Intel(r) AMT: Force PXE Boot"
+	Diagnostic          Source = "Intel(r) AMT: Force Diagnostic Boot"
 	OCRUEFIHTTPS        Source = "Intel(r) AMT: Force OCR UEFI HTTPS Boot"
 	OCRUEFIBootOption1  Source = "Intel(r) AMT: Force OCR UEFI Boot Option 1"
 	OCRUEFIBootOption2  Source = "Intel(r) AMT: Force OCR UEFI Boot Option 2"
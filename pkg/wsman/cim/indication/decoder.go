@@ -0,0 +1,155 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+const (
+	CIMFilterCollection                string = "CIM_FilterCollection"
+	CIMFilterCollectionSubscription    string = "CIM_FilterCollectionSubscription"
+	CIMIndicationFilter                string = "CIM_IndicationFilter"
+	CIMIndicationSubscription          string = "CIM_IndicationSubscription"
+	CIMListenerDestinationWSManagement string = "CIM_ListenerDestinationWSManagement"
+	ValueNotFound                      string = "Value not found in map"
+)
+
+// AllEvents is the InstanceID of the CIM_FilterCollection that holds every indication filter of Intel® AMT.
+const AllEvents = "Intel(r) AMT:All"
+
+const (
+	DeliveryModePush DeliveryMode = iota + 2
+	DeliveryModePushWithAck
+	DeliveryModeEvents
+	DeliveryModePull
+)
+
+// deliveryModeToString is a map of DeliveryMode values to their string representation.
+var deliveryModeToString = map[DeliveryMode]string{
+	DeliveryModePush:        "Push",
+	DeliveryModePushWithAck: "PushWithAck",
+	DeliveryModeEvents:      "Events",
+	DeliveryModePull:        "Pull",
+}
+
+// deliveryModeToURI is a map of DeliveryMode values to the URI of the mode in a WS-Eventing Subscribe.
+var deliveryModeToURI = map[DeliveryMode]string{
+	DeliveryModePush:        "http://schemas.xmlsoap.org/ws/2004/08/eventing/DeliveryModes/Push",
+	DeliveryModePushWithAck: "http://schemas.dmtf.org/wbem/wsman/1/wsman/PushWithAck",
+	DeliveryModeEvents:      "http://schemas.dmtf.org/wbem/wsman/1/wsman/Events",
+	DeliveryModePull:        "http://schemas.dmtf.org/wbem/wsman/1/wsman/Pull",
+}
+
+// String returns the string representation of the DeliveryMode value.
+func (d DeliveryMode) String() string {
+	if value, exists := deliveryModeToString[d]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// URI returns the URI of the DeliveryMode value in a WS-Eventing Subscribe, or an empty string for an unknown value.
+func (d DeliveryMode) URI() string {
+	return deliveryModeToURI[d]
+}
+
+const (
+	PersistenceTypeOther PersistenceType = iota + 1
+	PersistenceTypePermanent
+	PersistenceTypeTransient
+)
+
+// persistenceTypeToString is a map of PersistenceType values to their string representation.
+var persistenceTypeToString = map[PersistenceType]string{
+	PersistenceTypeOther:     "Other",
+	PersistenceTypePermanent: "Permanent",
+	PersistenceTypeTransient: "Transient",
+}
+
+// String returns the string representation of the PersistenceType value.
+func (p PersistenceType) String() string {
+	if value, exists := persistenceTypeToString[p]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ProtocolOther Protocol = iota + 1
+	ProtocolCIMXML
+	ProtocolSMCLP
+	ProtocolWSManagement
+	ProtocolWSDM
+)
+
+// protocolToString is a map of Protocol values to their string representation.
+var protocolToString = map[Protocol]string{
+	ProtocolOther:        "Other",
+	ProtocolCIMXML:       "CIM-XML",
+	ProtocolSMCLP:        "SM CLP",
+	ProtocolWSManagement: "WS-Management",
+	ProtocolWSDM:         "WSDM",
+}
+
+// String returns the string representation of the Protocol value.
+func (p Protocol) String() string {
+	if value, exists := protocolToString[p]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	OnFatalErrorPolicyUnknown OnFatalErrorPolicy = iota
+	OnFatalErrorPolicyOther
+	OnFatalErrorPolicyIgnore
+	OnFatalErrorPolicyDisable
+	OnFatalErrorPolicyRemove
+)
+
+// onFatalErrorPolicyToString is a map of OnFatalErrorPolicy values to their string representation.
+var onFatalErrorPolicyToString = map[OnFatalErrorPolicy]string{
+	OnFatalErrorPolicyUnknown: "Unknown",
+	OnFatalErrorPolicyOther:   "Other",
+	OnFatalErrorPolicyIgnore:  "Ignore",
+	OnFatalErrorPolicyDisable: "Disable",
+	OnFatalErrorPolicyRemove:  "Remove",
+}
+
+// String returns the string representation of the OnFatalErrorPolicy value.
+func (o OnFatalErrorPolicy) String() string {
+	if value, exists := onFatalErrorPolicyToString[o]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	SubscriptionStateUnknown SubscriptionState = iota
+	SubscriptionStateOther
+	SubscriptionStateEnabled
+	SubscriptionStateEnabledDegraded
+	SubscriptionStateDisabled
+)
+
+// subscriptionStateToString is a map of SubscriptionState values to their string representation.
+var subscriptionStateToString = map[SubscriptionState]string{
+	SubscriptionStateUnknown:         "Unknown",
+	SubscriptionStateOther:           "Other",
+	SubscriptionStateEnabled:         "Enabled",
+	SubscriptionStateEnabledDegraded: "EnabledDegraded",
+	SubscriptionStateDisabled:        "Disabled",
+}
+
+// String returns the string representation of the SubscriptionState value.
+func (s SubscriptionState) String() string {
+	if value, exists := subscriptionStateToString[s]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
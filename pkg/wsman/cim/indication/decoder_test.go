@@ -0,0 +1,130 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import "testing"
+
+func TestDeliveryMode_String(t *testing.T) {
+	tests := []struct {
+		state    DeliveryMode
+		expected string
+	}{
+		{DeliveryModePush, "Push"},
+		{DeliveryModePushWithAck, "PushWithAck"},
+		{DeliveryModeEvents, "Events"},
+		{DeliveryModePull, "Pull"},
+		{DeliveryMode(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestDeliveryMode_URI(t *testing.T) {
+	tests := []struct {
+		state    DeliveryMode
+		expected string
+	}{
+		{DeliveryModePush, "http://schemas.xmlsoap.org/ws/2004/08/eventing/DeliveryModes/Push"},
+		{DeliveryModePushWithAck, "http://schemas.dmtf.org/wbem/wsman/1/wsman/PushWithAck"},
+		{DeliveryModeEvents, "http://schemas.dmtf.org/wbem/wsman/1/wsman/Events"},
+		{DeliveryModePull, "http://schemas.dmtf.org/wbem/wsman/1/wsman/Pull"},
+		{DeliveryMode(999), ""},
+	}
+
+	for _, test := range tests {
+		result := test.state.URI()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestPersistenceType_String(t *testing.T) {
+	tests := []struct {
+		state    PersistenceType
+		expected string
+	}{
+		{PersistenceTypeOther, "Other"},
+		{PersistenceTypePermanent, "Permanent"},
+		{PersistenceTypeTransient, "Transient"},
+		{PersistenceType(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestProtocol_String(t *testing.T) {
+	tests := []struct {
+		state    Protocol
+		expected string
+	}{
+		{ProtocolOther, "Other"},
+		{ProtocolCIMXML, "CIM-XML"},
+		{ProtocolSMCLP, "SM CLP"},
+		{ProtocolWSManagement, "WS-Management"},
+		{ProtocolWSDM, "WSDM"},
+		{Protocol(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestOnFatalErrorPolicy_String(t *testing.T) {
+	tests := []struct {
+		state    OnFatalErrorPolicy
+		expected string
+	}{
+		{OnFatalErrorPolicyUnknown, "Unknown"},
+		{OnFatalErrorPolicyOther, "Other"},
+		{OnFatalErrorPolicyIgnore, "Ignore"},
+		{OnFatalErrorPolicyDisable, "Disable"},
+		{OnFatalErrorPolicyRemove, "Remove"},
+		{OnFatalErrorPolicy(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestSubscriptionState_String(t *testing.T) {
+	tests := []struct {
+		state    SubscriptionState
+		expected string
+	}{
+		{SubscriptionStateUnknown, "Unknown"},
+		{SubscriptionStateOther, "Other"},
+		{SubscriptionStateEnabled, "Enabled"},
+		{SubscriptionStateEnabledDegraded, "EnabledDegraded"},
+		{SubscriptionStateDisabled, "Disabled"},
+		{SubscriptionState(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package indication facilitates communication with Intel® AMT devices to route the alerts they raise to an external collector.
+//
+// Filters:
+// CIM_IndicationFilter selects a class of alerts. Intel® AMT provides the filters and groups them in CIM_FilterCollection instances.
+//
+// Destinations:
+// CIM_ListenerDestinationWSManagement is the address alerts are delivered to, such as a PET (SNMP trap) receiver.
+//
+// Subscriptions:
+// CIM_IndicationSubscription associates a filter with a destination.
+// CIM_FilterCollectionSubscription is created by a WS-Eventing Subscribe to a filter collection and removed by an Unsubscribe.
+package indication
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewFilterWithClient instantiates a new Filter.
+func NewFilterWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Filter {
+	return Filter{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMIndicationFilter, client),
+	}
+}
+
+// Get retrieves the representation of the filter with the given Name.
+func (filter Filter) Get(name string) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "Name",
+		Value: name,
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (filter Filter) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (filter Filter) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
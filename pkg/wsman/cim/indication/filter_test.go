@@ -0,0 +1,221 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const petFilterName = "Intel(r) AMT:PET"
+
+func indicationFilter(name, query string) FilterResponse {
+	return FilterResponse{
+		XMLName:                         xml.Name{Space: fmt.Sprintf("%s%s", message.CIMSchema, CIMIndicationFilter), Local: CIMIndicationFilter},
+		CreationClassName:               CIMIndicationFilter,
+		Name:                            name,
+		SystemCreationClassName:         "CIM_ComputerSystem",
+		SystemName:                      "Intel(r) AMT",
+		ElementName:                     name,
+		Query:                           query,
+		QueryLanguage:                   "WQL",
+		IndividualSubscriptionSupported: true,
+	}
+}
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			FilterGetResponse: FilterResponse{
+				Name: petFilterName,
+			},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"FilterGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"Intel(r) AMT:PET\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"Query\":\"\",\"QueryLanguage\":\"\",\"IndividualSubscriptionSupported\":false},\"ListenerDestinationGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"Destination\":\"\",\"PersistenceType\":0,\"Protocol\":0,\"DeliveryMode\":0},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"FilterItems\":null,\"FilterCollectionSubscriptionItems\":null,\"ListenerDestinationItems\":null,\"SubscriptionItems\":null},\"CreateResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"Selectors\":null},\"Identifier\":\"\"}},\"SubscribeResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"SubscriptionManager\":{\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"Selectors\":null},\"Identifier\":\"\"}},\"Expires\":\"\"}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			FilterGetResponse: FilterResponse{
+				Name: petFilterName,
+			},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nfiltergetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: Intel(r) AMT:PET\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    query: \"\"\n    querylanguage: \"\"\n    individualsubscriptionsupported: false\nlistenerdestinationgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    destination: \"\"\n    persistencetype: 0\n    protocol: 0\n    deliverymode: 0\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    filteritems: []\n    filtercollectionsubscriptionitems: []\n    listenerdestinationitems: []\n    subscriptionitems: []\ncreateresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    address: \"\"\n    referenceparameters:\n        resourceuri: \"\"\n        selectorset:\n            selectors: []\n        identifier: \"\"\nsubscriberesponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    subscriptionmanager:\n        address: \"\"\n        referenceparameters:\n            resourceuri: \"\"\n            selectorset:\n                selectors: []\n            identifier: \"\"\n    expires: \"\"\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveCIM_IndicationFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/filter",
+	}
+	elementUnderTest := NewFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_IndicationFilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid CIM_IndicationFilter Get wsman message",
+				CIMIndicationFilter,
+				wsmantesting.Get,
+				`<w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:PET</w:Selector></w:SelectorSet>`,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(petFilterName)
+				},
+				Body{
+					XMLName:           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					FilterGetResponse: indicationFilter(petFilterName, "SELECT * FROM CIM_AlertIndication"),
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid CIM_IndicationFilter Enumerate wsman message",
+				CIMIndicationFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid CIM_IndicationFilter Pull wsman message",
+				CIMIndicationFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						FilterItems: []FilterResponse{
+							indicationFilter(petFilterName, "SELECT * FROM CIM_AlertIndication"),
+							indicationFilter("Intel(r) AMT:Audit Log", "SELECT * FROM AMT_AuditLogIndication"),
+						},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeCIM_IndicationFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/filter",
+	}
+	elementUnderTest := NewFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_IndicationFilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when CIM_IndicationFilter Get wsman message fails",
+				CIMIndicationFilter,
+				wsmantesting.Get,
+				`<w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:PET</w:Selector></w:SelectorSet>`,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(petFilterName)
+				},
+			},
+			{
+				"should handle error when CIM_IndicationFilter Enumerate wsman message fails",
+				CIMIndicationFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when CIM_IndicationFilter Pull wsman message fails",
+				CIMIndicationFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,159 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewFilterCollectionSubscriptionWithClient instantiates a new FilterCollectionSubscription.
+func NewFilterCollectionSubscriptionWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) FilterCollectionSubscription {
+	return FilterCollectionSubscription{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMFilterCollectionSubscription, client),
+	}
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (subscription FilterCollectionSubscription) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (subscription FilterCollectionSubscription) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Subscribe delivers the alerts selected by a filter collection to the collector at request.NotifyTo with WS-Eventing.
+// The Identifier in the SubscriptionManager of the response is the argument of Unsubscribe.
+func (subscription FilterCollectionSubscription) Subscribe(request SubscribeRequest) (response Response, err error) {
+	selectors := []message.Selector{{Name: "InstanceID", Value: request.FilterCollection}}
+	header := subscription.base.WSManMessageCreator.CreateEventingHeader(message.BaseActionsSubscribe, selectors, "")
+
+	body, err := xml.Marshal(Subscribe_INPUT{
+		E: message.XMLEventingSpace,
+		Delivery: Delivery{
+			Mode:     request.DeliveryMode.URI(),
+			NotifyTo: NotifyTo{Address: request.NotifyTo},
+		},
+		Expires: request.Expires,
+	})
+	if err != nil {
+		return
+	}
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.WSManMessageCreator.CreateXML(header, "<Body>"+string(body)+"</Body>"),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Unsubscribe cancels the WS-Eventing subscription with the given identifier, as returned by Subscribe.
+func (subscription FilterCollectionSubscription) Unsubscribe(identifier string) (response Response, err error) {
+	header := subscription.base.WSManMessageCreator.CreateEventingHeader(message.BaseActionsUnsubscribe, nil, identifier)
+
+	body, err := xml.Marshal(Unsubscribe_INPUT{E: message.XMLEventingSpace})
+	if err != nil {
+		return
+	}
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.WSManMessageCreator.CreateXML(header, "<Body>"+string(body)+"</Body>"),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete removes the subscription of the destination with the given Name to the filter collection with the given InstanceID.
+func (subscription FilterCollectionSubscription) Delete(filterCollection, destinationName string) (response Response, err error) {
+	selectors := []message.Selector{
+		{
+			Name:  "Filter",
+			Value: selectorReference(CIMFilterCollection, "InstanceID", filterCollection),
+		},
+		{
+			Name:  "Handler",
+			Value: selectorReference(CIMListenerDestinationWSManagement, "Name", destinationName),
+		},
+	}
+	header := subscription.base.WSManMessageCreator.CreateHeader(message.BaseActionsDelete, CIMFilterCollectionSubscription, selectors, "", "")
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.WSManMessageCreator.CreateXML(header, message.DeleteBody),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
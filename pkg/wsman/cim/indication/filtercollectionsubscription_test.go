@@ -0,0 +1,281 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	eventingResourceURIBase               = "http://schemas.dmtf.org/wbem/wscim/1/"
+	subscriptionIdentifier                = "uuid:4b2a6ad6-7e2a-4a8c-9e5b-1f2c3d4e5f60"
+	collectorDestinationName              = "Intel(r) AMT:Destination 1"
+	subscribeBody                         = `<e:Subscribe xmlns:e="http://schemas.xmlsoap.org/ws/2004/08/eventing"><e:Delivery Mode="http://schemas.dmtf.org/wbem/wsman/1/wsman/PushWithAck"><e:NotifyTo><a:Address>http://192.168.0.10:16997/events</a:Address></e:NotifyTo></e:Delivery><e:Expires>PT1H</e:Expires></e:Subscribe>`
+	subscribeSelectors                    = `<w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:All</w:Selector></w:SelectorSet>`
+	unsubscribeBody                       = `<e:Unsubscribe xmlns:e="http://schemas.xmlsoap.org/ws/2004/08/eventing"></e:Unsubscribe>`
+	unsubscribeHeader                     = `<e:Identifier xmlns:e="http://schemas.xmlsoap.org/ws/2004/08/eventing">uuid:4b2a6ad6-7e2a-4a8c-9e5b-1f2c3d4e5f60</e:Identifier>`
+	filterCollectionSubscriptionSelectors = `<w:SelectorSet><w:Selector Name="Filter"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_FilterCollection</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:All</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector><w:Selector Name="Handler"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement</w:ResourceURI><w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:Destination 1</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector></w:SelectorSet>`
+)
+
+var subscribeRequest = SubscribeRequest{
+	FilterCollection: AllEvents,
+	DeliveryMode:     DeliveryModePushWithAck,
+	NotifyTo:         "http://192.168.0.10:16997/events",
+	Expires:          "PT1H",
+}
+
+func TestPositiveCIM_FilterCollectionSubscription(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/filtercollectionsubscription",
+	}
+	elementUnderTest := NewFilterCollectionSubscriptionWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_FilterCollectionSubscription Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			resourceURIBase  string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// ENUMERATES
+			{
+				"should create a valid CIM_FilterCollectionSubscription Enumerate wsman message",
+				resourceURIBase,
+				CIMFilterCollectionSubscription,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid CIM_FilterCollectionSubscription Pull wsman message",
+				resourceURIBase,
+				CIMFilterCollectionSubscription,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						FilterCollectionSubscriptionItems: []FilterCollectionSubscriptionResponse{
+							{
+								XMLName:              xml.Name{Space: fmt.Sprintf("%s%s", message.CIMSchema, CIMFilterCollectionSubscription), Local: CIMFilterCollectionSubscription},
+								Filter:               endpointReference(CIMFilterCollection, "InstanceID", AllEvents),
+								Handler:              endpointReference(CIMListenerDestinationWSManagement, "Name", collectorDestinationName),
+								OnFatalErrorPolicy:   OnFatalErrorPolicyIgnore,
+								SubscriptionState:    SubscriptionStateEnabled,
+								SubscriptionDuration: 3600,
+							},
+						},
+					},
+				},
+			},
+			// SUBSCRIBE
+			{
+				"should create a valid CIM_FilterCollectionSubscription Subscribe wsman message",
+				eventingResourceURIBase,
+				"*",
+				message.BaseActionsSubscribe,
+				subscribeSelectors,
+				subscribeBody,
+				func() (Response, error) {
+					client.CurrentMessage = "Subscribe"
+
+					return elementUnderTest.Subscribe(subscribeRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SubscribeResponse: SubscribeResponse{
+						XMLName: xml.Name{Space: message.XMLEventingSpace, Local: "SubscribeResponse"},
+						SubscriptionManager: EndpointReferenceResponse{
+							Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+							ReferenceParameters: ReferenceParametersResponse{
+								ResourceURI: message.EventingResourceURI,
+								Identifier:  subscriptionIdentifier,
+							},
+						},
+						Expires: "PT1H",
+					},
+				},
+			},
+			// UNSUBSCRIBE
+			{
+				"should create a valid CIM_FilterCollectionSubscription Unsubscribe wsman message",
+				eventingResourceURIBase,
+				"*",
+				message.BaseActionsUnsubscribe,
+				unsubscribeHeader,
+				unsubscribeBody,
+				func() (Response, error) {
+					client.CurrentMessage = "Unsubscribe"
+
+					return elementUnderTest.Unsubscribe(subscriptionIdentifier)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+			// DELETE
+			{
+				"should create a valid CIM_FilterCollectionSubscription Delete wsman message",
+				resourceURIBase,
+				CIMFilterCollectionSubscription,
+				wsmantesting.Delete,
+				filterCollectionSubscriptionSelectors,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(AllEvents, collectorDestinationName)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, test.resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeCIM_FilterCollectionSubscription(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/filtercollectionsubscription",
+	}
+	elementUnderTest := NewFilterCollectionSubscriptionWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_FilterCollectionSubscription Tests", func(t *testing.T) {
+		tests := []struct {
+			name            string
+			resourceURIBase string
+			method          string
+			action          string
+			extraHeader     string
+			body            string
+			responseFunc    func() (Response, error)
+		}{
+			{
+				"should handle error when CIM_FilterCollectionSubscription Enumerate wsman message fails",
+				resourceURIBase,
+				CIMFilterCollectionSubscription,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when CIM_FilterCollectionSubscription Pull wsman message fails",
+				resourceURIBase,
+				CIMFilterCollectionSubscription,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when CIM_FilterCollectionSubscription Subscribe wsman message fails",
+				eventingResourceURIBase,
+				"*",
+				message.BaseActionsSubscribe,
+				subscribeSelectors,
+				subscribeBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Subscribe(subscribeRequest)
+				},
+			},
+			{
+				"should handle error when CIM_FilterCollectionSubscription Unsubscribe wsman message fails",
+				eventingResourceURIBase,
+				"*",
+				message.BaseActionsUnsubscribe,
+				unsubscribeHeader,
+				unsubscribeBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Unsubscribe(subscriptionIdentifier)
+				},
+			},
+			{
+				"should handle error when CIM_FilterCollectionSubscription Delete wsman message fails",
+				resourceURIBase,
+				CIMFilterCollectionSubscription,
+				wsmantesting.Delete,
+				filterCollectionSubscriptionSelectors,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(AllEvents, collectorDestinationName)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, test.resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
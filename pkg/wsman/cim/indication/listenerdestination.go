@@ -0,0 +1,132 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewListenerDestinationWithClient instantiates a new ListenerDestination.
+func NewListenerDestinationWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ListenerDestination {
+	return ListenerDestination{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMListenerDestinationWSManagement, client),
+	}
+}
+
+// Get retrieves the representation of the destination with the given Name.
+func (destination ListenerDestination) Get(name string) (response Response, err error) {
+	selector := message.Selector{
+		Name:  "Name",
+		Value: name,
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: destination.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = destination.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (destination ListenerDestination) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: destination.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = destination.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (destination ListenerDestination) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: destination.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = destination.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create adds a destination alerts can be delivered to. The Name of the new destination is returned in the selectors of the CreateResponse, and the destination receives no alert until a subscription references it.
+func (destination ListenerDestination) Create(listenerDestination ListenerDestinationRequest) (response Response, err error) {
+	listenerDestination.H = fmt.Sprintf("%s%s", message.CIMSchema, CIMListenerDestinationWSManagement)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: destination.base.Create(listenerDestination, nil),
+		},
+	}
+	// send the message to AMT
+	err = destination.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete removes the destination with the given Name.
+func (destination ListenerDestination) Delete(name string) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: destination.base.Delete(selector),
+		},
+	}
+	// send the message to AMT
+	err = destination.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,279 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	petDestinationName        = "Intel(r) AMT:Destination 0"
+	listenerDestinationBody   = `<h:CIM_ListenerDestinationWSManagement xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement"><h:ElementName>PET Collector</h:ElementName><h:Destination>192.168.0.10</h:Destination><h:PersistenceType>2</h:PersistenceType><h:Protocol>4</h:Protocol><h:DeliveryMode>2</h:DeliveryMode></h:CIM_ListenerDestinationWSManagement>`
+	listenerDestinationHeader = `<w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:Destination 0</w:Selector></w:SelectorSet>`
+)
+
+var listenerDestinationRequest = ListenerDestinationRequest{
+	ElementName:     "PET Collector",
+	Destination:     "192.168.0.10",
+	PersistenceType: PersistenceTypePermanent,
+	Protocol:        ProtocolWSManagement,
+	DeliveryMode:    DeliveryModePush,
+}
+
+func listenerDestination(name, destination string, deliveryMode DeliveryMode) ListenerDestinationResponse {
+	return ListenerDestinationResponse{
+		XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.CIMSchema, CIMListenerDestinationWSManagement), Local: CIMListenerDestinationWSManagement},
+		CreationClassName:       CIMListenerDestinationWSManagement,
+		Name:                    name,
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		ElementName:             name,
+		Destination:             destination,
+		PersistenceType:         PersistenceTypePermanent,
+		Protocol:                ProtocolWSManagement,
+		DeliveryMode:            deliveryMode,
+	}
+}
+
+func TestPositiveCIM_ListenerDestinationWSManagement(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/listenerdestination",
+	}
+	elementUnderTest := NewListenerDestinationWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_ListenerDestinationWSManagement Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid CIM_ListenerDestinationWSManagement Get wsman message",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Get,
+				listenerDestinationHeader,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(petDestinationName)
+				},
+				Body{
+					XMLName:                        xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ListenerDestinationGetResponse: listenerDestination(petDestinationName, "192.168.0.10", DeliveryModePush),
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid CIM_ListenerDestinationWSManagement Enumerate wsman message",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid CIM_ListenerDestinationWSManagement Pull wsman message",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						ListenerDestinationItems: []ListenerDestinationResponse{
+							listenerDestination(petDestinationName, "192.168.0.10", DeliveryModePush),
+							listenerDestination("Intel(r) AMT:Destination 1", "http://192.168.0.10:16997/events", DeliveryModePushWithAck),
+						},
+					},
+				},
+			},
+			// CREATES
+			{
+				"should create a valid CIM_ListenerDestinationWSManagement Create wsman message",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Create,
+				"",
+				listenerDestinationBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(listenerDestinationRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParametersResponse{
+							ResourceURI: fmt.Sprintf("%s%s", message.CIMSchema, CIMListenerDestinationWSManagement),
+							SelectorSet: SelectorSetResponse{
+								Selectors: []SelectorResponse{
+									{Name: "CreationClassName", Text: CIMListenerDestinationWSManagement},
+									{Name: "Name", Text: petDestinationName},
+									{Name: "SystemCreationClassName", Text: "CIM_ComputerSystem"},
+									{Name: "SystemName", Text: "Intel(r) AMT"},
+								},
+							},
+						},
+					},
+				},
+			},
+			// DELETE
+			{
+				"should create a valid CIM_ListenerDestinationWSManagement Delete wsman message",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Delete,
+				listenerDestinationHeader,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(petDestinationName)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeCIM_ListenerDestinationWSManagement(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/listenerdestination",
+	}
+	elementUnderTest := NewListenerDestinationWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_ListenerDestinationWSManagement Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when CIM_ListenerDestinationWSManagement Get wsman message fails",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Get,
+				listenerDestinationHeader,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(petDestinationName)
+				},
+			},
+			{
+				"should handle error when CIM_ListenerDestinationWSManagement Enumerate wsman message fails",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when CIM_ListenerDestinationWSManagement Pull wsman message fails",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when CIM_ListenerDestinationWSManagement Create wsman message fails",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Create,
+				"",
+				listenerDestinationBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(listenerDestinationRequest)
+				},
+			},
+			{
+				"should handle error when CIM_ListenerDestinationWSManagement Delete wsman message fails",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Delete,
+				listenerDestinationHeader,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(petDestinationName)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
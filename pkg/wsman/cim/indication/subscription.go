@@ -0,0 +1,137 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewSubscriptionWithClient instantiates a new Subscription.
+func NewSubscriptionWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Subscription {
+	return Subscription{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMIndicationSubscription, client),
+	}
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (subscription Subscription) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (subscription Subscription) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create subscribes the destination with the given Name to the alerts selected by the filter with the given Name.
+func (subscription Subscription) Create(filterName, destinationName string) (response Response, err error) {
+	request := SubscriptionRequest{
+		H:       fmt.Sprintf("%s%s", message.CIMSchema, CIMIndicationSubscription),
+		Filter:  reference(CIMIndicationFilter, "Name", filterName),
+		Handler: reference(CIMListenerDestinationWSManagement, "Name", destinationName),
+	}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.Create(request, nil),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete unsubscribes the destination with the given Name from the filter with the given Name.
+func (subscription Subscription) Delete(filterName, destinationName string) (response Response, err error) {
+	selectors := []message.Selector{
+		{
+			Name:  "Filter",
+			Value: selectorReference(CIMIndicationFilter, "Name", filterName),
+		},
+		{
+			Name:  "Handler",
+			Value: selectorReference(CIMListenerDestinationWSManagement, "Name", destinationName),
+		},
+	}
+	header := subscription.base.WSManMessageCreator.CreateHeader(message.BaseActionsDelete, CIMIndicationSubscription, selectors, "", "")
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.WSManMessageCreator.CreateXML(header, message.DeleteBody),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// reference returns a reference to the instance of the CIM class with the given key.
+func reference(class, key, value string) EndpointReference {
+	return EndpointReference{
+		Address: "/wsman",
+		ReferenceParameters: ReferenceParameters{
+			ResourceURI: message.CIMSchema + class,
+			SelectorSet: SelectorSet{Selectors: []Selector{{Name: key, Text: value}}},
+		},
+	}
+}
+
+// selectorReference returns a reference to the instance of the CIM class with the given key, in the form used as the value of a header selector.
+func selectorReference(class, key, value string) string {
+	return fmt.Sprintf(`<a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%s%s</w:ResourceURI><w:SelectorSet><w:Selector Name=%q>%s</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference>`, message.CIMSchema, class, key, value)
+}
@@ -0,0 +1,243 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	subscriptionBody      = `<h:CIM_IndicationSubscription xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationSubscription"><h:Filter><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationFilter</w:ResourceURI><w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:PET</w:Selector></w:SelectorSet></a:ReferenceParameters></h:Filter><h:Handler><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement</w:ResourceURI><w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:Destination 0</w:Selector></w:SelectorSet></a:ReferenceParameters></h:Handler></h:CIM_IndicationSubscription>`
+	subscriptionSelectors = `<w:SelectorSet><w:Selector Name="Filter"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationFilter</w:ResourceURI><w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:PET</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector><w:Selector Name="Handler"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement</w:ResourceURI><w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:Destination 0</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector></w:SelectorSet>`
+)
+
+func endpointReference(class, name, value string) EndpointReferenceResponse {
+	return EndpointReferenceResponse{
+		Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+		ReferenceParameters: ReferenceParametersResponse{
+			ResourceURI: fmt.Sprintf("%s%s", message.CIMSchema, class),
+			SelectorSet: SelectorSetResponse{
+				Selectors: []SelectorResponse{{Name: name, Text: value}},
+			},
+		},
+	}
+}
+
+func TestPositiveCIM_IndicationSubscription(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/subscription",
+	}
+	elementUnderTest := NewSubscriptionWithClient(wsmanMessageCreator, &client)
+	filter := endpointReference(CIMIndicationFilter, "Name", petFilterName)
+	destination := endpointReference(CIMListenerDestinationWSManagement, "Name", petDestinationName)
+
+	t.Run("cim_IndicationSubscription Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// ENUMERATES
+			{
+				"should create a valid CIM_IndicationSubscription Enumerate wsman message",
+				CIMIndicationSubscription,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid CIM_IndicationSubscription Pull wsman message",
+				CIMIndicationSubscription,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						SubscriptionItems: []SubscriptionResponse{
+							{
+								XMLName:            xml.Name{Space: fmt.Sprintf("%s%s", message.CIMSchema, CIMIndicationSubscription), Local: CIMIndicationSubscription},
+								Filter:             filter,
+								Handler:            destination,
+								OnFatalErrorPolicy: OnFatalErrorPolicyIgnore,
+								SubscriptionState:  SubscriptionStateEnabled,
+							},
+						},
+					},
+				},
+			},
+			// CREATES
+			{
+				"should create a valid CIM_IndicationSubscription Create wsman message",
+				CIMIndicationSubscription,
+				wsmantesting.Create,
+				"",
+				subscriptionBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(petFilterName, petDestinationName)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParametersResponse{
+							ResourceURI: fmt.Sprintf("%s%s", message.CIMSchema, CIMIndicationSubscription),
+							SelectorSet: SelectorSetResponse{
+								Selectors: []SelectorResponse{
+									{Name: "Filter", EndpointReference: &filter},
+									{Name: "Handler", EndpointReference: &destination},
+								},
+							},
+						},
+					},
+				},
+			},
+			// DELETE
+			{
+				"should create a valid CIM_IndicationSubscription Delete wsman message",
+				CIMIndicationSubscription,
+				wsmantesting.Delete,
+				subscriptionSelectors,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(petFilterName, petDestinationName)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeCIM_IndicationSubscription(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/subscription",
+	}
+	elementUnderTest := NewSubscriptionWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_IndicationSubscription Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when CIM_IndicationSubscription Enumerate wsman message fails",
+				CIMIndicationSubscription,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when CIM_IndicationSubscription Pull wsman message fails",
+				CIMIndicationSubscription,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when CIM_IndicationSubscription Create wsman message fails",
+				CIMIndicationSubscription,
+				wsmantesting.Create,
+				"",
+				subscriptionBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(petFilterName, petDestinationName)
+				},
+			},
+			{
+				"should handle error when CIM_IndicationSubscription Delete wsman message fails",
+				CIMIndicationSubscription,
+				wsmantesting.Delete,
+				subscriptionSelectors,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(petFilterName, petDestinationName)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,216 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Filter struct {
+	base message.Base
+}
+
+type FilterCollectionSubscription struct {
+	base message.Base
+}
+
+type ListenerDestination struct {
+	base message.Base
+}
+
+type Subscription struct {
+	base message.Base
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                        xml.Name `xml:"Body"`
+		FilterGetResponse              FilterResponse
+		ListenerDestinationGetResponse ListenerDestinationResponse
+		EnumerateResponse              common.EnumerateResponse
+		PullResponse                   PullResponse
+		CreateResponse                 CreateResponse
+		SubscribeResponse              SubscribeResponse
+	}
+	FilterResponse struct {
+		XMLName                         xml.Name `xml:"CIM_IndicationFilter"`
+		CreationClassName               string   `xml:"CreationClassName,omitempty"`               // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                            string   `xml:"Name,omitempty"`                            // The name of the filter.
+		SystemCreationClassName         string   `xml:"SystemCreationClassName,omitempty"`         // The scoping System's CreationClassName.
+		SystemName                      string   `xml:"SystemName,omitempty"`                      // The scoping System's Name.
+		ElementName                     string   `xml:"ElementName,omitempty"`                     // A user-friendly name for the object.
+		Query                           string   `xml:"Query,omitempty"`                           // The query that selects the indications matched by the filter.
+		QueryLanguage                   string   `xml:"QueryLanguage,omitempty"`                   // The language the query is expressed in.
+		IndividualSubscriptionSupported bool     `xml:"IndividualSubscriptionSupported,omitempty"` // Indicates whether the filter can be subscribed to on its own, rather than only as part of a filter collection.
+	}
+	ListenerDestinationResponse struct {
+		XMLName                 xml.Name        `xml:"CIM_ListenerDestinationWSManagement"`
+		CreationClassName       string          `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		Name                    string          `xml:"Name,omitempty"`                    // The name of the destination.
+		SystemCreationClassName string          `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string          `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		ElementName             string          `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		Destination             string          `xml:"Destination,omitempty"`             // The address the indications are delivered to.
+		PersistenceType         PersistenceType `xml:"PersistenceType,omitempty"`         // Indicates whether the destination outlives the subscriptions that reference it.
+		Protocol                Protocol        `xml:"Protocol,omitempty"`                // The protocol used to deliver the indications.
+		DeliveryMode            DeliveryMode    `xml:"DeliveryMode,omitempty"`            // The WS-Management delivery mode of the indications.
+	}
+	SubscriptionResponse struct {
+		XMLName              xml.Name                  `xml:"CIM_IndicationSubscription"`
+		Filter               EndpointReferenceResponse `xml:"Filter"`                         // The CIM_IndicationFilter that selects the indications.
+		Handler              EndpointReferenceResponse `xml:"Handler"`                        // The CIM_ListenerDestinationWSManagement the indications are delivered to.
+		OnFatalErrorPolicy   OnFatalErrorPolicy        `xml:"OnFatalErrorPolicy,omitempty"`   // What happens to the subscription when the indications cannot be delivered.
+		SubscriptionState    SubscriptionState         `xml:"SubscriptionState,omitempty"`    // Indicates whether the subscription is active.
+		SubscriptionDuration int                       `xml:"SubscriptionDuration,omitempty"` // The time, in seconds, the subscription is active for. 0 means the subscription does not expire.
+	}
+	FilterCollectionSubscriptionResponse struct {
+		XMLName              xml.Name                  `xml:"CIM_FilterCollectionSubscription"`
+		Filter               EndpointReferenceResponse `xml:"Filter"`                         // The CIM_FilterCollection that selects the indications.
+		Handler              EndpointReferenceResponse `xml:"Handler"`                        // The CIM_ListenerDestinationWSManagement the indications are delivered to.
+		OnFatalErrorPolicy   OnFatalErrorPolicy        `xml:"OnFatalErrorPolicy,omitempty"`   // What happens to the subscription when the indications cannot be delivered.
+		SubscriptionState    SubscriptionState         `xml:"SubscriptionState,omitempty"`    // Indicates whether the subscription is active.
+		SubscriptionDuration int                       `xml:"SubscriptionDuration,omitempty"` // The time, in seconds, the subscription is active for. 0 means the subscription does not expire.
+	}
+	PullResponse struct {
+		XMLName                           xml.Name                               `xml:"PullResponse"`
+		FilterItems                       []FilterResponse                       `xml:"Items>CIM_IndicationFilter"`
+		FilterCollectionSubscriptionItems []FilterCollectionSubscriptionResponse `xml:"Items>CIM_FilterCollectionSubscription"`
+		ListenerDestinationItems          []ListenerDestinationResponse          `xml:"Items>CIM_ListenerDestinationWSManagement"`
+		SubscriptionItems                 []SubscriptionResponse                 `xml:"Items>CIM_IndicationSubscription"`
+	}
+	CreateResponse struct {
+		XMLName             xml.Name                    `xml:"ResourceCreated"`
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	SubscribeResponse struct {
+		XMLName             xml.Name                  `xml:"SubscribeResponse"`
+		SubscriptionManager EndpointReferenceResponse `xml:"SubscriptionManager"` // The reference to unsubscribe with. Its Identifier is the argument of FilterCollectionSubscription.Unsubscribe.
+		Expires             string                    `xml:"Expires,omitempty"`   // The xs:duration or xs:dateTime the subscription expires at.
+	}
+	EndpointReferenceResponse struct {
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	ReferenceParametersResponse struct {
+		ResourceURI string              `xml:"ResourceURI,omitempty"`
+		SelectorSet SelectorSetResponse `xml:"SelectorSet,omitempty"`
+		Identifier  string              `xml:"Identifier,omitempty"`
+	}
+	SelectorSetResponse struct {
+		Selectors []SelectorResponse `xml:"Selector,omitempty"`
+	}
+	SelectorResponse struct {
+		Name              string                     `xml:"Name,attr"`
+		Text              string                     `xml:",chardata"`
+		EndpointReference *EndpointReferenceResponse `xml:"EndpointReference,omitempty"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	ListenerDestinationRequest struct {
+		XMLName         xml.Name        `xml:"h:CIM_ListenerDestinationWSManagement"`
+		H               string          `xml:"xmlns:h,attr"`
+		ElementName     string          `xml:"h:ElementName,omitempty"`     // A user-friendly name for the object.
+		Destination     string          `xml:"h:Destination"`               // The address the indications are delivered to, for example "http://192.168.0.10:16997/events" or, for PET, the SNMP trap receiver "192.168.0.10".
+		PersistenceType PersistenceType `xml:"h:PersistenceType,omitempty"` // Indicates whether the destination outlives the subscriptions that reference it.
+		Protocol        Protocol        `xml:"h:Protocol,omitempty"`        // The protocol used to deliver the indications.
+		DeliveryMode    DeliveryMode    `xml:"h:DeliveryMode,omitempty"`    // The WS-Management delivery mode of the indications.
+	}
+	SubscriptionRequest struct {
+		XMLName xml.Name          `xml:"h:CIM_IndicationSubscription"`
+		H       string            `xml:"xmlns:h,attr"`
+		Filter  EndpointReference `xml:"h:Filter"`  // The CIM_IndicationFilter that selects the indications.
+		Handler EndpointReference `xml:"h:Handler"` // The CIM_ListenerDestinationWSManagement the indications are delivered to.
+	}
+	// SubscribeRequest describes a WS-Eventing subscription to a CIM_FilterCollection.
+	SubscribeRequest struct {
+		FilterCollection string       // The InstanceID of the CIM_FilterCollection to subscribe to, for example AllEvents.
+		DeliveryMode     DeliveryMode // How the events are delivered. Only the push modes are supported by Intel® AMT.
+		NotifyTo         string       // The address of the collector the events are delivered to.
+		Expires          string       // The xs:duration the subscription lasts for, for example "PT1H". Empty means the subscription does not expire.
+	}
+	Subscribe_INPUT struct {
+		XMLName  xml.Name `xml:"e:Subscribe"`
+		E        string   `xml:"xmlns:e,attr"`
+		Delivery Delivery `xml:"e:Delivery"`
+		Expires  string   `xml:"e:Expires,omitempty"`
+	}
+	Delivery struct {
+		Mode     string   `xml:"Mode,attr"`
+		NotifyTo NotifyTo `xml:"e:NotifyTo"`
+	}
+	NotifyTo struct {
+		Address string `xml:"a:Address"`
+	}
+	Unsubscribe_INPUT struct {
+		XMLName xml.Name `xml:"e:Unsubscribe"`
+		E       string   `xml:"xmlns:e,attr"`
+	}
+	EndpointReference struct {
+		Address             string              `xml:"a:Address"`
+		ReferenceParameters ReferenceParameters `xml:"a:ReferenceParameters"`
+	}
+	ReferenceParameters struct {
+		ResourceURI string      `xml:"w:ResourceURI"`
+		SelectorSet SelectorSet `xml:"w:SelectorSet"`
+	}
+	SelectorSet struct {
+		Selectors []Selector `xml:"w:Selector"`
+	}
+	Selector struct {
+		Name string `xml:"Name,attr"`
+		Text string `xml:",chardata"`
+	}
+)
+
+// Property Types.
+type (
+	// The WS-Management delivery mode of the indications.
+	//
+	// ValueMap={2, 3, 4, 5}
+	//
+	// Values={Push, PushWithAck, Events, Pull}
+	DeliveryMode int
+	// Indicates whether a destination outlives the subscriptions that reference it.
+	//
+	// ValueMap={1, 2, 3}
+	//
+	// Values={Other, Permanent, Transient}
+	PersistenceType int
+	// The protocol used to deliver the indications.
+	//
+	// ValueMap={1, 2, 3, 4, 5}
+	//
+	// Values={Other, CIM-XML, SM CLP, WS-Management, WSDM}
+	Protocol int
+	// What happens to a subscription when the indications cannot be delivered.
+	//
+	// ValueMap={0, 1, 2, 3, 4}
+	//
+	// Values={Unknown, Other, Ignore, Disable, Remove}
+	OnFatalErrorPolicy int
+	// Indicates whether a subscription is active.
+	//
+	// ValueMap={0, 1, 2, 3, 4}
+	//
+	// Values={Unknown, Other, Enabled, Enabled Degraded, Disabled}
+	SubscriptionState int
+)
@@ -16,6 +16,7 @@ import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/ieee8021x"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/indication"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/mediaaccess"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/physical"
@@ -30,29 +31,33 @@ import (
 )
 
 type Messages struct {
-	wsmanMessageCreator       *message.WSManMessageCreator
-	BIOSElement               bios.Element
-	BootConfigSetting         boot.ConfigSetting
-	BootService               boot.Service
-	BootSourceSetting         boot.SourceSetting
-	Card                      card.Package
-	Chassis                   chassis.Package
-	Chip                      chip.Package
-	ComputerSystemPackage     computer.SystemPackage
-	ConcreteDependency        concrete.Dependency
-	CredentialContext         credential.Context
-	IEEE8021xSettings         ieee8021x.Settings
-	KVMRedirectionSAP         kvm.RedirectionSAP
-	MediaAccessDevice         mediaaccess.Device
-	PhysicalMemory            physical.Memory
-	PhysicalPackage           physical.Package
-	PowerManagementService    power.ManagementService
-	Processor                 processor.Package
-	ServiceAvailableToElement service.AvailableToElement
-	SoftwareIdentity          software.Identity
-	SystemPackaging           system.Package
-	WiFiEndpointSettings      wifi.EndpointSettings
-	WiFiPort                  wifi.Port
+	wsmanMessageCreator             *message.WSManMessageCreator
+	BIOSElement                     bios.Element
+	BootConfigSetting               boot.ConfigSetting
+	BootService                     boot.Service
+	BootSourceSetting               boot.SourceSetting
+	Card                            card.Package
+	Chassis                         chassis.Package
+	Chip                            chip.Package
+	ComputerSystemPackage           computer.SystemPackage
+	ConcreteDependency              concrete.Dependency
+	CredentialContext               credential.Context
+	FilterCollectionSubscription    indication.FilterCollectionSubscription
+	IEEE8021xSettings               ieee8021x.Settings
+	IndicationFilter                indication.Filter
+	IndicationSubscription          indication.Subscription
+	KVMRedirectionSAP               kvm.RedirectionSAP
+	ListenerDestinationWSManagement indication.ListenerDestination
+	MediaAccessDevice               mediaaccess.Device
+	PhysicalMemory                  physical.Memory
+	PhysicalPackage                 physical.Package
+	PowerManagementService          power.ManagementService
+	Processor                       processor.Package
+	ServiceAvailableToElement       service.AvailableToElement
+	SoftwareIdentity                software.Identity
+	SystemPackaging                 system.Package
+	WiFiEndpointSettings            wifi.EndpointSettings
+	WiFiPort                        wifi.Port
 }
 
 func NewMessages(client client.WSMan) Messages {
@@ -71,8 +76,12 @@ func NewMessages(client client.WSMan) Messages {
 	m.ComputerSystemPackage = computer.NewComputerSystemPackageWithClient(wsmanMessageCreator, client)
 	m.ConcreteDependency = concrete.NewDependencyWithClient(wsmanMessageCreator, client)
 	m.CredentialContext = credential.NewContextWithClient(wsmanMessageCreator, client)
+	m.FilterCollectionSubscription = indication.NewFilterCollectionSubscriptionWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xSettings = ieee8021x.NewIEEE8021xSettingsWithClient(wsmanMessageCreator, client)
+	m.IndicationFilter = indication.NewFilterWithClient(wsmanMessageCreator, client)
+	m.IndicationSubscription = indication.NewSubscriptionWithClient(wsmanMessageCreator, client)
 	m.KVMRedirectionSAP = kvm.NewKVMRedirectionSAPWithClient(wsmanMessageCreator, client)
+	m.ListenerDestinationWSManagement = indication.NewListenerDestinationWithClient(wsmanMessageCreator, client)
 	m.MediaAccessDevice = mediaaccess.NewMediaAccessDeviceWithClient(wsmanMessageCreator, client)
 	m.PhysicalMemory = physical.NewPhysicalMemoryWithClient(wsmanMessageCreator, client)
 	m.PhysicalPackage = physical.NewPhysicalPackageWithClient(wsmanMessageCreator, client)
@@ -86,3 +95,12 @@ func NewMessages(client client.WSMan) Messages {
 
 	return m
 }
+
+// NewMessagesWithValidation instantiates a new instance of cim Messages whose services validate request types
+// against the constraints of their class before sending them, and fail with common.ErrInvalidRequest for an invalid one.
+func NewMessagesWithValidation(client client.WSMan) Messages {
+	m := NewMessages(client)
+	m.wsmanMessageCreator.ValidateRequests = true
+
+	return m
+}
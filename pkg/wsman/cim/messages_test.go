@@ -17,6 +17,7 @@ import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/computer"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/indication"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/mediaaccess"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/physical"
@@ -78,14 +79,30 @@ func TestNewMessages(t *testing.T) {
 		t.Error("Context is not initialized")
 	}
 
+	if reflect.DeepEqual(m.FilterCollectionSubscription, indication.FilterCollectionSubscription{}) {
+		t.Error("FilterCollectionSubscription is not initialized")
+	}
+
 	if reflect.DeepEqual(m.IEEE8021xSettings, ieee8021x.IEEE8021xSettingsRequest{}) {
 		t.Error("IEEE8021xSettings is not initialized")
 	}
 
+	if reflect.DeepEqual(m.IndicationFilter, indication.Filter{}) {
+		t.Error("IndicationFilter is not initialized")
+	}
+
+	if reflect.DeepEqual(m.IndicationSubscription, indication.Subscription{}) {
+		t.Error("IndicationSubscription is not initialized")
+	}
+
 	if reflect.DeepEqual(m.KVMRedirectionSAP, kvm.RedirectionSAP{}) {
 		t.Error("KVMRedirectionSAP is not initialized")
 	}
 
+	if reflect.DeepEqual(m.ListenerDestinationWSManagement, indication.ListenerDestination{}) {
+		t.Error("ListenerDestinationWSManagement is not initialized")
+	}
+
 	if reflect.DeepEqual(m.MediaAccessDevice, mediaaccess.Device{}) {
 		t.Error("MediaAccessDevice is not initialized")
 	}
@@ -0,0 +1,52 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wifi
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+
+const (
+	maxSSIDLength         = 32
+	minPassPhraseLength   = 8
+	maxPassPhraseLength   = 63
+	maxKeyLength          = 256
+	maxKeyIndex           = 4
+	maxPriority           = 255
+	passPhraseRequirement = "is required for the WPAPSK, WPA2PSK and WPA3SAE authentication methods"
+	instanceIDPrefix      = "Intel(r) AMT:WiFi Endpoint Settings "
+)
+
+// Validate checks the request against the constraints of CIM_WiFiEndpointSettings.
+func (request WiFiEndpointSettingsRequest) Validate() error {
+	validation := common.NewValidation(CIMWiFiEndpointSettings)
+
+	validation.Check(request.InstanceID == "" || request.InstanceID == instanceIDPrefix+request.ElementName, "InstanceID", "is assigned by the firmware and must be empty or match ElementName")
+	common.ValueMap(validation, "AuthenticationMethod", request.AuthenticationMethod, authenticationMethodMap)
+	common.ValueMap(validation, "EncryptionMethod", request.EncryptionMethod, encryptionMethodMap)
+	common.ValueMap(validation, "BSSType", request.BSSType, bssTypeMap)
+	validation.Required("SSID", request.SSID != "")
+	validation.MaxLength("SSID", request.SSID, maxSSIDLength)
+	validation.Range("Priority", request.Priority, 0, maxPriority)
+	validation.Length("PSKPassPhrase", request.PSKPassPhrase, minPassPhraseLength, maxPassPhraseLength)
+	validation.ASCII("PSKPassPhrase", request.PSKPassPhrase)
+
+	for _, key := range request.Keys {
+		validation.MaxLength("Keys", key, maxKeyLength)
+	}
+
+	if len(request.Keys) > 0 {
+		validation.Range("KeyIndex", request.KeyIndex, 1, maxKeyIndex)
+	}
+
+	switch request.AuthenticationMethod {
+	case AuthenticationMethodWPAPSK, AuthenticationMethodWPA2PSK, AuthenticationMethodWPA3SAE:
+		validation.Check(request.PSKPassPhrase != "" || request.PSKValue != 0, "PSKPassPhrase", passPhraseRequirement)
+		validation.Check(request.EncryptionMethod == EncryptionMethod_TKIP || request.EncryptionMethod == EncryptionMethod_CCMP, "EncryptionMethod", "must be TKIP or CCMP for a pre-shared key authentication method")
+	case AuthenticationMethodWPAIEEE8021x, AuthenticationMethodWPA2IEEE8021x:
+		validation.Check(request.PSKPassPhrase == "", "PSKPassPhrase", "must not be set for an IEEE 802.1x authentication method")
+	}
+
+	return validation.Err()
+}
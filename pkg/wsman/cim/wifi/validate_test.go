@@ -0,0 +1,73 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wifi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWiFiEndpointSettingsRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *WiFiEndpointSettingsRequest)
+		expected string
+	}{
+		{"valid request", func(request *WiFiEndpointSettingsRequest) {}, ""},
+		{"IEEE 802.1x", func(request *WiFiEndpointSettingsRequest) {
+			request.AuthenticationMethod = AuthenticationMethodWPA2IEEE8021x
+			request.PSKPassPhrase = ""
+		}, ""},
+		{"InstanceID left to the firmware", func(request *WiFiEndpointSettingsRequest) { request.InstanceID = "" }, ""},
+		{"foreign InstanceID", func(request *WiFiEndpointSettingsRequest) {
+			request.InstanceID = "Intel(r) AMT:WiFi Endpoint Settings office"
+		}, "InstanceID is assigned by the firmware and must be empty or match ElementName"},
+		{"unknown AuthenticationMethod", func(request *WiFiEndpointSettingsRequest) { request.AuthenticationMethod = 8 }, "AuthenticationMethod has unsupported value 8"},
+		{"unknown EncryptionMethod", func(request *WiFiEndpointSettingsRequest) {
+			request.AuthenticationMethod = AuthenticationMethodOpenSystem
+			request.EncryptionMethod = 6
+		}, "EncryptionMethod has unsupported value 6"},
+		{"unknown BSSType", func(request *WiFiEndpointSettingsRequest) { request.BSSType = 1 }, "BSSType has unsupported value 1"},
+		{"missing SSID", func(request *WiFiEndpointSettingsRequest) { request.SSID = "" }, "SSID is required"},
+		{"SSID too long", func(request *WiFiEndpointSettingsRequest) { request.SSID = strings.Repeat("s", 33) }, "SSID must not exceed 32 characters"},
+		{"invalid Priority", func(request *WiFiEndpointSettingsRequest) { request.Priority = 256 }, "Priority must be between 0 and 255, got 256"},
+		{"PSKPassPhrase too short", func(request *WiFiEndpointSettingsRequest) { request.PSKPassPhrase = "short" }, "PSKPassPhrase must be between 8 and 63 characters"},
+		{"missing PSKPassPhrase", func(request *WiFiEndpointSettingsRequest) { request.PSKPassPhrase = "" }, "PSKPassPhrase is required for the WPAPSK, WPA2PSK and WPA3SAE authentication methods"},
+		{"pre-shared key with WEP", func(request *WiFiEndpointSettingsRequest) { request.EncryptionMethod = EncryptionMethod_WEP }, "EncryptionMethod must be TKIP or CCMP for a pre-shared key authentication method"},
+		{"IEEE 802.1x with PSKPassPhrase", func(request *WiFiEndpointSettingsRequest) {
+			request.AuthenticationMethod = AuthenticationMethodWPAIEEE8021x
+		}, "PSKPassPhrase must not be set for an IEEE 802.1x authentication method"},
+		{"Keys without KeyIndex", func(request *WiFiEndpointSettingsRequest) { request.Keys = []string{"key"} }, "KeyIndex must be between 1 and 4, got 0"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := WiFiEndpointSettingsRequest{
+				ElementName:          "home",
+				InstanceID:           "Intel(r) AMT:WiFi Endpoint Settings home",
+				AuthenticationMethod: AuthenticationMethodWPA2PSK,
+				EncryptionMethod:     EncryptionMethod_CCMP,
+				SSID:                 "HomeNetwork",
+				Priority:             1,
+				PSKPassPhrase:        "P@ssw0rd1234",
+				BSSType:              BSSTypeInfrastructure,
+			}
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: CIM_WiFiEndpointSettings."+test.expected)
+		})
+	}
+}
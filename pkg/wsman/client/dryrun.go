@@ -0,0 +1,122 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"strings"
+	"sync"
+)
+
+const (
+	actionDelete  = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete"
+	emptyEnvelope = `<?xml version="1.0" encoding="UTF-8"?><Envelope xmlns="http://www.w3.org/2003/05/soap-envelope"><Header></Header><Body></Body></Envelope>`
+)
+
+var (
+	// ErrDryRun is returned by a dry-run client for calls that need a response from a live device.
+	ErrDryRun = errors.New("dry run: call requires a response from the device")
+	// ErrNoClient is returned when a message is executed by a service that was created without a client.
+	ErrNoClient = errors.New("no wsman client to execute the message")
+)
+
+// DryRun is a WSMan client that records the outgoing messages instead of sending them to a device.
+//
+// Every message is recorded. A Delete has no output, so it is answered with an empty envelope.
+// Every other message fails with ErrDryRun after it is recorded: a Get, Enumerate or Pull reads the state of the device,
+// a Put or Create returns the resulting instance, and a method returns its ReturnValue and output parameters,
+// none of which can be known without the device.
+type DryRun struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+// NewDryRun instantiates a client that records the outgoing messages.
+func NewDryRun() *DryRun {
+	return &DryRun{}
+}
+
+// Messages returns the recorded messages in the order they were sent.
+func (d *DryRun) Messages() []string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	messages := make([]string, len(d.messages))
+	copy(messages, d.messages)
+
+	return messages
+}
+
+// Reset discards the recorded messages.
+func (d *DryRun) Reset() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.messages = nil
+}
+
+// Post records msg and returns an empty envelope for a Delete, or ErrDryRun for any message whose response the caller needs.
+func (d *DryRun) Post(msg string) ([]byte, error) {
+	d.record(msg)
+
+	if action(msg) != actionDelete {
+		return nil, ErrDryRun
+	}
+
+	return []byte(emptyEnvelope), nil
+}
+
+// Connect does nothing, as no connection is made in a dry run.
+func (d *DryRun) Connect() error {
+	return nil
+}
+
+// Send records data.
+func (d *DryRun) Send(data []byte) error {
+	d.record(string(data))
+
+	return nil
+}
+
+// Receive always returns ErrDryRun.
+func (d *DryRun) Receive() ([]byte, error) {
+	return nil, ErrDryRun
+}
+
+// CloseConnection does nothing, as no connection is made in a dry run.
+func (d *DryRun) CloseConnection() error {
+	return nil
+}
+
+// IsAuthenticated always returns false.
+func (d *DryRun) IsAuthenticated() bool {
+	return false
+}
+
+// GetServerCertificate always returns ErrDryRun.
+func (d *DryRun) GetServerCertificate() (*tls.Certificate, error) {
+	return nil, ErrDryRun
+}
+
+func (d *DryRun) record(msg string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.messages = append(d.messages, msg)
+}
+
+// action returns the WS-Addressing action of a message.
+func action(msg string) string {
+	_, after, found := strings.Cut(msg, "<a:Action>")
+	if !found {
+		return ""
+	}
+
+	value, _, _ := strings.Cut(after, "</a:Action>")
+
+	return value
+}
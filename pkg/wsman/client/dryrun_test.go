@@ -0,0 +1,81 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const (
+	dryRunGet    = `<Envelope><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Get</a:Action></Header><Body></Body></Envelope>`
+	dryRunPut    = `<Envelope><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Put</a:Action></Header><Body><h:AMT_Example/></Body></Envelope>`
+	dryRunInvoke = `<Envelope><Header><a:Action>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Example/Commit</a:Action></Header><Body><h:Commit_INPUT/></Body></Envelope>`
+	dryRunDelete = `<Envelope><Header><a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete</a:Action></Header><Body></Body></Envelope>`
+)
+
+func TestDryRun_Post(t *testing.T) {
+	dryRun := NewDryRun()
+
+	response, err := dryRun.Post(dryRunDelete)
+	if err != nil {
+		t.Fatalf("Expected no error for a Delete message, but got %v", err)
+	}
+
+	if string(response) != emptyEnvelope {
+		t.Errorf("Expected an empty envelope, but got %s", response)
+	}
+
+	for _, msg := range []string{dryRunGet, dryRunPut, dryRunInvoke} {
+		if response, err := dryRun.Post(msg); !errors.Is(err, ErrDryRun) || response != nil {
+			t.Errorf("Expected ErrDryRun and no response for %s, but got %s, %v", msg, response, err)
+		}
+	}
+
+	expected := []string{dryRunDelete, dryRunGet, dryRunPut, dryRunInvoke}
+	if messages := dryRun.Messages(); !reflect.DeepEqual(messages, expected) {
+		t.Errorf("Expected recorded messages %v, but got %v", expected, messages)
+	}
+
+	dryRun.Reset()
+
+	if messages := dryRun.Messages(); len(messages) != 0 {
+		t.Errorf("Expected no recorded messages after Reset, but got %v", messages)
+	}
+}
+
+func TestDryRun_TCP(t *testing.T) {
+	dryRun := NewDryRun()
+
+	if err := dryRun.Connect(); err != nil {
+		t.Errorf("Expected Connect to succeed, but got %v", err)
+	}
+
+	if err := dryRun.Send([]byte("data")); err != nil {
+		t.Errorf("Expected Send to succeed, but got %v", err)
+	}
+
+	if _, err := dryRun.Receive(); !errors.Is(err, ErrDryRun) {
+		t.Errorf("Expected ErrDryRun from Receive, but got %v", err)
+	}
+
+	if _, err := dryRun.GetServerCertificate(); !errors.Is(err, ErrDryRun) {
+		t.Errorf("Expected ErrDryRun from GetServerCertificate, but got %v", err)
+	}
+
+	if dryRun.IsAuthenticated() {
+		t.Error("Expected a dry-run client not to be authenticated")
+	}
+
+	if err := dryRun.CloseConnection(); err != nil {
+		t.Errorf("Expected CloseConnection to succeed, but got %v", err)
+	}
+
+	if messages := dryRun.Messages(); !reflect.DeepEqual(messages, []string{"data"}) {
+		t.Errorf("Expected the sent data to be recorded, but got %v", messages)
+	}
+}
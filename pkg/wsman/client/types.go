@@ -19,4 +19,5 @@ type Parameters struct {
 	PinnedCert                string
 	TlsConfig                 *tls.Config
 	AllowInsecureCipherSuites bool
+	ValidateRequests          bool // When true, the services of wsman.NewMessages validate request types against the constraints of their class before sending them.
 }
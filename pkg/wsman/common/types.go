@@ -9,6 +9,7 @@ import (
 	"encoding/xml"
 
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
 
 type EnumerationResponse struct {
@@ -30,3 +31,45 @@ type ReturnValue struct {
 	ReturnValue    int      `xml:"ReturnValue,omitempty"`
 	ReturnValueStr string   `xml:"ReturnValueStr,omitempty"`
 }
+
+// FragmentResponse is the response to a fragment-level Get or Put.
+type FragmentResponse struct {
+	*client.Message
+	XMLName xml.Name       `xml:"Envelope"`
+	Header  message.Header `xml:"Header"`
+	Body    FragmentBody   `xml:"Body"`
+}
+
+type FragmentBody struct {
+	XMLName     xml.Name    `xml:"Body"`
+	XMLFragment XMLFragment `xml:"XmlFragment"`
+}
+
+// XMLFragment holds the properties returned for the requested fragment. An array property is returned as one element per value.
+type XMLFragment struct {
+	Properties []FragmentProperty `xml:",any"`
+}
+
+type FragmentProperty struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// Value returns the value of the first property in the fragment, or an empty string when the fragment is empty.
+func (f XMLFragment) Value() string {
+	if len(f.Properties) == 0 {
+		return ""
+	}
+
+	return f.Properties[0].Value
+}
+
+// Values returns the values of all the properties in the fragment, in document order.
+func (f XMLFragment) Values() []string {
+	values := make([]string, 0, len(f.Properties))
+	for _, property := range f.Properties {
+		values = append(values, property.Value)
+	}
+
+	return values
+}
@@ -0,0 +1,111 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package common
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidRequest is wrapped by every error returned from the Validate method of a request type.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// Validator is implemented by request types that can be checked against the constraints of their class before they are sent to AMT.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError describes a request property that violates a constraint of its class.
+type ValidationError struct {
+	Class    string
+	Property string
+	Reason   string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s.%s %s", ErrInvalidRequest, e.Class, e.Property, e.Reason)
+}
+
+// Unwrap allows errors.Is(err, ErrInvalidRequest).
+func (e ValidationError) Unwrap() error {
+	return ErrInvalidRequest
+}
+
+// enumeration is the set of underlying types of the enumerations in the class packages.
+type enumeration interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Validation collects the constraint violations of a request of the given class.
+type Validation struct {
+	class  string
+	errors []error
+}
+
+// NewValidation starts the validation of a request of the class className.
+func NewValidation(className string) *Validation {
+	return &Validation{class: className}
+}
+
+// Fail records a violation of property described by reason.
+func (v *Validation) Fail(property, reason string) {
+	v.errors = append(v.errors, ValidationError{Class: v.class, Property: property, Reason: reason})
+}
+
+// Check records a violation of property described by reason when ok is false.
+func (v *Validation) Check(ok bool, property, reason string) {
+	if !ok {
+		v.Fail(property, reason)
+	}
+}
+
+// Required records a violation when a required property is not set.
+func (v *Validation) Required(property string, set bool) {
+	v.Check(set, property, "is required")
+}
+
+// Range records a violation when value is outside of [minimum, maximum].
+func (v *Validation) Range(property string, value, minimum, maximum int) {
+	v.Check(value >= minimum && value <= maximum, property, fmt.Sprintf("must be between %d and %d, got %d", minimum, maximum, value))
+}
+
+// MaxLength records a violation when value is longer than maxLength characters.
+func (v *Validation) MaxLength(property, value string, maxLength int) {
+	v.Check(len([]rune(value)) <= maxLength, property, fmt.Sprintf("must not exceed %d characters", maxLength))
+}
+
+// Length records a violation when a non-empty value is not between minLength and maxLength characters.
+func (v *Validation) Length(property, value string, minLength, maxLength int) {
+	length := len([]rune(value))
+	if value == "" || (length >= minLength && length <= maxLength) {
+		return
+	}
+
+	v.Fail(property, fmt.Sprintf("must be between %d and %d characters", minLength, maxLength))
+}
+
+// ASCII records a violation when value contains characters outside of the printable ASCII range.
+func (v *Validation) ASCII(property, value string) {
+	for _, character := range value {
+		if character < 0x20 || character > 0x7E {
+			v.Fail(property, "must contain only printable ASCII characters")
+
+			return
+		}
+	}
+}
+
+// Err returns the recorded violations joined into a single error, or nil when the request is valid.
+func (v *Validation) Err() error {
+	return errors.Join(v.errors...)
+}
+
+// ValueMap records a violation when value is not one of the keys of valueMap, typically the map backing the String method of an enumeration.
+func ValueMap[T enumeration](v *Validation, property string, value T, valueMap map[T]string) {
+	if _, ok := valueMap[value]; !ok {
+		v.Fail(property, fmt.Sprintf("has unsupported value %d", value))
+	}
+}
@@ -0,0 +1,70 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testEnumeration int
+
+var testEnumerationToString = map[testEnumeration]string{
+	1: "One",
+	2: "Two",
+}
+
+func TestValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(validation *Validation)
+		expected string
+	}{
+		{"valid request", func(v *Validation) {
+			v.Required("Name", true)
+			v.Range("Port", 16993, 1, 65535)
+			v.MaxLength("Password", "P@ssw0rd", 32)
+			v.Length("PassPhrase", "", 8, 63)
+			v.ASCII("Password", "P@ssw0rd")
+			ValueMap(v, "Mode", testEnumeration(2), testEnumerationToString)
+		}, ""},
+		{"required", func(v *Validation) { v.Required("Name", false) }, "invalid request: AMT_Test.Name is required"},
+		{"range", func(v *Validation) { v.Range("Port", 0, 1, 65535) }, "invalid request: AMT_Test.Port must be between 1 and 65535, got 0"},
+		{"max length", func(v *Validation) { v.MaxLength("Name", "abcde", 4) }, "invalid request: AMT_Test.Name must not exceed 4 characters"},
+		{"length", func(v *Validation) { v.Length("PassPhrase", "short", 8, 63) }, "invalid request: AMT_Test.PassPhrase must be between 8 and 63 characters"},
+		{"ascii", func(v *Validation) { v.ASCII("Password", "pässword") }, "invalid request: AMT_Test.Password must contain only printable ASCII characters"},
+		{"value map", func(v *Validation) { ValueMap(v, "Mode", testEnumeration(3), testEnumerationToString) }, "invalid request: AMT_Test.Mode has unsupported value 3"},
+		{"multiple violations", func(v *Validation) {
+			v.Required("Name", false)
+			v.Check(false, "Mode", "applies only when Enabled is set")
+		}, "invalid request: AMT_Test.Name is required\ninvalid request: AMT_Test.Mode applies only when Enabled is set"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			validation := NewValidation("AMT_Test")
+			test.validate(validation)
+
+			err := validation.Err()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, test.expected)
+			assert.True(t, errors.Is(err, ErrInvalidRequest))
+
+			var validationError ValidationError
+
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, "AMT_Test", validationError.Class)
+		})
+	}
+}
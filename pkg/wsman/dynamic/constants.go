@@ -0,0 +1,18 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package dynamic
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+
+// Resource URI bases for the schemas supported by Intel® AMT.
+const (
+	AMTResourceURIBase = message.AMTSchema
+	CIMResourceURIBase = message.CIMSchema
+	IPSResourceURIBase = message.IPSSchema
+)
+
+// outputSuffix terminates the name of the element returned by a method invocation.
+const outputSuffix = "_OUTPUT"
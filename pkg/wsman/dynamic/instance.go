@@ -0,0 +1,365 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package dynamic
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+)
+
+// NewInstance returns an empty instance of the given class.
+func NewInstance(className string) Instance {
+	return Instance{ClassName: className}
+}
+
+// Property returns the named property and whether it exists.
+func (i *Instance) Property(name string) (Property, bool) {
+	if index := i.indexOf(name); index >= 0 {
+		return i.Properties[index], true
+	}
+
+	return Property{}, false
+}
+
+// Value returns the text of the first value of the named property, or an empty string if it does not exist.
+func (i *Instance) Value(name string) string {
+	property, ok := i.Property(name)
+	if !ok || len(property.Values) == 0 {
+		return ""
+	}
+
+	return property.Values[0].Text
+}
+
+// Values returns the text of every value of the named property.
+func (i *Instance) Values(name string) []string {
+	property, ok := i.Property(name)
+	if !ok {
+		return nil
+	}
+
+	values := make([]string, 0, len(property.Values))
+	for _, value := range property.Values {
+		values = append(values, value.Text)
+	}
+
+	return values
+}
+
+// EPR returns the first endpoint reference held by the named property, or nil if there is none.
+func (i *Instance) EPR(name string) *EndpointReference {
+	property, ok := i.Property(name)
+	if !ok || len(property.Values) == 0 {
+		return nil
+	}
+
+	return property.Values[0].EPR
+}
+
+// Set replaces the values of the named property, appending the property if it does not exist yet. Passing more than one value creates an array property.
+func (i *Instance) Set(name string, values ...string) {
+	property := Property{Name: name, Values: make([]Value, 0, len(values))}
+	for _, value := range values {
+		property.Values = append(property.Values, Value{Text: value})
+	}
+
+	i.setProperty(property)
+}
+
+// SetEPR replaces the values of the named property with one or more endpoint references.
+func (i *Instance) SetEPR(name string, references ...EndpointReference) {
+	property := Property{Name: name, Values: make([]Value, 0, len(references))}
+
+	for index := range references {
+		reference := references[index]
+		property.Values = append(property.Values, Value{EPR: &reference})
+	}
+
+	i.setProperty(property)
+}
+
+// SetNil sets the named property to xsi:nil.
+func (i *Instance) SetNil(name string) {
+	i.setProperty(Property{Name: name, Values: []Value{{Nil: true}}})
+}
+
+// Remove deletes the named property, so that it is not sent on Put or Create.
+func (i *Instance) Remove(name string) {
+	if index := i.indexOf(name); index >= 0 {
+		i.Properties = append(i.Properties[:index], i.Properties[index+1:]...)
+	}
+}
+
+func (i *Instance) setProperty(property Property) {
+	if index := i.indexOf(property.Name); index >= 0 {
+		i.Properties[index] = property
+
+		return
+	}
+
+	i.Properties = append(i.Properties, property)
+}
+
+func (i *Instance) indexOf(name string) int {
+	for index := range i.Properties {
+		if i.Properties[index].Name == name {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// rawElement captures an arbitrary element so property values can be classified after decoding.
+type rawElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr   `xml:",any,attr"`
+	Text     string       `xml:",chardata"`
+	Children []rawElement `xml:",any"`
+}
+
+func (r *rawElement) child(local string) *rawElement {
+	for index := range r.Children {
+		if r.Children[index].XMLName.Local == local {
+			return &r.Children[index]
+		}
+	}
+
+	return nil
+}
+
+func (r *rawElement) isNil() bool {
+	for _, attr := range r.Attrs {
+		if attr.Name.Local == "nil" && attr.Value == "true" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *rawElement) endpointReference() EndpointReference {
+	reference := EndpointReference{}
+
+	if address := r.child("Address"); address != nil {
+		reference.Address = strings.TrimSpace(address.Text)
+	}
+
+	parameters := r.child("ReferenceParameters")
+	if parameters == nil {
+		return reference
+	}
+
+	if resourceURI := parameters.child("ResourceURI"); resourceURI != nil {
+		reference.ResourceURI = strings.TrimSpace(resourceURI.Text)
+	}
+
+	if selectorSet := parameters.child("SelectorSet"); selectorSet != nil {
+		for _, selector := range selectorSet.Children {
+			name := ""
+
+			for _, attr := range selector.Attrs {
+				if attr.Name.Local == "Name" {
+					name = attr.Value
+				}
+			}
+
+			reference.Selectors = append(reference.Selectors, Selector{Name: name, Value: strings.TrimSpace(selector.Text)})
+		}
+	}
+
+	return reference
+}
+
+func (r *rawElement) value() Value {
+	if r.isNil() {
+		return Value{Nil: true}
+	}
+
+	if r.child("ReferenceParameters") != nil || r.child("Address") != nil {
+		reference := r.endpointReference()
+
+		return Value{EPR: &reference}
+	}
+
+	return Value{Text: r.Text}
+}
+
+// UnmarshalXML decodes any class instance, grouping repeated elements into array properties.
+func (i *Instance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	raw := rawElement{}
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	i.ClassName = start.Name.Local
+	i.Namespace = start.Name.Space
+	i.Properties = nil
+
+	for index := range raw.Children {
+		child := &raw.Children[index]
+		name := child.XMLName.Local
+
+		if existing := i.indexOf(name); existing >= 0 {
+			i.Properties[existing].Values = append(i.Properties[existing].Values, child.value())
+
+			continue
+		}
+
+		i.Properties = append(i.Properties, Property{Name: name, Values: []Value{child.value()}})
+	}
+
+	return nil
+}
+
+// UnmarshalXML decodes a ResourceCreated or other endpoint reference element.
+func (e *EndpointReference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	raw := rawElement{}
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	*e = raw.endpointReference()
+
+	return nil
+}
+
+// UnmarshalXML decodes the Items of a PullResponse regardless of their class.
+func (p *PullResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	p.XMLName = start.Name
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			if err := p.decodeChild(d, element); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func (p *PullResponse) decodeChild(d *xml.Decoder, element xml.StartElement) error {
+	switch element.Name.Local {
+	case "EnumerationContext":
+		return d.DecodeElement(&p.EnumerationContext, &element)
+	case "EndOfSequence":
+		p.EndOfSequence = true
+
+		return d.Skip()
+	case "Items":
+		items := struct {
+			Instances []Instance `xml:",any"`
+		}{}
+		if err := d.DecodeElement(&items, &element); err != nil {
+			return err
+		}
+
+		p.Items = append(p.Items, items.Instances...)
+
+		return nil
+	default:
+		return d.Skip()
+	}
+}
+
+// UnmarshalXML places the single child of the SOAP body into the matching field.
+func (b *Body) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	b.XMLName = start.Name
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			if err := b.decodeChild(d, element); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func (b *Body) decodeChild(d *xml.Decoder, element xml.StartElement) error {
+	switch {
+	case element.Name.Local == "EnumerateResponse":
+		return d.DecodeElement(&b.EnumerateResponse, &element)
+	case element.Name.Local == "PullResponse":
+		return d.DecodeElement(&b.PullResponse, &element)
+	case element.Name.Local == "ResourceCreated":
+		return d.DecodeElement(&b.CreateResponse, &element)
+	case strings.HasSuffix(element.Name.Local, outputSuffix):
+		return d.DecodeElement(&b.MethodResponse, &element)
+	default:
+		return d.DecodeElement(&b.GetResponse, &element)
+	}
+}
+
+// encode serializes the instance properties inside an element named elementName in the given namespace, as used in Put, Create and Invoke bodies.
+func (i *Instance) encode(elementName, namespace string) string {
+	var str strings.Builder
+
+	str.WriteString(fmt.Sprintf(`<h:%s xmlns:h="%s">`, elementName, message.EscapeXML(namespace)))
+
+	for _, property := range i.Properties {
+		for _, value := range property.Values {
+			str.WriteString(value.encode(property.Name))
+		}
+	}
+
+	str.WriteString(fmt.Sprintf(`</h:%s>`, elementName))
+
+	return str.String()
+}
+
+func (v Value) encode(name string) string {
+	switch {
+	case v.Nil:
+		return fmt.Sprintf(`<h:%s xsi:nil="true"></h:%s>`, name, name)
+	case v.EPR != nil:
+		return fmt.Sprintf(`<h:%s>%s</h:%s>`, name, v.EPR.encode(), name)
+	default:
+		return fmt.Sprintf(`<h:%s>%s</h:%s>`, name, message.EscapeXML(v.Text), name)
+	}
+}
+
+func (e *EndpointReference) encode() string {
+	var str strings.Builder
+
+	address := e.Address
+	if address == "" {
+		address = "/wsman"
+	}
+
+	str.WriteString(fmt.Sprintf(`<a:Address>%s</a:Address><a:ReferenceParameters><w:ResourceURI>%s</w:ResourceURI>`, message.EscapeXML(address), message.EscapeXML(e.ResourceURI)))
+
+	if len(e.Selectors) > 0 {
+		str.WriteString("<w:SelectorSet>")
+
+		for _, selector := range e.Selectors {
+			str.WriteString(fmt.Sprintf(`<w:Selector Name="%s">%s</w:Selector>`, message.EscapeXML(selector.Name), message.EscapeXML(selector.Value)))
+		}
+
+		str.WriteString("</w:SelectorSet>")
+	}
+
+	str.WriteString("</a:ReferenceParameters>")
+
+	return str.String()
+}
@@ -0,0 +1,91 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package dynamic
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceProperties(t *testing.T) {
+	instance := NewInstance("AMT_Test")
+	instance.Set("ElementName", "first")
+	instance.Set("Array", "1", "2", "3")
+	instance.SetNil("Empty")
+	instance.Set("ElementName", "second")
+
+	assert.Equal(t, "second", instance.Value("ElementName"))
+	assert.Equal(t, []string{"1", "2", "3"}, instance.Values("Array"))
+	assert.Equal(t, "ElementName", instance.Properties[0].Name)
+	assert.Equal(t, "", instance.Value("Missing"))
+	assert.Nil(t, instance.Values("Missing"))
+	assert.Nil(t, instance.EPR("ElementName"))
+
+	property, ok := instance.Property("Empty")
+	assert.True(t, ok)
+	assert.True(t, property.Values[0].Nil)
+
+	instance.Remove("Array")
+	_, ok = instance.Property("Array")
+	assert.False(t, ok)
+	assert.Len(t, instance.Properties, 2)
+}
+
+func TestInstanceRoundTrip(t *testing.T) {
+	instance := NewInstance("AMT_Test")
+	instance.Set("Name", "a&b")
+	instance.Set("Array", "1", "2")
+	instance.SetNil("Empty")
+	instance.SetEPR("Reference", EndpointReference{
+		ResourceURI: AMTResourceURIBase + "AMT_Other",
+		Selectors:   []Selector{{Name: "InstanceID", Value: "id"}},
+	})
+
+	encoded := instance.encode("AMT_Test", AMTResourceURIBase+"AMT_Test")
+	assert.Equal(t, `<h:AMT_Test xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Test">`+
+		`<h:Name>a&amp;b</h:Name><h:Array>1</h:Array><h:Array>2</h:Array><h:Empty xsi:nil="true"></h:Empty>`+
+		`<h:Reference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Other</w:ResourceURI>`+
+		`<w:SelectorSet><w:Selector Name="InstanceID">id</w:Selector></w:SelectorSet></a:ReferenceParameters></h:Reference></h:AMT_Test>`, encoded)
+
+	// declare the prefixes the encoder relies on being declared by the envelope
+	document := `<root xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:a="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:w="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">` + encoded + `</root>`
+	decoded := struct {
+		Instance Instance `xml:"AMT_Test"`
+	}{}
+
+	err := xml.Unmarshal([]byte(document), &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, "AMT_Test", decoded.Instance.ClassName)
+	assert.Equal(t, "a&b", decoded.Instance.Value("Name"))
+	assert.Equal(t, []string{"1", "2"}, decoded.Instance.Values("Array"))
+
+	property, _ := decoded.Instance.Property("Empty")
+	assert.True(t, property.Values[0].Nil)
+
+	reference := decoded.Instance.EPR("Reference")
+	assert.NotNil(t, reference)
+	assert.Equal(t, "/wsman", reference.Address)
+	assert.Equal(t, AMTResourceURIBase+"AMT_Other", reference.ResourceURI)
+	assert.Equal(t, []Selector{{Name: "InstanceID", Value: "id"}}, reference.Selectors)
+}
+
+func TestEndpointReferenceEscaping(t *testing.T) {
+	reference := EndpointReference{
+		ResourceURI: AMTResourceURIBase + "AMT_Other",
+		Selectors:   []Selector{{Name: `Name"<&`, Value: `"a"<b>&c`}},
+	}
+
+	encoded := reference.encode()
+	assert.Contains(t, encoded, `<w:Selector Name="Name&#34;&lt;&amp;">&#34;a&#34;&lt;b&gt;&amp;c</w:Selector>`)
+
+	document := `<root xmlns:a="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:w="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">` + encoded + `</root>`
+	decoded := EndpointReference{}
+
+	assert.NoError(t, xml.Unmarshal([]byte(document), &decoded))
+	assert.Equal(t, reference.Selectors, decoded.Selectors)
+}
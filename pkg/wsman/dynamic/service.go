@@ -0,0 +1,149 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package dynamic facilitates communication with Intel® AMT devices for classes that do not have a dedicated package.
+//
+// A Service is bound to a single class by its resource URI base (see AMTResourceURIBase, CIMResourceURIBase and IPSResourceURIBase)
+// and class name. Instances are returned as an Instance, an ordered list of properties that can be modified and sent back with Put.
+package dynamic
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+// NewServiceWithClient instantiates a new dynamic service for the class className under resourceURIBase.
+func NewServiceWithClient(resourceURIBase, className string, client client.WSMan) Service {
+	return Service{
+		base:      message.NewBaseWithClient(message.NewWSManMessageCreator(resourceURIBase), className, client),
+		className: className,
+	}
+}
+
+// ResourceURI returns the full resource URI of the class.
+func (service Service) ResourceURI() string {
+	return service.base.WSManMessageCreator.ResourceURIBase + service.className
+}
+
+// Get retrieves the representation of the instance identified by selectors. Singleton classes need no selectors.
+func (service Service) Get(selectors ...Selector) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(message.BaseActionsGet, service.className, toMessageSelectors(selectors), "", "")
+
+	return service.send(service.base.WSManMessageCreator.CreateXML(header, message.GetBody))
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service Service) Enumerate() (response Response, err error) {
+	return service.send(service.base.Enumerate())
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service Service) Pull(enumerationContext string) (response Response, err error) {
+	return service.send(service.base.Pull(enumerationContext))
+}
+
+// Put will change properties of the instance identified by selectors. The full instance, typically obtained with Get and then modified, must be supplied.
+func (service Service) Put(instance Instance, selectors ...Selector) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(message.BaseActionsPut, service.className, toMessageSelectors(selectors), "", "")
+	body := "<Body>" + instance.encode(service.className, service.ResourceURI()) + "</Body>"
+
+	return service.send(service.base.WSManMessageCreator.CreateXML(header, body))
+}
+
+// Create creates a new instance of this class. The endpoint reference of the new instance is returned in Body.CreateResponse.
+func (service Service) Create(instance Instance) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(message.BaseActionsCreate, service.className, nil, "", "")
+	body := "<Body>" + instance.encode(service.className, service.ResourceURI()) + "</Body>"
+
+	return service.send(service.base.WSManMessageCreator.CreateXML(header, body))
+}
+
+// Delete removes the instance identified by selectors.
+func (service Service) Delete(selectors ...Selector) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(message.BaseActionsDelete, service.className, toMessageSelectors(selectors), "", "")
+
+	return service.send(service.base.WSManMessageCreator.CreateXML(header, message.DeleteBody))
+}
+
+// Invoke calls the extrinsic method methodName with the properties of input as its parameters.
+// The method output, including ReturnValue, is returned in Body.MethodResponse.
+func (service Service) Invoke(methodName string, input Instance, selectors ...Selector) (response Response, err error) {
+	action := fmt.Sprintf("%s/%s", service.ResourceURI(), methodName)
+	header := service.base.WSManMessageCreator.CreateHeader(action, service.className, toMessageSelectors(selectors), "", "")
+	body := "<Body>" + input.encode(methodName+"_INPUT", service.ResourceURI()) + "</Body>"
+
+	return service.send(service.base.WSManMessageCreator.CreateXML(header, body))
+}
+
+// GetFragment retrieves only the fragment of the instance identified by fragment, typically the name of a single property.
+func (service Service) GetFragment(fragment string, selectors ...Selector) (response common.FragmentResponse, err error) {
+	return service.sendFragment(service.base.GetFragment(fragment, toMessageSelectors(selectors)))
+}
+
+// PutFragment changes only the fragment of the instance identified by fragment. Values are sent as one element per value, so array properties take several.
+func (service Service) PutFragment(fragment string, values []string, selectors ...Selector) (response common.FragmentResponse, err error) {
+	return service.sendFragment(service.base.PutFragment(fragment, values, toMessageSelectors(selectors)))
+}
+
+func (service Service) sendFragment(xmlInput string) (response common.FragmentResponse, err error) {
+	response = common.FragmentResponse{
+		Message: &client.Message{
+			XMLInput: xmlInput,
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
+
+func (service Service) send(xmlInput string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: xmlInput,
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
+
+func toMessageSelectors(selectors []Selector) []message.Selector {
+	if len(selectors) == 0 {
+		return nil
+	}
+
+	messageSelectors := make([]message.Selector, 0, len(selectors))
+	for _, selector := range selectors {
+		messageSelectors = append(messageSelectors, message.Selector{Name: selector.Name, Value: selector.Value})
+	}
+
+	return messageSelectors
+}
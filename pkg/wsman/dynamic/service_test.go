@@ -0,0 +1,196 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package dynamic
+
+import (
+	"crypto/tls"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockClient returns the contents of a fixture from the shared wsmantesting responses directory.
+type mockClient struct {
+	fixture string
+	request string
+}
+
+var errTest = errors.New("test error")
+
+func (c *mockClient) Post(msg string) ([]byte, error) {
+	c.request = msg
+
+	if c.fixture == "" {
+		return nil, errTest
+	}
+
+	return os.ReadFile("../wsmantesting/responses/" + c.fixture)
+}
+func (c *mockClient) Send(data []byte) error                          { return nil }
+func (c *mockClient) Receive() ([]byte, error)                        { return nil, nil }
+func (c *mockClient) CloseConnection() error                          { return nil }
+func (c *mockClient) Connect() error                                  { return nil }
+func (c *mockClient) IsAuthenticated() bool                           { return true }
+func (c *mockClient) GetServerCertificate() (*tls.Certificate, error) { return nil, nil }
+
+func TestPositiveDynamicGet(t *testing.T) {
+	client := &mockClient{fixture: "amt/general/get.xml"}
+	service := NewServiceWithClient(AMTResourceURIBase, "AMT_GeneralSettings", client)
+
+	response, err := service.Get()
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, "<a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Get</a:Action>")
+	assert.Contains(t, client.request, "<w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_GeneralSettings</w:ResourceURI>")
+
+	instance := response.Body.GetResponse
+	assert.Equal(t, "AMT_GeneralSettings", instance.ClassName)
+	assert.Equal(t, "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_GeneralSettings", instance.Namespace)
+	assert.Equal(t, "Digest:F3EB554784E729164447A89F60B641C5", instance.Value("DigestRealm"))
+	assert.Equal(t, "AMTNetworkEnabled", instance.Properties[0].Name)
+	assert.Equal(t, "WsmanOnlyMode", instance.Properties[len(instance.Properties)-1].Name)
+}
+
+func TestPositiveDynamicFragments(t *testing.T) {
+	client := &mockClient{fixture: "amt/boot/settingdata/getfragment.xml"}
+	service := NewServiceWithClient(AMTResourceURIBase, "AMT_BootSettingData", client)
+
+	response, err := service.GetFragment("BootMediaIndex", Selector{Name: "InstanceID", Value: "Intel(r) AMT:BootSettingData 0"})
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, `<w:Selector Name="InstanceID">Intel(r) AMT:BootSettingData 0</w:Selector></w:SelectorSet><w:FragmentTransfer xmlns:s="http://www.w3.org/2003/05/soap-envelope" s:mustUnderstand="true">BootMediaIndex</w:FragmentTransfer>`)
+	assert.Equal(t, "1", response.Body.XMLFragment.Value())
+
+	client.fixture = "amt/boot/settingdata/putfragment.xml"
+	response, err = service.PutFragment("BootMediaIndex", []string{"1"})
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, "<a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Put</a:Action>")
+	assert.Contains(t, client.request, `<Body><w:XmlFragment><h:BootMediaIndex xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_BootSettingData">1</h:BootMediaIndex></w:XmlFragment></Body>`)
+	assert.Equal(t, []string{"1"}, response.Body.XMLFragment.Values())
+}
+
+func TestPositiveDynamicGetWithSelector(t *testing.T) {
+	client := &mockClient{fixture: "amt/general/get.xml"}
+	service := NewServiceWithClient(CIMResourceURIBase, "CIM_Chip", client)
+
+	_, err := service.Get(Selector{Name: "CreationClassName", Value: "CIM_Chip"}, Selector{Name: "Tag", Value: "CPU 0"})
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, `<w:SelectorSet><w:Selector Name="CreationClassName">CIM_Chip</w:Selector><w:Selector Name="Tag">CPU 0</w:Selector></w:SelectorSet>`)
+}
+
+func TestPositiveDynamicEnumeratePull(t *testing.T) {
+	client := &mockClient{fixture: "cim/concrete/dependency/enumerate.xml"}
+	service := NewServiceWithClient(CIMResourceURIBase, "CIM_ConcreteDependency", client)
+
+	response, err := service.Enumerate()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.Body.EnumerateResponse.EnumerationContext)
+	assert.Contains(t, client.request, "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Enumerate")
+
+	client.fixture = "cim/concrete/dependency/pull.xml"
+	response, err = service.Pull(response.Body.EnumerateResponse.EnumerationContext)
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, "http://schemas.xmlsoap.org/ws/2004/09/enumeration/Pull")
+	assert.True(t, response.Body.PullResponse.EndOfSequence)
+	assert.NotEmpty(t, response.Body.PullResponse.Items)
+
+	item := response.Body.PullResponse.Items[0]
+	assert.Equal(t, "CIM_ConcreteDependency", item.ClassName)
+
+	antecedent := item.EPR("Antecedent")
+	assert.NotNil(t, antecedent)
+	assert.Equal(t, "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_Identity", antecedent.ResourceURI)
+	assert.Equal(t, []Selector{{Name: "InstanceID", Value: "Intel(r) AMT:$$OsAdmin"}}, antecedent.Selectors)
+
+	dependent := item.EPR("Dependent")
+	assert.NotNil(t, dependent)
+	assert.Len(t, dependent.Selectors, 2)
+}
+
+func TestPositiveDynamicPut(t *testing.T) {
+	client := &mockClient{fixture: "amt/general/get.xml"}
+	service := NewServiceWithClient(AMTResourceURIBase, "AMT_GeneralSettings", client)
+
+	response, err := service.Get()
+	assert.NoError(t, err)
+
+	instance := response.Body.GetResponse
+	instance.Set("HostName", "new<host>")
+
+	_, err = service.Put(instance)
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, "<a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Put</a:Action>")
+	assert.Contains(t, client.request, `<Body><h:AMT_GeneralSettings xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_GeneralSettings"><h:AMTNetworkEnabled>1</h:AMTNetworkEnabled>`)
+	assert.Contains(t, client.request, "<h:HostName>new&lt;host&gt;</h:HostName>")
+	assert.Contains(t, client.request, "<h:WsmanOnlyMode>false</h:WsmanOnlyMode></h:AMT_GeneralSettings></Body>")
+}
+
+func TestPositiveDynamicCreate(t *testing.T) {
+	client := &mockClient{fixture: "amt/tls/credentialcontext/create.xml"}
+	service := NewServiceWithClient(AMTResourceURIBase, "AMT_TLSCredentialContext", client)
+
+	instance := NewInstance("AMT_TLSCredentialContext")
+	instance.SetEPR("ElementInContext", EndpointReference{
+		ResourceURI: AMTResourceURIBase + "AMT_PublicKeyCertificate",
+		Selectors:   []Selector{{Name: "InstanceID", Value: "testCertificate"}},
+	})
+	instance.SetEPR("ElementProvidingContext", EndpointReference{
+		ResourceURI: AMTResourceURIBase + "AMT_TLSProtocolEndpointCollection",
+		Selectors:   []Selector{{Name: "ElementName", Value: "TLSProtocolEndpoint Instances Collection"}},
+	})
+
+	response, err := service.Create(instance)
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, "<a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Create</a:Action>")
+	assert.Contains(t, client.request, `<h:ElementInContext><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_PublicKeyCertificate</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">testCertificate</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ElementInContext>`)
+	assert.Equal(t, "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_TLSCredentialContext", response.Body.CreateResponse.ResourceURI)
+	assert.Len(t, response.Body.CreateResponse.Selectors, 2)
+	assert.Equal(t, "ElementInContext", response.Body.CreateResponse.Selectors[0].Name)
+}
+
+func TestPositiveDynamicDelete(t *testing.T) {
+	client := &mockClient{fixture: "amt/general/get.xml"}
+	service := NewServiceWithClient(AMTResourceURIBase, "AMT_PublicKeyCertificate", client)
+
+	_, err := service.Delete(Selector{Name: "InstanceID", Value: "cert"})
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, "<a:Action>http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete</a:Action>")
+	assert.Contains(t, client.request, `<w:Selector Name="InstanceID">cert</w:Selector>`)
+	assert.Contains(t, client.request, "<Body></Body>")
+}
+
+func TestPositiveDynamicInvoke(t *testing.T) {
+	client := &mockClient{fixture: "amt/authorization/setadminaclentryex.xml"}
+	service := NewServiceWithClient(AMTResourceURIBase, "AMT_AuthorizationService", client)
+
+	input := NewInstance("SetAdminAclEntryEx_INPUT")
+	input.Set("Username", "admin")
+	input.Set("DigestPassword", "aGFzaA==")
+
+	response, err := service.Invoke("SetAdminAclEntryEx", input)
+	assert.NoError(t, err)
+	assert.Contains(t, client.request, "<a:Action>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService/SetAdminAclEntryEx</a:Action>")
+	assert.Contains(t, client.request, `<Body><h:SetAdminAclEntryEx_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuthorizationService"><h:Username>admin</h:Username><h:DigestPassword>aGFzaA==</h:DigestPassword></h:SetAdminAclEntryEx_INPUT></Body>`)
+	assert.Equal(t, "SetAdminAclEntryEx_OUTPUT", response.Body.MethodResponse.ClassName)
+	assert.Equal(t, "0", response.Body.MethodResponse.Value("ReturnValue"))
+}
+
+func TestNegativeDynamic(t *testing.T) {
+	client := &mockClient{}
+	service := NewServiceWithClient(AMTResourceURIBase, "AMT_GeneralSettings", client)
+
+	_, err := service.Get()
+	assert.ErrorIs(t, err, errTest)
+
+	_, err = service.Enumerate()
+	assert.ErrorIs(t, err, errTest)
+
+	_, err = service.Put(NewInstance("AMT_GeneralSettings"))
+	assert.ErrorIs(t, err, errTest)
+
+	_, err = service.Invoke("Method", NewInstance("Method_INPUT"))
+	assert.ErrorIs(t, err, errTest)
+}
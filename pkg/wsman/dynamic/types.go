@@ -0,0 +1,81 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package dynamic
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+// Service sends WS-Management requests for a single class identified by its resource URI base and class name.
+type Service struct {
+	base      message.Base
+	className string
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+
+	// Body is decoded by Body.UnmarshalXML since the element names depend on the class being accessed.
+	Body struct {
+		XMLName           xml.Name
+		GetResponse       Instance          // Instance returned by Get and Put.
+		MethodResponse    Instance          // <Method>_OUTPUT returned by Invoke.
+		CreateResponse    EndpointReference // ResourceCreated returned by Create.
+		EnumerateResponse common.EnumerateResponse
+		PullResponse      PullResponse
+	}
+
+	PullResponse struct {
+		XMLName            xml.Name
+		EnumerationContext string
+		EndOfSequence      bool
+		Items              []Instance
+	}
+)
+
+// Instance is a class-agnostic representation of a CIM instance (or method input/output) that keeps its properties in document order.
+type Instance struct {
+	ClassName  string     // Local name of the instance element, e.g. AMT_GeneralSettings.
+	Namespace  string     // Namespace of the instance element, normally the resource URI of the class.
+	Properties []Property // Properties in the order they were received or set.
+}
+
+// Property is a named property of an Instance. Array properties hold one Value per element.
+type Property struct {
+	Name   string
+	Values []Value
+}
+
+// Value holds a single property value. EPR is set for reference properties, Nil for xsi:nil values, otherwise Text holds the value.
+type Value struct {
+	Text string
+	EPR  *EndpointReference
+	Nil  bool
+}
+
+// EndpointReference is a WS-Addressing endpoint reference to another instance.
+type EndpointReference struct {
+	Address     string
+	ResourceURI string
+	Selectors   []Selector
+}
+
+// Selector identifies an instance by key property name and value.
+type Selector struct {
+	Name  string
+	Value string
+}
@@ -0,0 +1,106 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package httpproxy
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewHTTPProxyAccessPointWithClient returns a new instance of the AccessPoint struct.
+func NewHTTPProxyAccessPointWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) AccessPoint {
+	return AccessPoint{
+		base: message.NewBaseWithClient(wsmanMessageCreator, IPSHTTPProxyAccessPoint, client),
+	}
+}
+
+// Get retrieves the representation of the access point with the given Name.
+func (accessPoint AccessPoint) Get(name string) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: accessPoint.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = accessPoint.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (accessPoint AccessPoint) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: accessPoint.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = accessPoint.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (accessPoint AccessPoint) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: accessPoint.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = accessPoint.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete removes the access point with the given Name.
+func (accessPoint AccessPoint) Delete(name string) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: accessPoint.base.Delete(selector),
+		},
+	}
+	// send the message to AMT
+	err = accessPoint.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
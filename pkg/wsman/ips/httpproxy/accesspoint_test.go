@@ -0,0 +1,227 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package httpproxy
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	accessPointName     = "Intel(r) AMT:HTTP Proxy Access Point 1"
+	accessPointSelector = `<w:SelectorSet><w:Selector Name="Name">Intel(r) AMT:HTTP Proxy Access Point 1</w:Selector></w:SelectorSet>`
+)
+
+func proxyAccessPoint(index int, accessInfo string, infoFormat InfoFormat, port int, networkDNSSuffix string) AccessPointResponse {
+	return AccessPointResponse{
+		XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSHTTPProxyAccessPoint), Local: IPSHTTPProxyAccessPoint},
+		CreationClassName:       IPSHTTPProxyAccessPoint,
+		ElementName:             "Intel(r) AMT:HTTP Proxy Access Point",
+		Name:                    fmt.Sprintf("Intel(r) AMT:HTTP Proxy Access Point %d", index),
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		AccessInfo:              accessInfo,
+		InfoFormat:              infoFormat,
+		Port:                    port,
+		NetworkDnsSuffix:        networkDNSSuffix,
+	}
+}
+
+func TestPositiveIPS_HTTPProxyAccessPoint(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/httpproxy/accesspoint",
+	}
+	elementUnderTest := NewHTTPProxyAccessPointWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_HTTPProxyAccessPoint Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid IPS_HTTPProxyAccessPoint Get wsman message",
+				IPSHTTPProxyAccessPoint,
+				wsmantesting.Get,
+				"",
+				accessPointSelector,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(accessPointName)
+				},
+				Body{
+					XMLName:                xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AccessPointGetResponse: proxyAccessPoint(1, "proxy.contoso.com", InfoFormatFQDN, 3128, "contoso.com"),
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid IPS_HTTPProxyAccessPoint Enumerate wsman message",
+				IPSHTTPProxyAccessPoint,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid IPS_HTTPProxyAccessPoint Pull wsman message",
+				IPSHTTPProxyAccessPoint,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						AccessPointItems: []AccessPointResponse{
+							proxyAccessPoint(1, "proxy.contoso.com", InfoFormatFQDN, 3128, "contoso.com"),
+							proxyAccessPoint(2, "10.0.0.8", InfoFormatIPv4Address, 8080, "branch.contoso.com"),
+						},
+					},
+				},
+			},
+			// DELETE
+			{
+				"should create a valid IPS_HTTPProxyAccessPoint Delete wsman message",
+				IPSHTTPProxyAccessPoint,
+				wsmantesting.Delete,
+				"",
+				accessPointSelector,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(accessPointName)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_HTTPProxyAccessPoint(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/httpproxy/accesspoint",
+	}
+	elementUnderTest := NewHTTPProxyAccessPointWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_HTTPProxyAccessPoint Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			extraHeader  string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when IPS_HTTPProxyAccessPoint Get wsman message fails",
+				IPSHTTPProxyAccessPoint,
+				wsmantesting.Get,
+				"",
+				accessPointSelector,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(accessPointName)
+				},
+			},
+			{
+				"should handle error when IPS_HTTPProxyAccessPoint Enumerate wsman message fails",
+				IPSHTTPProxyAccessPoint,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when IPS_HTTPProxyAccessPoint Pull wsman message fails",
+				IPSHTTPProxyAccessPoint,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when IPS_HTTPProxyAccessPoint Delete wsman message fails",
+				IPSHTTPProxyAccessPoint,
+				wsmantesting.Delete,
+				"",
+				accessPointSelector,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(accessPointName)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
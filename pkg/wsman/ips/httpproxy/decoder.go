@@ -0,0 +1,61 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package httpproxy
+
+const (
+	IPSHTTPProxyService     string = "IPS_HTTPProxyService"
+	IPSHTTPProxyAccessPoint string = "IPS_HTTPProxyAccessPoint"
+	AddProxyAccessPoint     string = "AddProxyAccessPoint"
+	ValueNotFound           string = "Value not found in map"
+)
+
+const (
+	InfoFormatIPv4Address InfoFormat = 3
+	InfoFormatIPv6Address InfoFormat = 4
+	InfoFormatFQDN        InfoFormat = 201
+)
+
+// infoFormatToString is a map of InfoFormat value to string.
+var infoFormatToString = map[InfoFormat]string{
+	InfoFormatIPv4Address: "IPv4Address",
+	InfoFormatIPv6Address: "IPv6Address",
+	InfoFormatFQDN:        "FQDN",
+}
+
+// String returns a human-readable string representation of the InfoFormat enumeration.
+func (i InfoFormat) String() string {
+	if s, ok := infoFormatToString[i]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ReturnValueSuccess          ReturnValue = 0
+	ReturnValueInternalError    ReturnValue = 1
+	ReturnValueMaxLimitReached  ReturnValue = 23
+	ReturnValueInvalidParameter ReturnValue = 36
+	ReturnValueDuplicate        ReturnValue = 2058
+)
+
+// returnValueToString is a map of ReturnValue value to string.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:          "Success",
+	ReturnValueInternalError:    "InternalError",
+	ReturnValueMaxLimitReached:  "MaxLimitReached",
+	ReturnValueInvalidParameter: "InvalidParameter",
+	ReturnValueDuplicate:        "Duplicate",
+}
+
+// String returns a human-readable string representation of the ReturnValue enumeration.
+func (r ReturnValue) String() string {
+	if s, ok := returnValueToString[r]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
@@ -0,0 +1,48 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package httpproxy
+
+import "testing"
+
+func TestInfoFormat_String(t *testing.T) {
+	tests := []struct {
+		state    InfoFormat
+		expected string
+	}{
+		{InfoFormatIPv4Address, "IPv4Address"},
+		{InfoFormatIPv6Address, "IPv6Address"},
+		{InfoFormatFQDN, "FQDN"},
+		{InfoFormat(0), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{ReturnValueSuccess, "Success"},
+		{ReturnValueInternalError, "InternalError"},
+		{ReturnValueMaxLimitReached, "MaxLimitReached"},
+		{ReturnValueInvalidParameter, "InvalidParameter"},
+		{ReturnValueDuplicate, "Duplicate"},
+		{ReturnValue(99), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
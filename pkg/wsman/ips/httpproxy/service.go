@@ -0,0 +1,121 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package httpproxy facilitates communication with Intel® AMT devices to configure the HTTP proxies used to reach a management server, for example by CIRA and UEFI HTTPS boot.
+//
+// Service:
+// The service that adds HTTP proxy access points.
+//
+// AccessPoint:
+// An HTTP proxy that Intel® AMT uses when it is connected to the network with the DNS suffix of the access point. Access points are listed with Enumerate and Pull, and removed with Delete.
+package httpproxy
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/methods"
+)
+
+// NewHTTPProxyServiceWithClient returns a new instance of the Service struct.
+func NewHTTPProxyServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base: message.NewBaseWithClient(wsmanMessageCreator, IPSHTTPProxyService, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (service Service) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service Service) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service Service) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// AddProxyAccessPoint adds an HTTP proxy, creating an IPS_HTTPProxyAccessPoint instance that is returned in the ProxyAccessPoint reference of the response.
+func (service Service) AddProxyAccessPoint(request AddProxyAccessPointRequest) (response Response, err error) {
+	err = service.base.Validate(request)
+	if err != nil {
+		return
+	}
+
+	request.H = fmt.Sprintf("%s%s", message.IPSSchema, IPSHTTPProxyService)
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSHTTPProxyService, AddProxyAccessPoint), IPSHTTPProxyService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AddProxyAccessPoint), IPSHTTPProxyService, request)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
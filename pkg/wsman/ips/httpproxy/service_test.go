@@ -0,0 +1,267 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package httpproxy
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const addProxyBody = `<h:AddProxyAccessPoint_INPUT xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_HTTPProxyService"><h:AccessInfo>proxy.contoso.com</h:AccessInfo><h:InfoFormat>201</h:InfoFormat><h:Port>3128</h:Port><h:NetworkDnsSuffix>contoso.com</h:NetworkDnsSuffix></h:AddProxyAccessPoint_INPUT>`
+
+var proxyRequest = AddProxyAccessPointRequest{
+	AccessInfo:       "proxy.contoso.com",
+	InfoFormat:       InfoFormatFQDN,
+	Port:             3128,
+	NetworkDnsSuffix: "contoso.com",
+}
+
+var proxyService = ServiceResponse{
+	XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSHTTPProxyService), Local: IPSHTTPProxyService},
+	CreationClassName:       IPSHTTPProxyService,
+	ElementName:             "Intel(r) HTTP Proxy Service",
+	EnabledState:            5,
+	Name:                    "Intel(r) HTTP Proxy Service",
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+}
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			ServiceGetResponse: ServiceResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ServiceItems\":null,\"AccessPointItems\":null},\"ServiceGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\"},\"AccessPointGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"AccessInfo\":\"\",\"InfoFormat\":0,\"Port\":0,\"NetworkDnsSuffix\":\"\"},\"AddProxyAccessPointResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ProxyAccessPoint\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Address\":\"\",\"ReferenceParameters\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ResourceURI\":\"\",\"SelectorSet\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Selectors\":null}}},\"ReturnValue\":0}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			ServiceGetResponse: ServiceResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    serviceitems: []\n    accesspointitems: []\nservicegetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\naccesspointgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    accessinfo: \"\"\n    infoformat: 0\n    port: 0\n    networkdnssuffix: \"\"\naddproxyaccesspointresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    proxyaccesspoint:\n        xmlname:\n            space: \"\"\n            local: \"\"\n        address: \"\"\n        referenceparameters:\n            xmlname:\n                space: \"\"\n                local: \"\"\n            resourceuri: \"\"\n            selectorset:\n                xmlname:\n                    space: \"\"\n                    local: \"\"\n                selectors: []\n    returnvalue: 0\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveIPS_HTTPProxyService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/httpproxy/service",
+	}
+	elementUnderTest := NewHTTPProxyServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_HTTPProxyService Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid IPS_HTTPProxyService Get wsman message",
+				IPSHTTPProxyService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:            xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ServiceGetResponse: proxyService,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid IPS_HTTPProxyService Enumerate wsman message",
+				IPSHTTPProxyService,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid IPS_HTTPProxyService Pull wsman message",
+				IPSHTTPProxyService,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:      xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						ServiceItems: []ServiceResponse{proxyService},
+					},
+				},
+			},
+			// ADD PROXY ACCESS POINT
+			{
+				"should create a valid IPS_HTTPProxyService AddProxyAccessPoint wsman message",
+				IPSHTTPProxyService,
+				methods.GenerateAction(IPSHTTPProxyService, AddProxyAccessPoint),
+				addProxyBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = AddProxyAccessPoint
+
+					return elementUnderTest.AddProxyAccessPoint(proxyRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddProxyAccessPointResponse: AddProxyAccessPoint_OUTPUT{
+						XMLName: xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSHTTPProxyService), Local: "AddProxyAccessPoint_OUTPUT"},
+						ProxyAccessPoint: ProxyAccessPointResponse{
+							XMLName: xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSHTTPProxyService), Local: "ProxyAccessPoint"},
+							Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+							ReferenceParameters: ReferenceParametersResponse{
+								XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/08/addressing", Local: "ReferenceParameters"},
+								ResourceURI: fmt.Sprintf("%s%s", message.IPSSchema, IPSHTTPProxyAccessPoint),
+								SelectorSet: SelectorSetResponse{
+									XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+									Selectors: []SelectorResponse{
+										{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "CreationClassName", Text: IPSHTTPProxyAccessPoint},
+										{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "Name", Text: "Intel(r) AMT:HTTP Proxy Access Point 1"},
+										{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "SystemCreationClassName", Text: "CIM_ComputerSystem"},
+										{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "SystemName", Text: "Intel(r) AMT"},
+									},
+								},
+							},
+						},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_HTTPProxyService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/httpproxy/service",
+	}
+	elementUnderTest := NewHTTPProxyServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_HTTPProxyService Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			extraHeader  string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when IPS_HTTPProxyService Get wsman message fails",
+				IPSHTTPProxyService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when IPS_HTTPProxyService Enumerate wsman message fails",
+				IPSHTTPProxyService,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when IPS_HTTPProxyService Pull wsman message fails",
+				IPSHTTPProxyService,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when IPS_HTTPProxyService AddProxyAccessPoint wsman message fails",
+				IPSHTTPProxyService,
+				methods.GenerateAction(IPSHTTPProxyService, AddProxyAccessPoint),
+				addProxyBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AddProxyAccessPoint(proxyRequest)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
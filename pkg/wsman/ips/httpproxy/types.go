@@ -0,0 +1,127 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package httpproxy
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+// Package Types.
+type (
+	Service struct {
+		base message.Base
+	}
+	AccessPoint struct {
+		base message.Base
+	}
+)
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+
+	Body struct {
+		XMLName                     xml.Name `xml:"Body"`
+		EnumerateResponse           common.EnumerateResponse
+		PullResponse                PullResponse
+		ServiceGetResponse          ServiceResponse
+		AccessPointGetResponse      AccessPointResponse
+		AddProxyAccessPointResponse AddProxyAccessPoint_OUTPUT
+	}
+
+	ServiceResponse struct {
+		XMLName                 xml.Name `xml:"IPS_HTTPProxyService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int      `xml:"EnabledState,omitempty"`            // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+	}
+
+	AccessPointResponse struct {
+		XMLName                 xml.Name   `xml:"IPS_HTTPProxyAccessPoint"`
+		CreationClassName       string     `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		ElementName             string     `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		Name                    string     `xml:"Name,omitempty"`                    // The Name property uniquely identifies the proxy access point, and is used to delete it.
+		SystemCreationClassName string     `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string     `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		AccessInfo              string     `xml:"AccessInfo,omitempty"`              // The IP address or FQDN of the HTTP proxy.
+		InfoFormat              InfoFormat `xml:"InfoFormat,omitempty"`              // An enumerated integer describing the format and interpretation of the AccessInfo property.
+		Port                    int        `xml:"Port,omitempty"`                    // The port of the HTTP proxy.
+		NetworkDnsSuffix        string     `xml:"NetworkDnsSuffix,omitempty"`        // The domain name of the network in which the proxy is used. Intel® AMT uses the proxy only when it is connected to a network with this DNS suffix.
+	}
+
+	PullResponse struct {
+		XMLName          xml.Name              `xml:"PullResponse"`
+		ServiceItems     []ServiceResponse     `xml:"Items>IPS_HTTPProxyService"`
+		AccessPointItems []AccessPointResponse `xml:"Items>IPS_HTTPProxyAccessPoint"`
+	}
+
+	AddProxyAccessPoint_OUTPUT struct {
+		XMLName          xml.Name                 `xml:"AddProxyAccessPoint_OUTPUT"`
+		ProxyAccessPoint ProxyAccessPointResponse `xml:"ProxyAccessPoint"` // A reference to the created IPS_HTTPProxyAccessPoint if the operation succeeded.
+		ReturnValue      ReturnValue              `xml:"ReturnValue"`
+	}
+	ProxyAccessPointResponse struct {
+		XMLName             xml.Name                    `xml:"ProxyAccessPoint"`
+		Address             string                      `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParametersResponse `xml:"ReferenceParameters,omitempty"`
+	}
+	ReferenceParametersResponse struct {
+		XMLName     xml.Name            `xml:"ReferenceParameters"`
+		ResourceURI string              `xml:"ResourceURI,omitempty"`
+		SelectorSet SelectorSetResponse `xml:"SelectorSet,omitempty"`
+	}
+	SelectorSetResponse struct {
+		XMLName   xml.Name           `xml:"SelectorSet"`
+		Selectors []SelectorResponse `xml:"Selector,omitempty"`
+	}
+	SelectorResponse struct {
+		XMLName xml.Name `xml:"Selector"`
+		Name    string   `xml:"Name,attr"`
+		Text    string   `xml:",chardata"`
+	}
+)
+
+// INPUT
+// Request Types.
+type (
+	AddProxyAccessPointRequest struct {
+		XMLName          xml.Name   `xml:"h:AddProxyAccessPoint_INPUT"`
+		H                string     `xml:"xmlns:h,attr"`
+		AccessInfo       string     `xml:"h:AccessInfo"`                 // The IP address or FQDN of the HTTP proxy.
+		InfoFormat       InfoFormat `xml:"h:InfoFormat"`                 // An enumerated integer describing the format and interpretation of the AccessInfo property.
+		Port             int        `xml:"h:Port"`                       // The port of the HTTP proxy.
+		NetworkDnsSuffix string     `xml:"h:NetworkDnsSuffix,omitempty"` // The domain name of the network in which the proxy is used.
+	}
+)
+
+// Property Types.
+type (
+	// An enumerated integer describing the format and interpretation of the AccessInfo property.
+	//
+	// ValueMap={3, 4, 201}
+	//
+	// Values={IPv4 Address, IPv6 Address, FQDN}.
+	InfoFormat int
+	// ReturnValue indicates the status of the operation.
+	//
+	// ValueMap={0, 1, 23, 36, 2058}
+	//
+	// Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_MAX_LIMIT_REACHED, PT_STATUS_INVALID_PARAMETER, PT_STATUS_DUPLICATE}.
+	ReturnValue int
+)
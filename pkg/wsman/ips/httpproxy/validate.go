@@ -0,0 +1,21 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package httpproxy
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+
+// Validate checks the request against the constraints of IPS_HTTPProxyService.AddProxyAccessPoint.
+func (request AddProxyAccessPointRequest) Validate() error {
+	validation := common.NewValidation(IPSHTTPProxyService)
+
+	validation.Required("AccessInfo", request.AccessInfo != "")
+	validation.MaxLength("AccessInfo", request.AccessInfo, 256)
+	common.ValueMap(validation, "InfoFormat", request.InfoFormat, infoFormatToString)
+	validation.Range("Port", request.Port, 1, 65535)
+	validation.MaxLength("NetworkDnsSuffix", request.NetworkDnsSuffix, 192)
+
+	return validation.Err()
+}
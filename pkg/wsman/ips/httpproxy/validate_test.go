@@ -0,0 +1,45 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package httpproxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddProxyAccessPointRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *AddProxyAccessPointRequest)
+		expected string
+	}{
+		{"valid request", func(request *AddProxyAccessPointRequest) {}, ""},
+		{"without NetworkDnsSuffix", func(request *AddProxyAccessPointRequest) { request.NetworkDnsSuffix = "" }, ""},
+		{"missing AccessInfo", func(request *AddProxyAccessPointRequest) { request.AccessInfo = "" }, "AccessInfo is required"},
+		{"unknown InfoFormat", func(request *AddProxyAccessPointRequest) { request.InfoFormat = 1 }, "InfoFormat has unsupported value 1"},
+		{"missing Port", func(request *AddProxyAccessPointRequest) { request.Port = 0 }, "Port must be between 1 and 65535, got 0"},
+		{"long NetworkDnsSuffix", func(request *AddProxyAccessPointRequest) { request.NetworkDnsSuffix = strings.Repeat("a", 193) }, "NetworkDnsSuffix must not exceed 192 characters"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := proxyRequest
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: IPS_HTTPProxyService."+test.expected)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+const (
+	IPSKVMRedirectionSettingData  string = "IPS_KVMRedirectionSettingData"
+	IPSScreenSettingData          string = "IPS_ScreenSettingData"
+	IPSScreenConfigurationService string = "IPS_ScreenConfigurationService"
+	ValueNotFound                 string = "Value not found in map"
+)
+
+const (
+	DefaultScreenPrimary DefaultScreen = iota
+	DefaultScreenSecondary
+	DefaultScreenTertiary
+)
+
+// defaultScreenToString is a map of DefaultScreen value to string.
+var defaultScreenToString = map[DefaultScreen]string{
+	DefaultScreenPrimary:   "Primary",
+	DefaultScreenSecondary: "Secondary",
+	DefaultScreenTertiary:  "Tertiary",
+}
+
+// String returns a human-readable string representation of the DefaultScreen enumeration.
+func (d DefaultScreen) String() string {
+	if s, ok := defaultScreenToString[d]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	DecimationModeDisabled DecimationMode = iota
+	DecimationModeEnabled
+)
+
+// decimationModeToString is a map of DecimationMode value to string.
+var decimationModeToString = map[DecimationMode]string{
+	DecimationModeDisabled: "Disabled",
+	DecimationModeEnabled:  "Enabled",
+}
+
+// String returns a human-readable string representation of the DecimationMode enumeration.
+func (d DecimationMode) String() string {
+	if s, ok := decimationModeToString[d]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	EnabledStateEnabled  EnabledState = 2
+	EnabledStateDisabled EnabledState = 3
+)
+
+// enabledStateToString is a map of EnabledState value to string.
+var enabledStateToString = map[EnabledState]string{
+	EnabledStateEnabled:  "Enabled",
+	EnabledStateDisabled: "Disabled",
+}
+
+// String returns a human-readable string representation of the EnabledState enumeration.
+func (e EnabledState) String() string {
+	if s, ok := enabledStateToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
@@ -0,0 +1,63 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import "testing"
+
+func TestDefaultScreen_String(t *testing.T) {
+	tests := []struct {
+		state    DefaultScreen
+		expected string
+	}{
+		{DefaultScreenPrimary, "Primary"},
+		{DefaultScreenSecondary, "Secondary"},
+		{DefaultScreenTertiary, "Tertiary"},
+		{DefaultScreen(9), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestDecimationMode_String(t *testing.T) {
+	tests := []struct {
+		state    DecimationMode
+		expected string
+	}{
+		{DecimationModeDisabled, "Disabled"},
+		{DecimationModeEnabled, "Enabled"},
+		{DecimationMode(9), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestEnabledState_String(t *testing.T) {
+	tests := []struct {
+		state    EnabledState
+		expected string
+	}{
+		{EnabledStateEnabled, "Enabled"},
+		{EnabledStateDisabled, "Disabled"},
+		{EnabledState(9), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewScreenConfigurationServiceWithClient returns a new instance of the ScreenConfigurationService struct.
+func NewScreenConfigurationServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ScreenConfigurationService {
+	return ScreenConfigurationService{
+		base: message.NewBaseWithClient(wsmanMessageCreator, IPSScreenConfigurationService, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (service ScreenConfigurationService) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service ScreenConfigurationService) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service ScreenConfigurationService) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Put will change properties of the instance, typically EnabledState.
+func (service ScreenConfigurationService) Put(request ScreenConfigurationServiceRequest) (response Response, err error) {
+	err = service.base.Validate(request)
+	if err != nil {
+		return response, err
+	}
+
+	request.H = fmt.Sprintf("%s%s", message.IPSSchema, IPSScreenConfigurationService)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Put(request, false, nil),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
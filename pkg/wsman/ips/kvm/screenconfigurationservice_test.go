@@ -0,0 +1,234 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const servicePutBody = `<h:IPS_ScreenConfigurationService xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_ScreenConfigurationService"><h:CreationClassName>IPS_ScreenConfigurationService</h:CreationClassName><h:ElementName>Intel(r) Screen Configuration Service</h:ElementName><h:EnabledState>3</h:EnabledState><h:Name>Intel(r) Screen Configuration Service</h:Name><h:SystemCreationClassName>CIM_ComputerSystem</h:SystemCreationClassName><h:SystemName>Intel(r) AMT</h:SystemName></h:IPS_ScreenConfigurationService>`
+
+var screenService = ScreenConfigurationServiceResponse{
+	XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSScreenConfigurationService), Local: IPSScreenConfigurationService},
+	CreationClassName:       IPSScreenConfigurationService,
+	ElementName:             "Intel(r) Screen Configuration Service",
+	EnabledState:            EnabledStateEnabled,
+	Name:                    "Intel(r) Screen Configuration Service",
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+}
+
+var serviceRequest = ScreenConfigurationServiceRequest{
+	CreationClassName:       IPSScreenConfigurationService,
+	ElementName:             "Intel(r) Screen Configuration Service",
+	EnabledState:            EnabledStateDisabled,
+	Name:                    "Intel(r) Screen Configuration Service",
+	SystemCreationClassName: "CIM_ComputerSystem",
+	SystemName:              "Intel(r) AMT",
+}
+
+func TestPositiveIPS_ScreenConfigurationService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/kvm/screenconfigurationservice",
+	}
+	elementUnderTest := NewScreenConfigurationServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_ScreenConfigurationService Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid IPS_ScreenConfigurationService Get wsman message",
+				IPSScreenConfigurationService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                            xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ScreenConfigurationServiceResponse: screenService,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid IPS_ScreenConfigurationService Enumerate wsman message",
+				IPSScreenConfigurationService,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid IPS_ScreenConfigurationService Pull wsman message",
+				IPSScreenConfigurationService,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                         xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						ScreenConfigurationServiceItems: []ScreenConfigurationServiceResponse{screenService},
+					},
+				},
+			},
+			// PUTS
+			{
+				"should create a valid IPS_ScreenConfigurationService Put wsman message",
+				IPSScreenConfigurationService,
+				wsmantesting.Put,
+				servicePutBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(serviceRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ScreenConfigurationServiceResponse: ScreenConfigurationServiceResponse{
+						XMLName:                 screenService.XMLName,
+						CreationClassName:       IPSScreenConfigurationService,
+						ElementName:             "Intel(r) Screen Configuration Service",
+						EnabledState:            EnabledStateDisabled,
+						Name:                    "Intel(r) Screen Configuration Service",
+						SystemCreationClassName: "CIM_ComputerSystem",
+						SystemName:              "Intel(r) AMT",
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_ScreenConfigurationService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/kvm/screenconfigurationservice",
+	}
+	elementUnderTest := NewScreenConfigurationServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_ScreenConfigurationService Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			extraHeader  string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when IPS_ScreenConfigurationService Get wsman message fails",
+				IPSScreenConfigurationService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when IPS_ScreenConfigurationService Enumerate wsman message fails",
+				IPSScreenConfigurationService,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when IPS_ScreenConfigurationService Pull wsman message fails",
+				IPSScreenConfigurationService,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when IPS_ScreenConfigurationService Put wsman message fails",
+				IPSScreenConfigurationService,
+				wsmantesting.Put,
+				servicePutBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put(serviceRequest)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,111 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewScreenSettingDataWithClient returns a new instance of the ScreenSettingData struct.
+func NewScreenSettingDataWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ScreenSettingData {
+	return ScreenSettingData{
+		base: message.NewBaseWithClient(wsmanMessageCreator, IPSScreenSettingData, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (screenSettingData ScreenSettingData) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: screenSettingData.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = screenSettingData.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (screenSettingData ScreenSettingData) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: screenSettingData.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = screenSettingData.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (screenSettingData ScreenSettingData) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: screenSettingData.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = screenSettingData.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Put will change properties of the instance. Only the screen indexes can be changed; the screens themselves are reported by the host.
+func (screenSettingData ScreenSettingData) Put(request ScreenSettingDataRequest) (response Response, err error) {
+	err = screenSettingData.base.Validate(request)
+	if err != nil {
+		return response, err
+	}
+
+	request.H = fmt.Sprintf("%s%s", message.IPSSchema, IPSScreenSettingData)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: screenSettingData.base.Put(request, false, nil),
+		},
+	}
+	// send the message to AMT
+	err = screenSettingData.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
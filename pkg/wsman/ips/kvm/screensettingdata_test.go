@@ -0,0 +1,244 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const screenPutBody = `<h:IPS_ScreenSettingData xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_ScreenSettingData"><h:ElementName>Intel(r) Screen Setting Data</h:ElementName><h:InstanceID>Intel(r) Screen Setting Data</h:InstanceID><h:PrimaryIndex>1</h:PrimaryIndex><h:SecondaryIndex>0</h:SecondaryIndex><h:TertiaryIndex>2</h:TertiaryIndex><h:QuadraIndex>3</h:QuadraIndex></h:IPS_ScreenSettingData>`
+
+var screenSettings = ScreenSettingDataResponse{
+	XMLName:        xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSScreenSettingData), Local: IPSScreenSettingData},
+	ElementName:    "Intel(r) Screen Setting Data",
+	InstanceID:     "Intel(r) Screen Setting Data",
+	PrimaryIndex:   0,
+	SecondaryIndex: 1,
+	TertiaryIndex:  2,
+	QuadraIndex:    3,
+	IsActive:       []bool{true, true, false, false},
+	UpperLeftX:     []int{0, 1920, 0, 0},
+	UpperLeftY:     []int{0, 0, 0, 0},
+	ResolutionX:    []int{1920, 1280, 0, 0},
+	ResolutionY:    []int{1080, 1024, 0, 0},
+}
+
+var screenRequest = ScreenSettingDataRequest{
+	ElementName:    "Intel(r) Screen Setting Data",
+	InstanceID:     "Intel(r) Screen Setting Data",
+	PrimaryIndex:   1,
+	SecondaryIndex: 0,
+	TertiaryIndex:  2,
+	QuadraIndex:    3,
+}
+
+func TestPositiveIPS_ScreenSettingData(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/kvm/screensettingdata",
+	}
+	elementUnderTest := NewScreenSettingDataWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_ScreenSettingData Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid IPS_ScreenSettingData Get wsman message",
+				IPSScreenSettingData,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                   xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ScreenSettingDataResponse: screenSettings,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid IPS_ScreenSettingData Enumerate wsman message",
+				IPSScreenSettingData,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid IPS_ScreenSettingData Pull wsman message",
+				IPSScreenSettingData,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						ScreenSettingDataItems: []ScreenSettingDataResponse{screenSettings},
+					},
+				},
+			},
+			// PUTS
+			{
+				"should create a valid IPS_ScreenSettingData Put wsman message",
+				IPSScreenSettingData,
+				wsmantesting.Put,
+				screenPutBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(screenRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ScreenSettingDataResponse: ScreenSettingDataResponse{
+						XMLName:        screenSettings.XMLName,
+						ElementName:    screenSettings.ElementName,
+						InstanceID:     screenSettings.InstanceID,
+						PrimaryIndex:   1,
+						SecondaryIndex: 0,
+						TertiaryIndex:  2,
+						QuadraIndex:    3,
+						IsActive:       screenSettings.IsActive,
+						UpperLeftX:     screenSettings.UpperLeftX,
+						UpperLeftY:     screenSettings.UpperLeftY,
+						ResolutionX:    screenSettings.ResolutionX,
+						ResolutionY:    screenSettings.ResolutionY,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_ScreenSettingData(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/kvm/screensettingdata",
+	}
+	elementUnderTest := NewScreenSettingDataWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_ScreenSettingData Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			extraHeader  string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when IPS_ScreenSettingData Get wsman message fails",
+				IPSScreenSettingData,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when IPS_ScreenSettingData Enumerate wsman message fails",
+				IPSScreenSettingData,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when IPS_ScreenSettingData Pull wsman message fails",
+				IPSScreenSettingData,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when IPS_ScreenSettingData Put wsman message fails",
+				IPSScreenSettingData,
+				wsmantesting.Put,
+				screenPutBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put(screenRequest)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,121 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package kvm facilitates communication with Intel® AMT devices to configure KVM (keyboard, video and mouse) redirection and the screens it shows.
+//
+// SettingData:
+// The KVM redirection settings of Intel® AMT, such as the RFB password and opt-in policy of sessions on the standard port 5900, the screen shown when a session starts, and the session timeout.
+//
+// ScreenSettingData:
+// The screens of the host, their resolution and position on the desktop, and which of them are the primary, secondary, tertiary and fourth screen of KVM sessions.
+//
+// ScreenConfigurationService:
+// The service that applies the screen configuration of the host to KVM sessions.
+package kvm
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewKVMRedirectionSettingDataWithClient returns a new instance of the SettingData struct.
+func NewKVMRedirectionSettingDataWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) SettingData {
+	return SettingData{
+		base: message.NewBaseWithClient(wsmanMessageCreator, IPSKVMRedirectionSettingData, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (settingData SettingData) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settingData.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = settingData.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (settingData SettingData) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settingData.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = settingData.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (settingData SettingData) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settingData.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = settingData.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Put will change properties of the instance. RFBPassword is only sent when set, leaving the current password unchanged otherwise.
+func (settingData SettingData) Put(request KVMRedirectionSettingDataRequest) (response Response, err error) {
+	err = settingData.base.Validate(request)
+	if err != nil {
+		return response, err
+	}
+
+	request.H = fmt.Sprintf("%s%s", message.IPSSchema, IPSKVMRedirectionSettingData)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settingData.base.Put(request, false, nil),
+		},
+	}
+	// send the message to AMT
+	err = settingData.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
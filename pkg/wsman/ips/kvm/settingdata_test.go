@@ -0,0 +1,272 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const kvmPutBody = `<h:IPS_KVMRedirectionSettingData xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_KVMRedirectionSettingData"><h:ElementName>Intel(r) KVM Redirection Settings</h:ElementName><h:InstanceID>Intel(r) KVM Redirection Settings</h:InstanceID><h:EnabledByMEBx>true</h:EnabledByMEBx><h:BackToBackFbMode>true</h:BackToBackFbMode><h:Is5900PortEnabled>true</h:Is5900PortEnabled><h:OptInPolicy>true</h:OptInPolicy><h:OptInPolicyTimeout>300</h:OptInPolicyTimeout><h:SessionTimeout>3</h:SessionTimeout><h:RFBPassword>P@ssw0rd</h:RFBPassword><h:DefaultScreen>1</h:DefaultScreen><h:InitialDecimationModeForLowRes>0</h:InitialDecimationModeForLowRes><h:ZlibControlEnabled>true</h:ZlibControlEnabled></h:IPS_KVMRedirectionSettingData>`
+
+var kvmSettings = KVMRedirectionSettingDataResponse{
+	XMLName:                        xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, IPSKVMRedirectionSettingData), Local: IPSKVMRedirectionSettingData},
+	ElementName:                    "Intel(r) KVM Redirection Settings",
+	InstanceID:                     "Intel(r) KVM Redirection Settings",
+	EnabledByMEBx:                  true,
+	BackToBackFbMode:               true,
+	Is5900PortEnabled:              false,
+	OptInPolicy:                    true,
+	OptInPolicyTimeout:             120,
+	SessionTimeout:                 3,
+	DefaultScreen:                  DefaultScreenPrimary,
+	InitialDecimationModeForLowRes: DecimationModeDisabled,
+	ZlibControlEnabled:             true,
+}
+
+var kvmRequest = KVMRedirectionSettingDataRequest{
+	ElementName:                    "Intel(r) KVM Redirection Settings",
+	InstanceID:                     "Intel(r) KVM Redirection Settings",
+	EnabledByMEBx:                  true,
+	BackToBackFbMode:               true,
+	Is5900PortEnabled:              true,
+	OptInPolicy:                    true,
+	OptInPolicyTimeout:             300,
+	SessionTimeout:                 3,
+	RFBPassword:                    "P@ssw0rd",
+	DefaultScreen:                  DefaultScreenSecondary,
+	InitialDecimationModeForLowRes: DecimationModeDisabled,
+	ZlibControlEnabled:             true,
+}
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			KVMRedirectionSettingDataResponse: KVMRedirectionSettingDataResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"KVMRedirectionSettingDataItems\":null,\"ScreenSettingDataItems\":null,\"ScreenConfigurationServiceItems\":null},\"KVMRedirectionSettingDataResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ElementName\":\"\",\"InstanceID\":\"\",\"EnabledByMEBx\":false,\"BackToBackFbMode\":false,\"Is5900PortEnabled\":false,\"OptInPolicy\":false,\"OptInPolicyTimeout\":0,\"SessionTimeout\":0,\"DefaultScreen\":0,\"InitialDecimationModeForLowRes\":0,\"ZlibControlEnabled\":false},\"ScreenSettingDataResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ElementName\":\"\",\"InstanceID\":\"\",\"PrimaryIndex\":0,\"SecondaryIndex\":0,\"TertiaryIndex\":0,\"QuadraIndex\":0,\"IsActive\":null,\"UpperLeftX\":null,\"UpperLeftY\":null,\"ResolutionX\":null,\"ResolutionY\":null},\"ScreenConfigurationServiceResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\"}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			KVMRedirectionSettingDataResponse: KVMRedirectionSettingDataResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    kvmredirectionsettingdataitems: []\n    screensettingdataitems: []\n    screenconfigurationserviceitems: []\nkvmredirectionsettingdataresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    elementname: \"\"\n    instanceid: \"\"\n    enabledbymebx: false\n    backtobackfbmode: false\n    is5900portenabled: false\n    optinpolicy: false\n    optinpolicytimeout: 0\n    sessiontimeout: 0\n    defaultscreen: 0\n    initialdecimationmodeforlowres: 0\n    zlibcontrolenabled: false\nscreensettingdataresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    elementname: \"\"\n    instanceid: \"\"\n    primaryindex: 0\n    secondaryindex: 0\n    tertiaryindex: 0\n    quadraindex: 0\n    isactive: []\n    upperleftx: []\n    upperlefty: []\n    resolutionx: []\n    resolutiony: []\nscreenconfigurationserviceresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveIPS_KVMRedirectionSettingData(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/kvm/settingdata",
+	}
+	elementUnderTest := NewKVMRedirectionSettingDataWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_KVMRedirectionSettingData Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid IPS_KVMRedirectionSettingData Get wsman message",
+				IPSKVMRedirectionSettingData,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					KVMRedirectionSettingDataResponse: kvmSettings,
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid IPS_KVMRedirectionSettingData Enumerate wsman message",
+				IPSKVMRedirectionSettingData,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid IPS_KVMRedirectionSettingData Pull wsman message",
+				IPSKVMRedirectionSettingData,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                        xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						KVMRedirectionSettingDataItems: []KVMRedirectionSettingDataResponse{kvmSettings},
+					},
+				},
+			},
+			// PUTS
+			{
+				"should create a valid IPS_KVMRedirectionSettingData Put wsman message",
+				IPSKVMRedirectionSettingData,
+				wsmantesting.Put,
+				kvmPutBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(kvmRequest)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					KVMRedirectionSettingDataResponse: KVMRedirectionSettingDataResponse{
+						XMLName:                        kvmSettings.XMLName,
+						ElementName:                    kvmSettings.ElementName,
+						InstanceID:                     kvmSettings.InstanceID,
+						EnabledByMEBx:                  true,
+						BackToBackFbMode:               true,
+						Is5900PortEnabled:              true,
+						OptInPolicy:                    true,
+						OptInPolicyTimeout:             300,
+						SessionTimeout:                 3,
+						DefaultScreen:                  DefaultScreenSecondary,
+						InitialDecimationModeForLowRes: DecimationModeDisabled,
+						ZlibControlEnabled:             true,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_KVMRedirectionSettingData(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/kvm/settingdata",
+	}
+	elementUnderTest := NewKVMRedirectionSettingDataWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_KVMRedirectionSettingData Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			extraHeader  string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when IPS_KVMRedirectionSettingData Get wsman message fails",
+				IPSKVMRedirectionSettingData,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when IPS_KVMRedirectionSettingData Enumerate wsman message fails",
+				IPSKVMRedirectionSettingData,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when IPS_KVMRedirectionSettingData Pull wsman message fails",
+				IPSKVMRedirectionSettingData,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when IPS_KVMRedirectionSettingData Put wsman message fails",
+				IPSKVMRedirectionSettingData,
+				wsmantesting.Put,
+				kvmPutBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put(kvmRequest)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
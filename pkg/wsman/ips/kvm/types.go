@@ -0,0 +1,142 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+// Package Types.
+type (
+	SettingData struct {
+		base message.Base
+	}
+	ScreenSettingData struct {
+		base message.Base
+	}
+	ScreenConfigurationService struct {
+		base message.Base
+	}
+)
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+
+	Body struct {
+		XMLName                            xml.Name `xml:"Body"`
+		EnumerateResponse                  common.EnumerateResponse
+		PullResponse                       PullResponse
+		KVMRedirectionSettingDataResponse  KVMRedirectionSettingDataResponse
+		ScreenSettingDataResponse          ScreenSettingDataResponse
+		ScreenConfigurationServiceResponse ScreenConfigurationServiceResponse
+	}
+
+	KVMRedirectionSettingDataResponse struct {
+		XMLName                        xml.Name       `xml:"IPS_KVMRedirectionSettingData"`
+		ElementName                    string         `xml:"ElementName,omitempty"`          // A user-friendly name for the object.
+		InstanceID                     string         `xml:"InstanceID,omitempty"`           // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		EnabledByMEBx                  bool           `xml:"EnabledByMEBx"`                  // Indicates whether KVM was enabled in the MEBx. KVM cannot be used when it was disabled in the MEBx.
+		BackToBackFbMode               bool           `xml:"BackToBackFbMode"`               // Indicates whether frame buffers are sent back to back, without waiting for the viewer to request the next update.
+		Is5900PortEnabled              bool           `xml:"Is5900PortEnabled"`              // Indicates whether the standard RFB port 5900 is open, for viewers that connect without redirection through Intel® AMT.
+		OptInPolicy                    bool           `xml:"OptInPolicy"`                    // Indicates whether user consent is required for a KVM session on port 5900.
+		OptInPolicyTimeout             int            `xml:"OptInPolicyTimeout"`             // The time, in seconds, that the user consent code is displayed for a KVM session on port 5900.
+		SessionTimeout                 int            `xml:"SessionTimeout"`                 // The time, in minutes, after which an idle KVM session on port 5900 is closed.
+		DefaultScreen                  DefaultScreen  `xml:"DefaultScreen"`                  // The screen that is shown when a KVM session starts.
+		InitialDecimationModeForLowRes DecimationMode `xml:"InitialDecimationModeForLowRes"` // The decimation mode used when a KVM session starts in a low resolution.
+		ZlibControlEnabled             bool           `xml:"ZlibControlEnabled"`             // Indicates whether the viewer may change the zlib compression level.
+	}
+
+	ScreenSettingDataResponse struct {
+		XMLName        xml.Name `xml:"IPS_ScreenSettingData"`
+		ElementName    string   `xml:"ElementName,omitempty"` // A user-friendly name for the object.
+		InstanceID     string   `xml:"InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		PrimaryIndex   int      `xml:"PrimaryIndex"`          // The index, into the screen arrays, of the primary screen.
+		SecondaryIndex int      `xml:"SecondaryIndex"`        // The index, into the screen arrays, of the secondary screen.
+		TertiaryIndex  int      `xml:"TertiaryIndex"`         // The index, into the screen arrays, of the tertiary screen.
+		QuadraIndex    int      `xml:"QuadraIndex"`           // The index, into the screen arrays, of the fourth screen.
+		IsActive       []bool   `xml:"IsActive"`              // Indicates, per screen, whether the screen is connected and active.
+		UpperLeftX     []int    `xml:"UpperLeftX"`            // The horizontal position, per screen, of the upper left corner of the screen on the desktop.
+		UpperLeftY     []int    `xml:"UpperLeftY"`            // The vertical position, per screen, of the upper left corner of the screen on the desktop.
+		ResolutionX    []int    `xml:"ResolutionX"`           // The horizontal resolution, per screen, in pixels.
+		ResolutionY    []int    `xml:"ResolutionY"`           // The vertical resolution, per screen, in pixels.
+	}
+
+	ScreenConfigurationServiceResponse struct {
+		XMLName                 xml.Name     `xml:"IPS_ScreenConfigurationService"`
+		CreationClassName       string       `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		ElementName             string       `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            EnabledState `xml:"EnabledState,omitempty"`            // Indicates whether the screen configuration of the host is applied to KVM sessions.
+		Name                    string       `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string       `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string       `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+	}
+
+	PullResponse struct {
+		XMLName                         xml.Name                             `xml:"PullResponse"`
+		KVMRedirectionSettingDataItems  []KVMRedirectionSettingDataResponse  `xml:"Items>IPS_KVMRedirectionSettingData"`
+		ScreenSettingDataItems          []ScreenSettingDataResponse          `xml:"Items>IPS_ScreenSettingData"`
+		ScreenConfigurationServiceItems []ScreenConfigurationServiceResponse `xml:"Items>IPS_ScreenConfigurationService"`
+	}
+
+	// DefaultScreen is the screen that is shown when a KVM session starts.
+	DefaultScreen int
+	// DecimationMode is the decimation mode used when a KVM session starts in a low resolution.
+	DecimationMode int
+	// EnabledState indicates whether the screen configuration of the host is applied to KVM sessions.
+	EnabledState int
+)
+
+// INPUT
+// Request Types.
+type (
+	KVMRedirectionSettingDataRequest struct {
+		XMLName                        xml.Name       `xml:"h:IPS_KVMRedirectionSettingData"`
+		H                              string         `xml:"xmlns:h,attr"`
+		ElementName                    string         `xml:"h:ElementName,omitempty"`
+		InstanceID                     string         `xml:"h:InstanceID,omitempty"`
+		EnabledByMEBx                  bool           `xml:"h:EnabledByMEBx"`
+		BackToBackFbMode               bool           `xml:"h:BackToBackFbMode"`
+		Is5900PortEnabled              bool           `xml:"h:Is5900PortEnabled"`
+		OptInPolicy                    bool           `xml:"h:OptInPolicy"`
+		OptInPolicyTimeout             int            `xml:"h:OptInPolicyTimeout"`
+		SessionTimeout                 int            `xml:"h:SessionTimeout"`
+		RFBPassword                    string         `xml:"h:RFBPassword,omitempty"` // Write only. The password of KVM sessions on port 5900, which is never returned by Get.
+		DefaultScreen                  DefaultScreen  `xml:"h:DefaultScreen"`
+		InitialDecimationModeForLowRes DecimationMode `xml:"h:InitialDecimationModeForLowRes"`
+		ZlibControlEnabled             bool           `xml:"h:ZlibControlEnabled"`
+	}
+	ScreenSettingDataRequest struct {
+		XMLName        xml.Name `xml:"h:IPS_ScreenSettingData"`
+		H              string   `xml:"xmlns:h,attr"`
+		ElementName    string   `xml:"h:ElementName,omitempty"`
+		InstanceID     string   `xml:"h:InstanceID,omitempty"`
+		PrimaryIndex   int      `xml:"h:PrimaryIndex"`
+		SecondaryIndex int      `xml:"h:SecondaryIndex"`
+		TertiaryIndex  int      `xml:"h:TertiaryIndex"`
+		QuadraIndex    int      `xml:"h:QuadraIndex"`
+	}
+	ScreenConfigurationServiceRequest struct {
+		XMLName                 xml.Name     `xml:"h:IPS_ScreenConfigurationService"`
+		H                       string       `xml:"xmlns:h,attr"`
+		CreationClassName       string       `xml:"h:CreationClassName,omitempty"`
+		ElementName             string       `xml:"h:ElementName,omitempty"`
+		EnabledState            EnabledState `xml:"h:EnabledState"`
+		Name                    string       `xml:"h:Name,omitempty"`
+		SystemCreationClassName string       `xml:"h:SystemCreationClassName,omitempty"`
+		SystemName              string       `xml:"h:SystemName,omitempty"`
+	}
+)
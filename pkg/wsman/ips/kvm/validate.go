@@ -0,0 +1,63 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+// rfbPasswordLength is the length of an RFB password, which the RFB protocol limits to 8 characters.
+const rfbPasswordLength = 8
+
+// Validate checks the request against the constraints of IPS_KVMRedirectionSettingData.
+func (request KVMRedirectionSettingDataRequest) Validate() error {
+	validation := common.NewValidation(IPSKVMRedirectionSettingData)
+
+	common.ValueMap(validation, "DefaultScreen", request.DefaultScreen, defaultScreenToString)
+	common.ValueMap(validation, "InitialDecimationModeForLowRes", request.InitialDecimationModeForLowRes, decimationModeToString)
+	validation.Range("OptInPolicyTimeout", request.OptInPolicyTimeout, 0, 65535)
+	validation.Range("SessionTimeout", request.SessionTimeout, 0, 65535)
+
+	if request.RFBPassword != "" {
+		validation.ASCII("RFBPassword", request.RFBPassword)
+		validation.Length("RFBPassword", request.RFBPassword, rfbPasswordLength, rfbPasswordLength)
+		validation.Check(strongPassword(request.RFBPassword), "RFBPassword", "must contain an uppercase letter, a lowercase letter, a digit and a special character")
+	}
+
+	return validation.Err()
+}
+
+// Validate checks the request against the constraints of IPS_ScreenSettingData.
+func (request ScreenSettingDataRequest) Validate() error {
+	validation := common.NewValidation(IPSScreenSettingData)
+
+	validation.Range("PrimaryIndex", request.PrimaryIndex, 0, 3)
+	validation.Range("SecondaryIndex", request.SecondaryIndex, 0, 3)
+	validation.Range("TertiaryIndex", request.TertiaryIndex, 0, 3)
+	validation.Range("QuadraIndex", request.QuadraIndex, 0, 3)
+
+	return validation.Err()
+}
+
+// Validate checks the request against the constraints of IPS_ScreenConfigurationService.
+func (request ScreenConfigurationServiceRequest) Validate() error {
+	validation := common.NewValidation(IPSScreenConfigurationService)
+
+	common.ValueMap(validation, "EnabledState", request.EnabledState, enabledStateToString)
+
+	return validation.Err()
+}
+
+// strongPassword reports whether password has an uppercase letter, a lowercase letter, a digit and a special character.
+func strongPassword(password string) bool {
+	return strings.IndexFunc(password, unicode.IsUpper) >= 0 &&
+		strings.IndexFunc(password, unicode.IsLower) >= 0 &&
+		strings.IndexFunc(password, unicode.IsDigit) >= 0 &&
+		strings.IndexFunc(password, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) >= 0
+}
@@ -0,0 +1,62 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package kvm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKVMRedirectionSettingDataRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *KVMRedirectionSettingDataRequest)
+		expected string
+	}{
+		{"valid request", func(request *KVMRedirectionSettingDataRequest) {}, ""},
+		{"unchanged RFBPassword", func(request *KVMRedirectionSettingDataRequest) { request.RFBPassword = "" }, ""},
+		{"short RFBPassword", func(request *KVMRedirectionSettingDataRequest) { request.RFBPassword = "P@ss0rd" }, "RFBPassword must be between 8 and 8 characters"},
+		{"weak RFBPassword", func(request *KVMRedirectionSettingDataRequest) { request.RFBPassword = "password" }, "RFBPassword must contain an uppercase letter, a lowercase letter, a digit and a special character"},
+		{"non ASCII RFBPassword", func(request *KVMRedirectionSettingDataRequest) { request.RFBPassword = "P@ssw0rö" }, "RFBPassword must contain only printable ASCII characters"},
+		{"unknown DefaultScreen", func(request *KVMRedirectionSettingDataRequest) { request.DefaultScreen = 3 }, "DefaultScreen has unsupported value 3"},
+		{"unknown InitialDecimationModeForLowRes", func(request *KVMRedirectionSettingDataRequest) { request.InitialDecimationModeForLowRes = 2 }, "InitialDecimationModeForLowRes has unsupported value 2"},
+		{"SessionTimeout out of range", func(request *KVMRedirectionSettingDataRequest) { request.SessionTimeout = 65536 }, "SessionTimeout must be between 0 and 65535, got 65536"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := kvmRequest
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: IPS_KVMRedirectionSettingData."+test.expected)
+		})
+	}
+}
+
+func TestScreenSettingDataRequest_Validate(t *testing.T) {
+	request := screenRequest
+	assert.NoError(t, request.Validate())
+
+	request.QuadraIndex = 4
+	assert.EqualError(t, request.Validate(), "invalid request: IPS_ScreenSettingData.QuadraIndex must be between 0 and 3, got 4")
+}
+
+func TestScreenConfigurationServiceRequest_Validate(t *testing.T) {
+	request := serviceRequest
+	assert.NoError(t, request.Validate())
+
+	request.EnabledState = 0
+	assert.EqualError(t, request.Validate(), "invalid request: IPS_ScreenConfigurationService.EnabledState has unsupported value 0")
+}
@@ -10,20 +10,33 @@ import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/alarmclock"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/httpproxy"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/ieee8021x"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/kvm"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/provisioningrecord"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
 )
 
 type Messages struct {
-	wsmanMessageCreator        *message.WSManMessageCreator
-	OptInService               optin.Service
-	HostBasedSetupService      hostbasedsetup.Service
-	AlarmClockOccurrence       alarmclock.Occurrence
-	IEEE8021xCredentialContext ieee8021x.CredentialContext
-	IEEE8021xSettings          ieee8021x.Settings
-	PowerManagementService     power.ManagementService
+	wsmanMessageCreator              *message.WSManMessageCreator
+	OptInService                     optin.Service
+	HostBasedSetupService            hostbasedsetup.Service
+	AlarmClockOccurrence             alarmclock.Occurrence
+	IEEE8021xCredentialContext       ieee8021x.CredentialContext
+	IEEE8021xSettings                ieee8021x.Settings
+	PowerManagementService           power.ManagementService
+	KVMRedirectionSettingData        kvm.SettingData
+	ScreenSettingData                kvm.ScreenSettingData
+	ScreenConfigurationService       kvm.ScreenConfigurationService
+	HTTPProxyService                 httpproxy.Service
+	HTTPProxyAccessPoint             httpproxy.AccessPoint
+	ProvisioningRecordLog            provisioningrecord.RecordLog
+	ProvisioningAuditRecord          provisioningrecord.AuditRecord
+	AdminProvisioningRecord          provisioningrecord.AdminRecord
+	HostBasedSetupProvisioningRecord provisioningrecord.HostBasedSetupRecord
+	TLSProvisioningRecord            provisioningrecord.TLSRecord
 }
 
 func NewMessages(client client.WSMan) Messages {
@@ -38,6 +51,25 @@ func NewMessages(client client.WSMan) Messages {
 	m.IEEE8021xCredentialContext = ieee8021x.NewIEEE8021xCredentialContextWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xSettings = ieee8021x.NewIEEE8021xSettingsWithClient(wsmanMessageCreator, client)
 	m.PowerManagementService = power.NewPowerManagementServiceWithClient(wsmanMessageCreator, client)
+	m.KVMRedirectionSettingData = kvm.NewKVMRedirectionSettingDataWithClient(wsmanMessageCreator, client)
+	m.ScreenSettingData = kvm.NewScreenSettingDataWithClient(wsmanMessageCreator, client)
+	m.ScreenConfigurationService = kvm.NewScreenConfigurationServiceWithClient(wsmanMessageCreator, client)
+	m.HTTPProxyService = httpproxy.NewHTTPProxyServiceWithClient(wsmanMessageCreator, client)
+	m.HTTPProxyAccessPoint = httpproxy.NewHTTPProxyAccessPointWithClient(wsmanMessageCreator, client)
+	m.ProvisioningRecordLog = provisioningrecord.NewProvisioningRecordLogWithClient(wsmanMessageCreator, client)
+	m.ProvisioningAuditRecord = provisioningrecord.NewProvisioningAuditRecordWithClient(wsmanMessageCreator, client)
+	m.AdminProvisioningRecord = provisioningrecord.NewAdminProvisioningRecordWithClient(wsmanMessageCreator, client)
+	m.HostBasedSetupProvisioningRecord = provisioningrecord.NewHostBasedSetupProvisioningRecordWithClient(wsmanMessageCreator, client)
+	m.TLSProvisioningRecord = provisioningrecord.NewTLSProvisioningRecordWithClient(wsmanMessageCreator, client)
+
+	return m
+}
+
+// NewMessagesWithValidation instantiates a new instance of ips Messages whose services validate request types
+// against the constraints of their class before sending them, and fail with common.ErrInvalidRequest for an invalid one.
+func NewMessagesWithValidation(client client.WSMan) Messages {
+	m := NewMessages(client)
+	m.wsmanMessageCreator.ValidateRequests = true
 
 	return m
 }
@@ -11,9 +11,12 @@ import (
 
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/alarmclock"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/hostbasedsetup"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/httpproxy"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/ieee8021x"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/kvm"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/power"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/provisioningrecord"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
 )
 
@@ -48,4 +51,44 @@ func TestNewMessages(t *testing.T) {
 	if reflect.DeepEqual(m.PowerManagementService, power.ManagementService{}) {
 		t.Error("PowerManagementService is not initialized")
 	}
+
+	if reflect.DeepEqual(m.KVMRedirectionSettingData, kvm.SettingData{}) {
+		t.Error("KVMRedirectionSettingData is not initialized")
+	}
+
+	if reflect.DeepEqual(m.ScreenSettingData, kvm.ScreenSettingData{}) {
+		t.Error("ScreenSettingData is not initialized")
+	}
+
+	if reflect.DeepEqual(m.ScreenConfigurationService, kvm.ScreenConfigurationService{}) {
+		t.Error("ScreenConfigurationService is not initialized")
+	}
+
+	if reflect.DeepEqual(m.HTTPProxyService, httpproxy.Service{}) {
+		t.Error("HTTPProxyService is not initialized")
+	}
+
+	if reflect.DeepEqual(m.HTTPProxyAccessPoint, httpproxy.AccessPoint{}) {
+		t.Error("HTTPProxyAccessPoint is not initialized")
+	}
+
+	if reflect.DeepEqual(m.ProvisioningRecordLog, provisioningrecord.RecordLog{}) {
+		t.Error("ProvisioningRecordLog is not initialized")
+	}
+
+	if reflect.DeepEqual(m.ProvisioningAuditRecord, provisioningrecord.AuditRecord{}) {
+		t.Error("ProvisioningAuditRecord is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AdminProvisioningRecord, provisioningrecord.AdminRecord{}) {
+		t.Error("AdminProvisioningRecord is not initialized")
+	}
+
+	if reflect.DeepEqual(m.HostBasedSetupProvisioningRecord, provisioningrecord.HostBasedSetupRecord{}) {
+		t.Error("HostBasedSetupProvisioningRecord is not initialized")
+	}
+
+	if reflect.DeepEqual(m.TLSProvisioningRecord, provisioningrecord.TLSRecord{}) {
+		t.Error("TLSProvisioningRecord is not initialized")
+	}
 }
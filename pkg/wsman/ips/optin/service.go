@@ -161,6 +161,11 @@ func (service Service) CancelOptIn() (response Response, err error) {
 
 // Put will change properties of the selected instance.
 func (service Service) Put(request OptInServiceRequest) (response Response, err error) {
+	err = service.base.Validate(request)
+	if err != nil {
+		return response, err
+	}
+
 	request.H = fmt.Sprintf("%s%s", message.IPSSchema, IPSOptInService)
 	response = Response{
 		Message: &client.Message{
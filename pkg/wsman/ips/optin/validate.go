@@ -0,0 +1,25 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package optin
+
+import "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+
+// Validate checks the request against the constraints of IPS_OptInService.
+func (request OptInServiceRequest) Validate() error {
+	validation := common.NewValidation(IPSOptInService)
+
+	common.ValueMap(validation, "OptInRequired", OptInRequired(request.OptInRequired), optInRequiredToString)
+
+	if request.OptInCodeTimeout != 0 {
+		validation.Range("OptInCodeTimeout", request.OptInCodeTimeout, 60, 900)
+	}
+
+	if request.OptInDisplayTimeout != 0 {
+		validation.Range("OptInDisplayTimeout", request.OptInDisplayTimeout, 10, 65535)
+	}
+
+	return validation.Err()
+}
@@ -0,0 +1,83 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package optin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestOptInServiceRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(request *OptInServiceRequest)
+		expected string
+	}{
+		{"valid request", func(request *OptInServiceRequest) {}, ""},
+		{"all redirection sessions require opt-in", func(request *OptInServiceRequest) { request.OptInRequired = int(OptInRequiredAll) }, ""},
+		{"unknown OptInRequired", func(request *OptInServiceRequest) { request.OptInRequired = 2 }, "OptInRequired has unsupported value 2"},
+		{"OptInCodeTimeout too short", func(request *OptInServiceRequest) { request.OptInCodeTimeout = 30 }, "OptInCodeTimeout must be between 60 and 900, got 30"},
+		{"OptInDisplayTimeout too long", func(request *OptInServiceRequest) { request.OptInDisplayTimeout = 65536 }, "OptInDisplayTimeout must be between 10 and 65535, got 65536"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			request := OptInServiceRequest{
+				CreationClassName:       IPSOptInService,
+				Name:                    "Intel(r) AMT OptIn Service",
+				OptInCodeTimeout:        120,
+				OptInDisplayTimeout:     300,
+				OptInRequired:           int(OptInRequiredKVM),
+				SystemCreationClassName: "CIM_ComputerSystem",
+				SystemName:              "Intel(r) AMT",
+			}
+			test.modify(&request)
+
+			err := request.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, "invalid request: IPS_OptInService."+test.expected)
+		})
+	}
+}
+
+func TestOptInServiceGetPutRoundTrip(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.IPSResourceURIBase)
+	wsmanMessageCreator.ValidateRequests = true
+	client := wsmantesting.MockClient{PackageUnderTest: "ips/optin", CurrentMessage: wsmantesting.CurrentMessageGet}
+	elementUnderTest := NewOptInServiceWithClient(wsmanMessageCreator, &client)
+
+	response, err := elementUnderTest.Get()
+	assert.NoError(t, err)
+
+	current := response.Body.GetAndPutResponse
+	request := OptInServiceRequest{
+		CanModifyOptInPolicy:    current.CanModifyOptInPolicy,
+		CreationClassName:       current.CreationClassName,
+		ElementName:             current.ElementName,
+		Name:                    current.Name,
+		OptInCodeTimeout:        current.OptInCodeTimeout,
+		OptInDisplayTimeout:     current.OptInDisplayTimeout,
+		OptInRequired:           int(current.OptInRequired),
+		OptInState:              current.OptInState,
+		SystemCreationClassName: current.SystemCreationClassName,
+		SystemName:              current.SystemName,
+	}
+	request.OptInRequired = int(OptInRequiredAll)
+
+	// ips/optin has no Put fixture; the Get response has the same shape.
+	_, err = elementUnderTest.Put(request)
+	assert.NoError(t, err)
+}
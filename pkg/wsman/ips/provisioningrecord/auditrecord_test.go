@@ -0,0 +1,196 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecord
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	auditRecordInstanceID = "Intel(r) AMT:Provisioning Audit Record 1"
+	auditRecordSelector   = `<w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:Provisioning Audit Record 1</w:Selector></w:SelectorSet>`
+)
+
+func TestPositiveIPS_ProvisioningAuditRecord(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/provisioningrecord/auditrecord",
+	}
+	elementUnderTest := NewProvisioningAuditRecordWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_ProvisioningAuditRecord Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid IPS_ProvisioningAuditRecord Get wsman message",
+				IPSProvisioningAuditRecord,
+				wsmantesting.Get,
+				"",
+				auditRecordSelector,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(auditRecordInstanceID)
+				},
+				Body{
+					XMLName:                xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AuditRecordGetResponse: auditRecordResponse(),
+					DecodedRecordsResponse: []ProvisioningRecord{
+						decodedAuditRecord(),
+					},
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid IPS_ProvisioningAuditRecord Enumerate wsman message",
+				IPSProvisioningAuditRecord,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid IPS_ProvisioningAuditRecord Pull wsman message returning the records of every provisioning record class",
+				IPSProvisioningAuditRecord,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						AdminRecordItems: []AdminRecordResponse{
+							adminRecordResponse(),
+						},
+						HostBasedSetupRecordItems: []HostBasedSetupRecordResponse{
+							hostBasedSetupRecordResponse(),
+						},
+						TLSRecordItems: []TLSRecordResponse{
+							tlsRecordResponse(),
+						},
+					},
+					DecodedRecordsResponse: []ProvisioningRecord{
+						decodedAdminRecord(),
+						decodedHostBasedSetupRecord(),
+						decodedTLSRecord(),
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_ProvisioningAuditRecord(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/provisioningrecord/auditrecord",
+	}
+	elementUnderTest := NewProvisioningAuditRecordWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_ProvisioningAuditRecord Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			extraHeader  string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when IPS_ProvisioningAuditRecord Get wsman message fails",
+				IPSProvisioningAuditRecord,
+				wsmantesting.Get,
+				"",
+				auditRecordSelector,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(auditRecordInstanceID)
+				},
+			},
+			{
+				"should handle error when IPS_ProvisioningAuditRecord Enumerate wsman message fails",
+				IPSProvisioningAuditRecord,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when IPS_ProvisioningAuditRecord Pull wsman message fails",
+				IPSProvisioningAuditRecord,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
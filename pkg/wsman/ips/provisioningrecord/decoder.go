@@ -0,0 +1,233 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecord
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+)
+
+const (
+	IPSProvisioningRecordLog            string = "IPS_ProvisioningRecordLog"
+	IPSProvisioningAuditRecord          string = "IPS_ProvisioningAuditRecord"
+	IPSAdminProvisioningRecord          string = "IPS_AdminProvisioningRecord"
+	IPSHostBasedSetupProvisioningRecord string = "IPS_HostBasedSetupProvisioningRecord"
+	IPSTLSProvisioningRecord            string = "IPS_TLSProvisioningRecord"
+	ValueNotFound                       string = "Value not found in map"
+)
+
+const (
+	ProvisioningMethodRemoteConfiguration ProvisioningMethod = 2
+	ProvisioningMethodManual              ProvisioningMethod = 3
+	ProvisioningMethodHostBasedClient     ProvisioningMethod = 4
+	ProvisioningMethodHostBasedAdmin      ProvisioningMethod = 5
+)
+
+// provisioningMethodToString is a map of ProvisioningMethod value to string.
+var provisioningMethodToString = map[ProvisioningMethod]string{
+	ProvisioningMethodRemoteConfiguration: "RemoteConfiguration",
+	ProvisioningMethodManual:              "Manual",
+	ProvisioningMethodHostBasedClient:     "HostBasedClient",
+	ProvisioningMethodHostBasedAdmin:      "HostBasedAdmin",
+}
+
+// String returns a human-readable string representation of the ProvisioningMethod enumeration.
+func (p ProvisioningMethod) String() string {
+	if s, ok := provisioningMethodToString[p]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	HashTypeSHA1   HashType = 1
+	HashTypeSHA256 HashType = 2
+	HashTypeSHA384 HashType = 3
+)
+
+// hashTypeToString is a map of HashType value to string.
+var hashTypeToString = map[HashType]string{
+	HashTypeSHA1:   "SHA1",
+	HashTypeSHA256: "SHA256",
+	HashTypeSHA384: "SHA384",
+}
+
+// String returns a human-readable string representation of the HashType enumeration.
+func (h HashType) String() string {
+	if s, ok := hashTypeToString[h]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	CertificateChainStatusValid            CertificateChainStatus = 0
+	CertificateChainStatusRootNotTrusted   CertificateChainStatus = 1
+	CertificateChainStatusExpired          CertificateChainStatus = 2
+	CertificateChainStatusInvalidSignature CertificateChainStatus = 3
+	CertificateChainStatusInvalidUsage     CertificateChainStatus = 4
+)
+
+// certificateChainStatusToString is a map of CertificateChainStatus value to string.
+var certificateChainStatusToString = map[CertificateChainStatus]string{
+	CertificateChainStatusValid:            "Valid",
+	CertificateChainStatusRootNotTrusted:   "RootNotTrusted",
+	CertificateChainStatusExpired:          "Expired",
+	CertificateChainStatusInvalidSignature: "InvalidSignature",
+	CertificateChainStatusInvalidUsage:     "InvalidUsage",
+}
+
+// String returns a human-readable string representation of the CertificateChainStatus enumeration.
+func (c CertificateChainStatus) String() string {
+	if s, ok := certificateChainStatusToString[c]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	TLSModePKI TLSMode = 1
+	TLSModePSK TLSMode = 2
+)
+
+// tlsModeToString is a map of TLSMode value to string.
+var tlsModeToString = map[TLSMode]string{
+	TLSModePKI: "PKI",
+	TLSModePSK: "PSK",
+}
+
+// String returns a human-readable string representation of the TLSMode enumeration.
+func (t TLSMode) String() string {
+	if s, ok := tlsModeToString[t]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+// decodeRecords converts every provisioning record in the body, whether returned by a Get or a Pull, into a ProvisioningRecord.
+func decodeRecords(body Body) ([]ProvisioningRecord, error) {
+	auditRecords := body.PullResponse.AuditRecordItems
+	if body.AuditRecordGetResponse.XMLName.Local != "" {
+		auditRecords = append(auditRecords, body.AuditRecordGetResponse)
+	}
+
+	adminRecords := body.PullResponse.AdminRecordItems
+	if body.AdminRecordGetResponse.XMLName.Local != "" {
+		adminRecords = append(adminRecords, body.AdminRecordGetResponse)
+	}
+
+	hostBasedSetupRecords := body.PullResponse.HostBasedSetupRecordItems
+	if body.HostBasedSetupRecordGetResponse.XMLName.Local != "" {
+		hostBasedSetupRecords = append(hostBasedSetupRecords, body.HostBasedSetupRecordGetResponse)
+	}
+
+	tlsRecords := body.PullResponse.TLSRecordItems
+	if body.TLSRecordGetResponse.XMLName.Local != "" {
+		tlsRecords = append(tlsRecords, body.TLSRecordGetResponse)
+	}
+
+	var records []ProvisioningRecord
+
+	for i := range auditRecords {
+		record, err := decodeRecord(IPSProvisioningAuditRecord, auditRecords[i].RecordResponse, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	for i := range adminRecords {
+		record, err := decodeRecord(IPSAdminProvisioningRecord, adminRecords[i].RecordResponse, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	for i := range hostBasedSetupRecords {
+		record, err := decodeRecord(IPSHostBasedSetupProvisioningRecord, hostBasedSetupRecords[i].RecordResponse, &hostBasedSetupRecords[i].CertificateChainResponse, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	for i := range tlsRecords {
+		record, err := decodeRecord(IPSTLSProvisioningRecord, tlsRecords[i].RecordResponse, &tlsRecords[i].CertificateChainResponse, &tlsRecords[i])
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// decodeRecord converts a single provisioning record. chain and tls are nil for records of classes without those properties.
+// The provisioning parameters are filled in the same way as the extended data of an AMT Provisioning Completed audit log record.
+func decodeRecord(class string, record RecordResponse, chain *CertificateChainResponse, tls *TLSRecordResponse) (decoded ProvisioningRecord, err error) {
+	decoded = ProvisioningRecord{
+		Class:              class,
+		InstanceID:         record.InstanceID,
+		ElementName:        record.ElementName,
+		ProvisioningMethod: record.ProvisioningMethod,
+		Parameters: auditlog.ProvisioningParameters{
+			ProvisioningMethod: uint8(record.ProvisioningMethod),
+		},
+	}
+
+	decoded.CreationTime, err = parseDatetime(record.CreationTimeStamp)
+	if err != nil {
+		return decoded, fmt.Errorf("%s %s CreationTimeStamp: %w", class, record.InstanceID, err)
+	}
+
+	if chain != nil {
+		decoded.HashType = chain.SelectedHashType
+		decoded.CertificateChainStatus = chain.CertificateChainStatus
+		decoded.Parameters.HashType = uint8(chain.SelectedHashType)
+
+		decoded.Parameters.TrustedRootCertHash, err = base64.StdEncoding.DecodeString(chain.SelectedHashData)
+		if err != nil {
+			return decoded, fmt.Errorf("%s %s SelectedHashData: %w", class, record.InstanceID, err)
+		}
+
+		decoded.Parameters.NumberOfCertificates = uint8(len(chain.CaCertSerials))
+		decoded.Parameters.CertSerialNumbers = chain.CaCertSerials
+	}
+
+	if tls != nil {
+		decoded.ProvisioningTLSMode = tls.ProvisioningTLSMode
+		decoded.Parameters.ProvServFQDNLength = uint8(len(tls.ProvServerFQDN))
+		decoded.Parameters.ProvServFQDN = tls.ProvServerFQDN
+
+		decoded.TLSStartTime, err = parseDatetime(tls.TlsStartTime)
+		if err != nil {
+			return decoded, fmt.Errorf("%s %s TlsStartTime: %w", class, record.InstanceID, err)
+		}
+	}
+
+	return decoded, nil
+}
+
+// parseDatetime parses a CIM datetime. An empty datetime is returned as the zero time.
+func parseDatetime(datetime auditlog.Datetime) (time.Time, error) {
+	if datetime.Datetime == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, datetime.Datetime)
+}
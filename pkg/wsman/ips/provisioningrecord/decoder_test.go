@@ -0,0 +1,298 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecord
+
+import (
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+)
+
+const (
+	selectedHashData = "yzzLt2Ax5eATj43TmiP53kf/w15DwRRM6ifUalqxy18="
+	selectedHashHex  = "cb3ccbb76031e5e0138f8dd39a23f9de47ffc35e43c1144cea27d46a5ab1cb5f"
+	provServerFQDN   = "Intel.vprodemo.com"
+)
+
+var (
+	caCertSerials = []string{"0c8ee0c90d6a89158804061ee241f9af", "033af1e6a711a9a0bb2864b11d09fae5"}
+	creationTime  = time.Date(2024, time.January, 3, 0, 44, 35, 0, time.UTC)
+	tlsStartTime  = time.Date(2024, time.January, 3, 0, 44, 30, 0, time.UTC)
+)
+
+func xmlName(class string) xml.Name {
+	return xml.Name{Space: fmt.Sprintf("%s%s", message.IPSSchema, class), Local: class}
+}
+
+func record(name string, method ProvisioningMethod) RecordResponse {
+	return RecordResponse{
+		InstanceID:         fmt.Sprintf("Intel(r) AMT:%s 1", name),
+		ElementName:        fmt.Sprintf("Intel(r) AMT %s", name),
+		CreationTimeStamp:  auditlog.Datetime{Datetime: "2024-01-03T00:44:35Z"},
+		ProvisioningMethod: method,
+	}
+}
+
+func certificateChain() CertificateChainResponse {
+	return CertificateChainResponse{
+		SelectedHashType:       HashTypeSHA256,
+		SelectedHashData:       selectedHashData,
+		CaCertSerials:          caCertSerials,
+		AdditionalCaSerialNums: false,
+		CertificateChainStatus: CertificateChainStatusValid,
+	}
+}
+
+func auditRecordResponse() AuditRecordResponse {
+	return AuditRecordResponse{
+		XMLName:        xmlName(IPSProvisioningAuditRecord),
+		RecordResponse: record("Provisioning Audit Record", ProvisioningMethodRemoteConfiguration),
+	}
+}
+
+func adminRecordResponse() AdminRecordResponse {
+	return AdminRecordResponse{
+		XMLName:        xmlName(IPSAdminProvisioningRecord),
+		RecordResponse: record("Admin Provisioning Record", ProvisioningMethodManual),
+	}
+}
+
+func hostBasedSetupRecordResponse() HostBasedSetupRecordResponse {
+	return HostBasedSetupRecordResponse{
+		XMLName:                  xmlName(IPSHostBasedSetupProvisioningRecord),
+		RecordResponse:           record("Host Based Setup Provisioning Record", ProvisioningMethodHostBasedAdmin),
+		CertificateChainResponse: certificateChain(),
+	}
+}
+
+func tlsRecordResponse() TLSRecordResponse {
+	return TLSRecordResponse{
+		XMLName:                  xmlName(IPSTLSProvisioningRecord),
+		RecordResponse:           record("TLS Provisioning Record", ProvisioningMethodRemoteConfiguration),
+		CertificateChainResponse: certificateChain(),
+		ProvisioningTLSMode:      TLSModePKI,
+		SecureDNS:                false,
+		HostInitiated:            true,
+		ProvServerFQDN:           provServerFQDN,
+		ProvServerIP:             "10.0.0.5",
+		TlsStartTime:             auditlog.Datetime{Datetime: "2024-01-03T00:44:30Z"},
+		IsOemDefault:             true,
+		IsTimeValid:              true,
+	}
+}
+
+func decodedAuditRecord() ProvisioningRecord {
+	return ProvisioningRecord{
+		Class:              IPSProvisioningAuditRecord,
+		InstanceID:         "Intel(r) AMT:Provisioning Audit Record 1",
+		ElementName:        "Intel(r) AMT Provisioning Audit Record",
+		CreationTime:       creationTime,
+		ProvisioningMethod: ProvisioningMethodRemoteConfiguration,
+		Parameters:         auditlog.ProvisioningParameters{ProvisioningMethod: 2},
+	}
+}
+
+func decodedAdminRecord() ProvisioningRecord {
+	return ProvisioningRecord{
+		Class:              IPSAdminProvisioningRecord,
+		InstanceID:         "Intel(r) AMT:Admin Provisioning Record 1",
+		ElementName:        "Intel(r) AMT Admin Provisioning Record",
+		CreationTime:       creationTime,
+		ProvisioningMethod: ProvisioningMethodManual,
+		Parameters:         auditlog.ProvisioningParameters{ProvisioningMethod: 3},
+	}
+}
+
+func decodedHostBasedSetupRecord() ProvisioningRecord {
+	hash, _ := hex.DecodeString(selectedHashHex)
+
+	return ProvisioningRecord{
+		Class:                  IPSHostBasedSetupProvisioningRecord,
+		InstanceID:             "Intel(r) AMT:Host Based Setup Provisioning Record 1",
+		ElementName:            "Intel(r) AMT Host Based Setup Provisioning Record",
+		CreationTime:           creationTime,
+		ProvisioningMethod:     ProvisioningMethodHostBasedAdmin,
+		HashType:               HashTypeSHA256,
+		CertificateChainStatus: CertificateChainStatusValid,
+		Parameters: auditlog.ProvisioningParameters{
+			ProvisioningMethod:   5,
+			HashType:             2,
+			TrustedRootCertHash:  hash,
+			NumberOfCertificates: 2,
+			CertSerialNumbers:    caCertSerials,
+		},
+	}
+}
+
+func decodedTLSRecord() ProvisioningRecord {
+	hash, _ := hex.DecodeString(selectedHashHex)
+
+	return ProvisioningRecord{
+		Class:                  IPSTLSProvisioningRecord,
+		InstanceID:             "Intel(r) AMT:TLS Provisioning Record 1",
+		ElementName:            "Intel(r) AMT TLS Provisioning Record",
+		CreationTime:           creationTime,
+		ProvisioningMethod:     ProvisioningMethodRemoteConfiguration,
+		HashType:               HashTypeSHA256,
+		CertificateChainStatus: CertificateChainStatusValid,
+		ProvisioningTLSMode:    TLSModePKI,
+		TLSStartTime:           tlsStartTime,
+		Parameters: auditlog.ProvisioningParameters{
+			ProvisioningMethod:   2,
+			HashType:             2,
+			TrustedRootCertHash:  hash,
+			NumberOfCertificates: 2,
+			CertSerialNumbers:    caCertSerials,
+			ProvServFQDNLength:   18,
+			ProvServFQDN:         provServerFQDN,
+		},
+	}
+}
+
+func TestProvisioningMethod_String(t *testing.T) {
+	tests := []struct {
+		state    ProvisioningMethod
+		expected string
+	}{
+		{ProvisioningMethodRemoteConfiguration, "RemoteConfiguration"},
+		{ProvisioningMethodManual, "Manual"},
+		{ProvisioningMethodHostBasedClient, "HostBasedClient"},
+		{ProvisioningMethodHostBasedAdmin, "HostBasedAdmin"},
+		{ProvisioningMethod(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestHashType_String(t *testing.T) {
+	tests := []struct {
+		state    HashType
+		expected string
+	}{
+		{HashTypeSHA1, "SHA1"},
+		{HashTypeSHA256, "SHA256"},
+		{HashTypeSHA384, "SHA384"},
+		{HashType(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestCertificateChainStatus_String(t *testing.T) {
+	tests := []struct {
+		state    CertificateChainStatus
+		expected string
+	}{
+		{CertificateChainStatusValid, "Valid"},
+		{CertificateChainStatusRootNotTrusted, "RootNotTrusted"},
+		{CertificateChainStatusExpired, "Expired"},
+		{CertificateChainStatusInvalidSignature, "InvalidSignature"},
+		{CertificateChainStatusInvalidUsage, "InvalidUsage"},
+		{CertificateChainStatus(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestTLSMode_String(t *testing.T) {
+	tests := []struct {
+		state    TLSMode
+		expected string
+	}{
+		{TLSModePKI, "PKI"},
+		{TLSModePSK, "PSK"},
+		{TLSMode(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestDecodeRecords(t *testing.T) {
+	t.Run("should decode a record returned by a Get", func(t *testing.T) {
+		records, err := decodeRecords(Body{TLSRecordGetResponse: tlsRecordResponse()})
+		assert.NoError(t, err)
+		assert.Equal(t, []ProvisioningRecord{decodedTLSRecord()}, records)
+	})
+
+	t.Run("should decode the records returned by a Pull", func(t *testing.T) {
+		records, err := decodeRecords(Body{PullResponse: PullResponse{
+			AuditRecordItems:          []AuditRecordResponse{auditRecordResponse()},
+			AdminRecordItems:          []AdminRecordResponse{adminRecordResponse()},
+			HostBasedSetupRecordItems: []HostBasedSetupRecordResponse{hostBasedSetupRecordResponse()},
+			TLSRecordItems:            []TLSRecordResponse{tlsRecordResponse()},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, []ProvisioningRecord{decodedAuditRecord(), decodedAdminRecord(), decodedHostBasedSetupRecord(), decodedTLSRecord()}, records)
+	})
+
+	t.Run("should decode nothing when the body has no records", func(t *testing.T) {
+		records, err := decodeRecords(Body{RecordLogGetResponse: RecordLogResponse{XMLName: xmlName(IPSProvisioningRecordLog)}})
+		assert.NoError(t, err)
+		assert.Nil(t, records)
+	})
+
+	t.Run("should describe the parameters as a Provisioning Completed audit log record does", func(t *testing.T) {
+		records, err := decodeRecords(Body{TLSRecordGetResponse: tlsRecordResponse()})
+		assert.NoError(t, err)
+		assert.Equal(t, "Provisioning Method: Remote Configuration\nHash Type: SHA 256\nTrusted Root Cert Hash: "+selectedHashHex+"\nNumber of Certificates: 2\nCert Serial Numbers (first 3): [0c8ee0c90d6a89158804061ee241f9af 033af1e6a711a9a0bb2864b11d09fae5]\nProvisioning Server FQDN: Intel.vprodemo.com", records[0].Parameters.String())
+	})
+
+	t.Run("should fail on an invalid CreationTimeStamp", func(t *testing.T) {
+		response := adminRecordResponse()
+		response.CreationTimeStamp.Datetime = "yesterday"
+		_, err := decodeRecords(Body{AdminRecordGetResponse: response})
+		assert.ErrorContains(t, err, "IPS_AdminProvisioningRecord Intel(r) AMT:Admin Provisioning Record 1 CreationTimeStamp")
+	})
+
+	t.Run("should fail on an invalid SelectedHashData", func(t *testing.T) {
+		response := hostBasedSetupRecordResponse()
+		response.SelectedHashData = "not base64"
+		_, err := decodeRecords(Body{HostBasedSetupRecordGetResponse: response})
+		assert.ErrorContains(t, err, "IPS_HostBasedSetupProvisioningRecord Intel(r) AMT:Host Based Setup Provisioning Record 1 SelectedHashData")
+	})
+
+	t.Run("should fail on an invalid TlsStartTime", func(t *testing.T) {
+		response := tlsRecordResponse()
+		response.TlsStartTime.Datetime = "yesterday"
+		_, err := decodeRecords(Body{TLSRecordGetResponse: response})
+		assert.ErrorContains(t, err, "IPS_TLSProvisioningRecord Intel(r) AMT:TLS Provisioning Record 1 TlsStartTime")
+	})
+
+	t.Run("should decode an empty datetime as the zero time", func(t *testing.T) {
+		response := auditRecordResponse()
+		response.CreationTimeStamp.Datetime = ""
+		records, err := decodeRecords(Body{AuditRecordGetResponse: response})
+		assert.NoError(t, err)
+		assert.True(t, records[0].CreationTime.IsZero())
+	})
+}
@@ -0,0 +1,188 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecord
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	hostBasedSetupRecordInstanceID = "Intel(r) AMT:Host Based Setup Provisioning Record 1"
+	hostBasedSetupRecordSelector   = `<w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:Host Based Setup Provisioning Record 1</w:Selector></w:SelectorSet>`
+)
+
+func TestPositiveIPS_HostBasedSetupProvisioningRecord(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/provisioningrecord/hostbasedsetuprecord",
+	}
+	elementUnderTest := NewHostBasedSetupProvisioningRecordWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_HostBasedSetupProvisioningRecord Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid IPS_HostBasedSetupProvisioningRecord Get wsman message",
+				IPSHostBasedSetupProvisioningRecord,
+				wsmantesting.Get,
+				"",
+				hostBasedSetupRecordSelector,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(hostBasedSetupRecordInstanceID)
+				},
+				Body{
+					XMLName:                         xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					HostBasedSetupRecordGetResponse: hostBasedSetupRecordResponse(),
+					DecodedRecordsResponse: []ProvisioningRecord{
+						decodedHostBasedSetupRecord(),
+					},
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid IPS_HostBasedSetupProvisioningRecord Enumerate wsman message",
+				IPSHostBasedSetupProvisioningRecord,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid IPS_HostBasedSetupProvisioningRecord Pull wsman message",
+				IPSHostBasedSetupProvisioningRecord,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						HostBasedSetupRecordItems: []HostBasedSetupRecordResponse{
+							hostBasedSetupRecordResponse(),
+						},
+					},
+					DecodedRecordsResponse: []ProvisioningRecord{
+						decodedHostBasedSetupRecord(),
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_HostBasedSetupProvisioningRecord(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/provisioningrecord/hostbasedsetuprecord",
+	}
+	elementUnderTest := NewHostBasedSetupProvisioningRecordWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_HostBasedSetupProvisioningRecord Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			extraHeader  string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when IPS_HostBasedSetupProvisioningRecord Get wsman message fails",
+				IPSHostBasedSetupProvisioningRecord,
+				wsmantesting.Get,
+				"",
+				hostBasedSetupRecordSelector,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(hostBasedSetupRecordInstanceID)
+				},
+			},
+			{
+				"should handle error when IPS_HostBasedSetupProvisioningRecord Enumerate wsman message fails",
+				IPSHostBasedSetupProvisioningRecord,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when IPS_HostBasedSetupProvisioningRecord Pull wsman message fails",
+				IPSHostBasedSetupProvisioningRecord,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,90 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package provisioningrecord facilitates communication with Intel® AMT devices to read the provisioning record log and the records of how the device was provisioned.
+//
+// RecordLog:
+// The log that holds the provisioning records.
+//
+// AuditRecord, AdminRecord, HostBasedSetupRecord and TLSRecord:
+// The records of how the device was provisioned. Get and Pull decode the records into Body.DecodedRecordsResponse, whose Parameters hold the same provisioning parameters as the extended data of an AMT Provisioning Completed audit log record.
+package provisioningrecord
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewProvisioningRecordLogWithClient returns a new instance of the RecordLog struct.
+func NewProvisioningRecordLogWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) RecordLog {
+	return RecordLog{
+		base: message.NewBaseWithClient(wsmanMessageCreator, IPSProvisioningRecordLog, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (recordLog RecordLog) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: recordLog.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = recordLog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (recordLog RecordLog) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: recordLog.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = recordLog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (recordLog RecordLog) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: recordLog.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = recordLog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,212 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecord
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func recordLog() RecordLogResponse {
+	return RecordLogResponse{
+		XMLName:                xmlName(IPSProvisioningRecordLog),
+		InstanceID:             "Intel(r) AMT:Provisioning Record Log",
+		ElementName:            "Intel(r) AMT Provisioning Record Log",
+		Name:                   "Intel(r) AMT:Provisioning Record Log",
+		EnabledState:           2,
+		MaxNumberOfRecords:     1,
+		CurrentNumberOfRecords: 1,
+		OverwritePolicy:        2,
+	}
+}
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			RecordLogGetResponse: RecordLogResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"RecordLogItems\":null,\"AuditRecordItems\":null,\"AdminRecordItems\":null,\"HostBasedSetupRecordItems\":null,\"TLSRecordItems\":null},\"RecordLogGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"Name\":\"\",\"EnabledState\":0,\"MaxNumberOfRecords\":0,\"CurrentNumberOfRecords\":0,\"OverwritePolicy\":0},\"AuditRecordGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"CreationTimeStamp\":{\"Datetime\":\"\"},\"ProvisioningMethod\":0},\"AdminRecordGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"CreationTimeStamp\":{\"Datetime\":\"\"},\"ProvisioningMethod\":0},\"HostBasedSetupRecordGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"CreationTimeStamp\":{\"Datetime\":\"\"},\"ProvisioningMethod\":0,\"SelectedHashType\":0,\"SelectedHashData\":\"\",\"CaCertSerials\":null,\"AdditionalCaSerialNums\":false,\"CertificateChainStatus\":0},\"TLSRecordGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"CreationTimeStamp\":{\"Datetime\":\"\"},\"ProvisioningMethod\":0,\"SelectedHashType\":0,\"SelectedHashData\":\"\",\"CaCertSerials\":null,\"AdditionalCaSerialNums\":false,\"CertificateChainStatus\":0,\"ProvisioningTLSMode\":0,\"SecureDNS\":false,\"HostInitiated\":false,\"ProvServerFQDN\":\"\",\"ProvServerIP\":\"\",\"TlsStartTime\":{\"Datetime\":\"\"},\"IsOemDefault\":false,\"IsTimeValid\":false},\"DecodedRecordsResponse\":null}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			RecordLogGetResponse: RecordLogResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    recordlogitems: []\n    auditrecorditems: []\n    adminrecorditems: []\n    hostbasedsetuprecorditems: []\n    tlsrecorditems: []\nrecordloggetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    name: \"\"\n    enabledstate: 0\n    maxnumberofrecords: 0\n    currentnumberofrecords: 0\n    overwritepolicy: 0\nauditrecordgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    recordresponse:\n        instanceid: \"\"\n        elementname: \"\"\n        creationtimestamp:\n            datetime: \"\"\n        provisioningmethod: 0\nadminrecordgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    recordresponse:\n        instanceid: \"\"\n        elementname: \"\"\n        creationtimestamp:\n            datetime: \"\"\n        provisioningmethod: 0\nhostbasedsetuprecordgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    recordresponse:\n        instanceid: \"\"\n        elementname: \"\"\n        creationtimestamp:\n            datetime: \"\"\n        provisioningmethod: 0\n    certificatechainresponse:\n        selectedhashtype: 0\n        selectedhashdata: \"\"\n        cacertserials: []\n        additionalcaserialnums: false\n        certificatechainstatus: 0\ntlsrecordgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    recordresponse:\n        instanceid: \"\"\n        elementname: \"\"\n        creationtimestamp:\n            datetime: \"\"\n        provisioningmethod: 0\n    certificatechainresponse:\n        selectedhashtype: 0\n        selectedhashdata: \"\"\n        cacertserials: []\n        additionalcaserialnums: false\n        certificatechainstatus: 0\n    provisioningtlsmode: 0\n    securedns: false\n    hostinitiated: false\n    provserverfqdn: \"\"\n    provserverip: \"\"\n    tlsstarttime:\n        datetime: \"\"\n    isoemdefault: false\n    istimevalid: false\ndecodedrecordsresponse: []\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveIPS_ProvisioningRecordLog(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/provisioningrecord/recordlog",
+	}
+	elementUnderTest := NewProvisioningRecordLogWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_ProvisioningRecordLog Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			extraHeader      string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid IPS_ProvisioningRecordLog Get wsman message",
+				IPSProvisioningRecordLog,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:              xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RecordLogGetResponse: recordLog(),
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid IPS_ProvisioningRecordLog Enumerate wsman message",
+				IPSProvisioningRecordLog,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "CA000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid IPS_ProvisioningRecordLog Pull wsman message",
+				IPSProvisioningRecordLog,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						RecordLogItems: []RecordLogResponse{
+							recordLog(),
+						},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPS_ProvisioningRecordLog(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.IPSResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "ips/provisioningrecord/recordlog",
+	}
+	elementUnderTest := NewProvisioningRecordLogWithClient(wsmanMessageCreator, &client)
+
+	t.Run("ips_ProvisioningRecordLog Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			body         string
+			extraHeader  string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when IPS_ProvisioningRecordLog Get wsman message fails",
+				IPSProvisioningRecordLog,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when IPS_ProvisioningRecordLog Enumerate wsman message fails",
+				IPSProvisioningRecordLog,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when IPS_ProvisioningRecordLog Pull wsman message fails",
+				IPSProvisioningRecordLog,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
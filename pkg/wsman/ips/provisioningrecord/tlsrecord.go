@@ -0,0 +1,94 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecord
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewTLSProvisioningRecordWithClient returns a new instance of the TLSRecord struct.
+func NewTLSProvisioningRecordWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) TLSRecord {
+	return TLSRecord{
+		base: message.NewBaseWithClient(wsmanMessageCreator, IPSTLSProvisioningRecord, client),
+	}
+}
+
+// Get retrieves the representation of the TLS provisioning record with the given InstanceID, and decodes it into Body.DecodedRecordsResponse.
+func (tlsRecord TLSRecord) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: tlsRecord.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = tlsRecord.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	response.Body.DecodedRecordsResponse, err = decodeRecords(response.Body)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (tlsRecord TLSRecord) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: tlsRecord.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = tlsRecord.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class, and decodes them into Body.DecodedRecordsResponse.  An enumeration context provided by the Enumerate call is used as input.
+func (tlsRecord TLSRecord) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: tlsRecord.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = tlsRecord.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	response.Body.DecodedRecordsResponse, err = decodeRecords(response.Body)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,167 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package provisioningrecord
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+// Package Types.
+type (
+	RecordLog struct {
+		base message.Base
+	}
+	AuditRecord struct {
+		base message.Base
+	}
+	AdminRecord struct {
+		base message.Base
+	}
+	HostBasedSetupRecord struct {
+		base message.Base
+	}
+	TLSRecord struct {
+		base message.Base
+	}
+)
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+
+	Body struct {
+		XMLName                         xml.Name `xml:"Body"`
+		EnumerateResponse               common.EnumerateResponse
+		PullResponse                    PullResponse
+		RecordLogGetResponse            RecordLogResponse
+		AuditRecordGetResponse          AuditRecordResponse
+		AdminRecordGetResponse          AdminRecordResponse
+		HostBasedSetupRecordGetResponse HostBasedSetupRecordResponse
+		TLSRecordGetResponse            TLSRecordResponse
+		DecodedRecordsResponse          []ProvisioningRecord
+	}
+
+	RecordLogResponse struct {
+		XMLName                xml.Name `xml:"IPS_ProvisioningRecordLog"`
+		InstanceID             string   `xml:"InstanceID,omitempty"`             // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName            string   `xml:"ElementName,omitempty"`            // A user-friendly name for the object.
+		Name                   string   `xml:"Name,omitempty"`                   // The inherited Name serves as part of the key of a Log instance.
+		EnabledState           int      `xml:"EnabledState,omitempty"`           // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+		MaxNumberOfRecords     int      `xml:"MaxNumberOfRecords,omitempty"`     // Maximum number of records that can be captured in the Log.
+		CurrentNumberOfRecords int      `xml:"CurrentNumberOfRecords,omitempty"` // Current number of records in the Log.
+		OverwritePolicy        int      `xml:"OverwritePolicy,omitempty"`        // An enumeration describing the behavior of the log when it becomes full or near full.
+	}
+
+	// RecordResponse holds the properties shared by all provisioning records.
+	RecordResponse struct {
+		InstanceID         string             `xml:"InstanceID,omitempty"`         // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName        string             `xml:"ElementName,omitempty"`        // A user-friendly name for the object.
+		CreationTimeStamp  auditlog.Datetime  `xml:"CreationTimeStamp"`            // The time at which provisioning completed.
+		ProvisioningMethod ProvisioningMethod `xml:"ProvisioningMethod,omitempty"` // The method by which Intel® AMT was provisioned.
+	}
+
+	// CertificateChainResponse holds the properties of records of provisioning that authenticated the provisioning server with a certificate chain.
+	CertificateChainResponse struct {
+		SelectedHashType       HashType               `xml:"SelectedHashType,omitempty"`       // The algorithm of the trusted root certificate hash that matched the root of the provisioning certificate chain.
+		SelectedHashData       string                 `xml:"SelectedHashData,omitempty"`       // The trusted root certificate hash that matched the root of the provisioning certificate chain, base64 encoded.
+		CaCertSerials          []string               `xml:"CaCertSerials,omitempty"`          // The serial numbers of the first three certificates of the provisioning certificate chain, hex encoded.
+		AdditionalCaSerialNums bool                   `xml:"AdditionalCaSerialNums,omitempty"` // Indicates whether the provisioning certificate chain has more certificates than are listed in CaCertSerials.
+		CertificateChainStatus CertificateChainStatus `xml:"CertificateChainStatus"`           // The result of the validation of the provisioning certificate chain.
+	}
+
+	AuditRecordResponse struct {
+		XMLName xml.Name `xml:"IPS_ProvisioningAuditRecord"`
+		RecordResponse
+	}
+
+	AdminRecordResponse struct {
+		XMLName xml.Name `xml:"IPS_AdminProvisioningRecord"`
+		RecordResponse
+	}
+
+	HostBasedSetupRecordResponse struct {
+		XMLName xml.Name `xml:"IPS_HostBasedSetupProvisioningRecord"`
+		RecordResponse
+		CertificateChainResponse
+	}
+
+	TLSRecordResponse struct {
+		XMLName xml.Name `xml:"IPS_TLSProvisioningRecord"`
+		RecordResponse
+		CertificateChainResponse
+		ProvisioningTLSMode TLSMode           `xml:"ProvisioningTLSMode,omitempty"` // The TLS mode used to connect to the provisioning server.
+		SecureDNS           bool              `xml:"SecureDNS,omitempty"`           // Indicates whether the provisioning server FQDN was resolved with secure DNS.
+		HostInitiated       bool              `xml:"HostInitiated,omitempty"`       // Indicates whether the provisioning was initiated by software on the host.
+		ProvServerFQDN      string            `xml:"ProvServerFQDN,omitempty"`      // The FQDN of the provisioning server.
+		ProvServerIP        string            `xml:"ProvServerIP,omitempty"`        // The IP address of the provisioning server.
+		TlsStartTime        auditlog.Datetime `xml:"TlsStartTime"`                  // The time at which the TLS session with the provisioning server started.
+		IsOemDefault        bool              `xml:"IsOemDefault,omitempty"`        // Indicates whether the matching trusted root certificate hash is an OEM default hash.
+		IsTimeValid         bool              `xml:"IsTimeValid,omitempty"`         // Indicates whether the time of Intel® AMT was valid when the provisioning certificate chain was validated.
+	}
+
+	PullResponse struct {
+		XMLName                   xml.Name                       `xml:"PullResponse"`
+		RecordLogItems            []RecordLogResponse            `xml:"Items>IPS_ProvisioningRecordLog"`
+		AuditRecordItems          []AuditRecordResponse          `xml:"Items>IPS_ProvisioningAuditRecord"`
+		AdminRecordItems          []AdminRecordResponse          `xml:"Items>IPS_AdminProvisioningRecord"`
+		HostBasedSetupRecordItems []HostBasedSetupRecordResponse `xml:"Items>IPS_HostBasedSetupProvisioningRecord"`
+		TLSRecordItems            []TLSRecordResponse            `xml:"Items>IPS_TLSProvisioningRecord"`
+	}
+
+	// ProvisioningRecord is a provisioning record with its hash type, certificate chain status and timestamps decoded.
+	ProvisioningRecord struct {
+		Class                  string                          // The class of the record.
+		InstanceID             string                          // The InstanceID of the record.
+		ElementName            string                          // The ElementName of the record.
+		CreationTime           time.Time                       // The time at which provisioning completed.
+		ProvisioningMethod     ProvisioningMethod              // The method by which Intel® AMT was provisioned.
+		HashType               HashType                        // The algorithm of the matching trusted root certificate hash. Zero if the record has no certificate chain.
+		CertificateChainStatus CertificateChainStatus          // The result of the validation of the provisioning certificate chain.
+		ProvisioningTLSMode    TLSMode                         // The TLS mode used to connect to the provisioning server. Zero if the record is not a TLS provisioning record.
+		TLSStartTime           time.Time                       // The time at which the TLS session with the provisioning server started.
+		Parameters             auditlog.ProvisioningParameters // The provisioning parameters, as reported in a Provisioning Completed audit log record.
+	}
+)
+
+// Property Types.
+type (
+	// The method by which Intel® AMT was provisioned.
+	//
+	// ValueMap={2, 3, 4, 5}
+	//
+	// Values={Remote Configuration, Manual Provisioning via MEBx, Host-Based Provisioning Client Mode, Host-Based Provisioning Admin Mode}.
+	ProvisioningMethod int
+	// The algorithm of a trusted root certificate hash.
+	//
+	// ValueMap={1, 2, 3}
+	//
+	// Values={SHA1, SHA256, SHA384}.
+	HashType int
+	// The result of the validation of the provisioning certificate chain.
+	//
+	// ValueMap={0, 1, 2, 3, 4}
+	//
+	// Values={Valid, Root Not Trusted, Expired, Invalid Signature, Invalid Usage}.
+	CertificateChainStatus int
+	// The TLS mode used to connect to the provisioning server.
+	//
+	// ValueMap={1, 2}
+	//
+	// Values={PKI, PSK}.
+	TLSMode int
+)
@@ -7,6 +7,8 @@
 package wsman
 
 import (
+	"errors"
+
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
@@ -27,9 +29,42 @@ func NewMessages(cp client.Parameters) Messages {
 		Client: client1,
 	}
 
-	m.AMT = amt.NewMessages(client1)
-	m.CIM = cim.NewMessages(client1)
-	m.IPS = ips.NewMessages(client1)
+	if cp.ValidateRequests {
+		m.AMT = amt.NewMessagesWithValidation(client1)
+		m.CIM = cim.NewMessagesWithValidation(client1)
+		m.IPS = ips.NewMessagesWithValidation(client1)
+	} else {
+		m.AMT = amt.NewMessages(client1)
+		m.CIM = cim.NewMessages(client1)
+		m.IPS = ips.NewMessages(client1)
+	}
 
 	return m
 }
+
+// ErrNotDryRun is returned by RecordedMessages when the Messages were not created with NewDryRunMessages.
+var ErrNotDryRun = errors.New("messages were not created in dry-run mode")
+
+// NewDryRunMessages instantiates a new Messages class that records the outgoing messages instead of sending them to a device.
+// Every call except a Delete needs a response from the device, so it is recorded and then fails with client.ErrDryRun.
+// The recorded messages are returned by RecordedMessages.
+func NewDryRunMessages() Messages {
+	dryRun := client.NewDryRun()
+
+	return Messages{
+		Client: dryRun,
+		AMT:    amt.NewMessages(dryRun),
+		CIM:    cim.NewMessages(dryRun),
+		IPS:    ips.NewMessages(dryRun),
+	}
+}
+
+// RecordedMessages returns the messages recorded in dry-run mode, in the order they were sent.
+func (m Messages) RecordedMessages() ([]string, error) {
+	dryRun, ok := m.Client.(*client.DryRun)
+	if !ok {
+		return nil, ErrNotDryRun
+	}
+
+	return dryRun.Messages(), nil
+}
@@ -6,12 +6,16 @@
 package wsman
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips"
 )
 
@@ -45,3 +49,49 @@ func TestNewMessages(t *testing.T) {
 		t.Error("IPS is not initialized")
 	}
 }
+
+func TestNewMessages_ValidateRequests(t *testing.T) {
+	t.Parallel()
+
+	m := NewMessages(client.Parameters{Target: "test", ValidateRequests: true})
+
+	_, err := m.AMT.RedirectionService.Put(redirection.RedirectionRequest{EnabledState: redirection.Enabled})
+	if !errors.Is(err, common.ErrInvalidRequest) {
+		t.Errorf("expected common.ErrInvalidRequest, got %v", err)
+	}
+}
+
+func TestNewDryRunMessages(t *testing.T) {
+	t.Parallel()
+
+	m := NewDryRunMessages()
+
+	if _, err := m.AMT.RedirectionService.Get(); !errors.Is(err, client.ErrDryRun) {
+		t.Errorf("expected Get to fail with client.ErrDryRun, got %v", err)
+	}
+
+	if _, err := m.AMT.RedirectionService.RequestStateChange(redirection.EnableIDERAndSOL); !errors.Is(err, client.ErrDryRun) {
+		t.Errorf("expected RequestStateChange to fail with client.ErrDryRun, got %v", err)
+	}
+
+	if _, err := m.AMT.PublicKeyCertificate.Delete("Intel(r) AMT Certificate: Handle: 0"); err != nil {
+		t.Errorf("expected Delete to be recorded, got %v", err)
+	}
+
+	recorded, err := m.RecordedMessages()
+	if err != nil {
+		t.Fatalf("expected recorded messages, got %v", err)
+	}
+
+	if len(recorded) != 3 {
+		t.Fatalf("expected 3 recorded messages, got %d", len(recorded))
+	}
+
+	if !strings.Contains(recorded[0], "transfer/Get") || !strings.Contains(recorded[1], "AMT_RedirectionService/RequestStateChange") || !strings.Contains(recorded[2], "transfer/Delete") {
+		t.Errorf("messages were not recorded in order: %v", recorded)
+	}
+
+	if _, err := NewMessages(client.Parameters{Target: "test"}).RecordedMessages(); !errors.Is(err, ErrNotDryRun) {
+		t.Errorf("expected ErrNotDryRun, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package wsmantesting
+
+import (
+	"crypto/tls"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ErrNoFixture is returned by RoutingClient for a message without a registered fixture.
+var ErrNoFixture = errors.New("no fixture registered for message")
+
+// RoutingClient is a mock implementation of the wsman.Client interface for testing calls that send several messages.
+//
+// Each message is answered with the next fixture registered in Responses for the class and method of the message,
+// for example "AMT_SystemDefensePolicy/Create": {"amt/systemdefense/policy/create"}. The last fixture answers every further message.
+// Fixtures are relative to the responses directory, without the .xml extension.
+// Messages registered in Failures fail with the given error instead.
+type RoutingClient struct {
+	Responses map[string][]string
+	Failures  map[string]error
+	answered  map[string]int
+	Requests  []string // class/method of every message received, in order
+	Messages  []string // every message received, in order
+}
+
+func (c *RoutingClient) IsAuthenticated() bool { return true }
+
+func (c *RoutingClient) Post(msg string) ([]byte, error) {
+	key := RequestKey(msg)
+	c.Requests = append(c.Requests, key)
+	c.Messages = append(c.Messages, msg)
+
+	if err, ok := c.Failures[key]; ok {
+		return nil, err
+	}
+
+	fixtures := c.Responses[key]
+	if len(fixtures) == 0 {
+		return nil, ErrNoFixture
+	}
+
+	if c.answered == nil {
+		c.answered = map[string]int{}
+	}
+
+	index := c.answered[key]
+	if index >= len(fixtures) {
+		index = len(fixtures) - 1
+	}
+
+	c.answered[key]++
+	fixture := fixtures[index]
+
+	_, file, _, _ := runtime.Caller(0)
+
+	xmlData, err := os.ReadFile(filepath.Join(filepath.Dir(file), "responses", fixture+".xml"))
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strings.ReplaceAll(string(xmlData), "\r\n", "")), nil
+}
+
+func (c *RoutingClient) Send(data []byte) error                          { return nil }
+func (c *RoutingClient) Receive() ([]byte, error)                        { return nil, nil }
+func (c *RoutingClient) CloseConnection() error                          { return nil }
+func (c *RoutingClient) Connect() error                                  { return nil }
+func (c *RoutingClient) GetServerCertificate() (*tls.Certificate, error) { return nil, nil }
+
+// RequestKey returns the class/method key of a message, such as AMT_SystemDefensePolicy/Create.
+func RequestKey(msg string) string {
+	return lastSegment(between(msg, "<w:ResourceURI>", "</w:ResourceURI>")) + "/" + lastSegment(between(msg, "<a:Action>", "</a:Action>"))
+}
+
+func between(text, start, end string) string {
+	_, after, _ := strings.Cut(text, start)
+	value, _, _ := strings.Cut(after, end)
+
+	return value
+}
+
+func lastSegment(uri string) string {
+	return uri[strings.LastIndex(uri, "/")+1:]
+}